@@ -40,6 +40,8 @@ import (
 	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
 	httpLog "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-http-logger"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	met "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metrics"
+	mgmc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-manager-client"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 	scc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
@@ -56,6 +58,27 @@ type DevInfo struct {
 	PreferredNodes [][]string
 }
 type TrackedDevInfo map[string]string
+type MgUeTransferState struct {
+	MgName string
+	UeId   string
+	State  string
+}
+type CtxTransferInfo struct {
+	AppName       string
+	Address       string
+	SourceAppId   string
+	TargetAppId   string
+	State         string
+	StartTime     time.Time
+	timer         *time.Timer
+	pendingNotifs int
+	notified      bool
+}
+type AttrFilter struct {
+	Op     string
+	Attr   string
+	Values []string
+}
 
 const moduleName = "meep-ams"
 const amsBasePath = "amsi/v1/"
@@ -68,6 +91,8 @@ const defaultConsumedLocalOnly = true
 const appTerminationPath = "notifications/mec011/appTermination"
 const serviceAppVersion = "2.1.1"
 const USER_CTX_TRANSFER_COMPLETED = "USER_CONTEXT_TRANSFER_COMPLETED"
+const defaultCtxTransferTimeout = 30 // seconds
+const mgUeTransferQueueSize = 1000
 
 // App Info fields
 const (
@@ -94,6 +119,47 @@ const (
 	FieldCtxOwner         string = "contextOwner"
 )
 
+// Context Transfer fields
+const (
+	FieldCtxAppName     string = "appName"
+	FieldCtxAddress     string = "address"
+	FieldCtxSourceAppId string = "sourceAppId"
+	FieldCtxTargetAppId string = "targetAppId"
+	FieldCtxState       string = "state"
+	FieldCtxStartTime   string = "startTime"
+	FieldCtxDuration    string = "duration"
+)
+
+// Context Transfer states
+const (
+	CtxTransferInitiated  string = "INITIATED"
+	CtxTransferInProgress string = "IN_PROGRESS"
+	CtxTransferCompleted  string = "COMPLETED"
+	CtxTransferFailed     string = "FAILED"
+	CtxTransferTimedOut   string = "TIMED_OUT"
+)
+
+// Mobility Group UE transfer states
+const (
+	mgUeTransferTracked    string = "TRACKED"
+	mgUeTransferUntracked  string = "UNTRACKED"
+	mgUeTransferInProgress string = "IN-PROGRESS"
+	mgUeTransferCompleted  string = "COMPLETED"
+	mgUeTransferCancelled  string = "CANCELLED"
+)
+
+// Attribute-based filter operators & adjacent app instance attributes
+const (
+	filterOpEq                     string = "eq"
+	filterOpNeq                    string = "neq"
+	filterOpIn                     string = "in"
+	filterOpNin                    string = "nin"
+	filterAttrAppDId               string = "appDId"
+	filterAttrAppInstanceId        string = "appInstanceId"
+	filterAttrRegisteredInstanceId string = "registeredInstanceId"
+	filterAttrHostName             string = "mecHostInformation/hostName"
+)
+
 const MOBILITY_PROCEDURE_SUBSCRIPTION = "MobilityProcedureSubscription"
 const MOBILITY_PROCEDURE_NOTIFICATION = "MobilityProcedureNotification"
 const ADJACENT_APP_INFO_SUBSCRIPTION = "AdjacentAppInfoSubscription"
@@ -103,6 +169,7 @@ const APP_STATE_READY = "READY"
 var redisAddr string = "meep-redis-master.default.svc.cluster.local:6379"
 var influxAddr string = "http://meep-influxdb.default.svc.cluster.local:8086"
 var sbxCtrlUrl string = "http://meep-sandbox-ctrl"
+var mgManagerUrl string = "http://meep-mg-manager"
 var appStore *apps.ApplicationStore
 var subMgr *subs.SubscriptionMgr
 var mqLocal *mq.MsgQueue
@@ -129,6 +196,9 @@ var appEnablementServiceId string
 var appSupportClient *asc.APIClient
 var svcMgmtClient *smc.APIClient
 var sbxCtrlClient *scc.APIClient
+var mgManagerClient *mgmc.APIClient
+var mgUeTransferQueue chan MgUeTransferState
+var ctxTransferTimeout time.Duration = defaultCtxTransferTimeout * time.Second
 var registrationTicker *time.Ticker
 
 // AMS Resource map
@@ -143,6 +213,9 @@ var devInfoMap map[string]*DevInfo
 // k1 = AM service id; k2 = assocId (device address)
 var trackedDevInfoMap map[string]map[string]TrackedDevInfo
 
+// Context Transfer map: key = App Name + ":" + Device Address
+var ctxTransferMap map[string]*CtxTransferInfo
+
 func notImplemented(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusNotImplemented)
@@ -156,6 +229,7 @@ func Init() (err error) {
 	appInfoMap = make(map[string]AppInfo)
 	devInfoMap = make(map[string]*DevInfo)
 	trackedDevInfoMap = make(map[string]map[string]TrackedDevInfo)
+	ctxTransferMap = make(map[string]*CtxTransferInfo)
 
 	// Retrieve Instance ID from environment variable if present
 	instanceIdEnv := strings.TrimSpace(os.Getenv("MEEP_INSTANCE_ID"))
@@ -228,6 +302,16 @@ func Init() (err error) {
 	}
 	log.Info("MEEP_CONSUMED_LOCAL_ONLY: ", consumedLocalOnly)
 
+	// Get context transfer timeout
+	ctxTransferTimeoutEnv := strings.TrimSpace(os.Getenv("MEEP_CTX_TRANSFER_TIMEOUT"))
+	if ctxTransferTimeoutEnv != "" {
+		value, err := strconv.Atoi(ctxTransferTimeoutEnv)
+		if err == nil && value > 0 {
+			ctxTransferTimeout = time.Duration(value) * time.Second
+		}
+	}
+	log.Info("MEEP_CTX_TRANSFER_TIMEOUT: ", ctxTransferTimeout)
+
 	// Set base path & base storage key
 	if amsMepName == defaultMepName {
 		basePath = "/" + sandboxName + "/" + amsBasePath
@@ -307,6 +391,22 @@ func Init() (err error) {
 	}
 	log.Info("SBI Initialized")
 
+	// Create Mobility Group Manager client
+	mgManagerClientCfg := mgmc.NewConfiguration()
+	mgManagerClientCfg.BasePath = mgManagerUrl + "/mgm/v1"
+	mgManagerClient = mgmc.NewAPIClient(mgManagerClientCfg)
+	if mgManagerClient == nil {
+		return errors.New("Failed to create Mobility Group Manager REST API client")
+	}
+	log.Info("Create Mobility Group Manager REST API client")
+
+	// Start Mobility Group UE transfer state sender
+	// NOTE: States must be sent in order to avoid holding UE traffic steering indefinitely
+	if mgUeTransferQueue == nil {
+		mgUeTransferQueue = make(chan MgUeTransferState, mgUeTransferQueueSize)
+		go sendMgUeTransferStates(mgUeTransferQueue)
+	}
+
 	// Create App Enablement REST clients
 	if appEnablementEnabled {
 		// Create Sandbox Controller client
@@ -754,7 +854,7 @@ func sendAdjAppInfoNotifications(updatedAppName string) {
 	}
 }
 
-func sendMpNotifications(currentAppId string, targetAppId string, assocId *AssociateId) {
+func sendMpNotifications(currentAppId string, targetAppId string, assocId *AssociateId, ctxTransfer *CtxTransferInfo) {
 	// Get subscription list
 	subList, err := subMgr.GetFilteredSubscriptions("", MOBILITY_PROCEDURE_SUBSCRIPTION)
 	if err != nil {
@@ -813,9 +913,17 @@ func sendMpNotifications(currentAppId string, targetAppId string, assocId *Assoc
 
 		log.Info("Sending AMS Mobility Procedure notification to: ", sub.Cfg.NotifyUrl)
 
+		// Track notifications pending delivery for context transfer
+		if ctxTransfer != nil {
+			ctxTransfer.pendingNotifs++
+		}
+
 		go func(sub *subs.Subscription) {
-			_ = subMgr.SendNotification(sub, []byte(convertMobilityProcedureNotificationToJson(&notif)))
+			err := subMgr.SendNotification(sub, []byte(convertMobilityProcedureNotificationToJson(&notif)))
 			log.Info("Mobility Procedure Notification(" + sub.Cfg.Id + ")")
+			if ctxTransfer != nil {
+				updateCtxTransferDelivery(ctxTransfer, err == nil)
+			}
 		}(sub)
 	}
 }
@@ -1435,6 +1543,19 @@ func appMobilityServiceByIdDELETE(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	svcId := vars["appMobilityServiceId"]
 
+	deregisterAppMobilityService(w, svcId)
+}
+
+func appMobilityServiceDerPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	svcId := vars["appMobilityServiceId"]
+
+	deregisterAppMobilityService(w, svcId)
+}
+
+// deregisterAppMobilityService - Delete AMS resource & its tracked devices
+func deregisterAppMobilityService(w http.ResponseWriter, svcId string) {
 	mutex.Lock()
 	defer mutex.Unlock()
 
@@ -1513,6 +1634,159 @@ func populateRegInfoList(key string, jsonEntry string, response interface{}) err
 	return nil
 }
 
+func adjAppInstGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Validate query parameters
+	// NOTE: Attribute selectors are not supported; all attributes are always included in the response
+	q := r.URL.Query()
+	validParams := []string{"filter", "all_fields"}
+	if !validateQueryParams(q, validParams) {
+		errHandlerProblemDetails(w, "Unsupported query parameter", http.StatusBadRequest)
+		return
+	}
+	filters, err := parseAdjAppInstFilter(q.Get("filter"))
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get adjacent app instances matching filter
+	adjAppInstList := make([]AdjacentAppInstanceInfo, 0)
+	for _, adjAppInst := range getAdjAppInstances() {
+		if matchAdjAppInstFilter(adjAppInst, filters) {
+			adjAppInstList = append(adjAppInstList, *adjAppInst)
+		}
+	}
+
+	// Send response
+	jsonResponse, err := json.Marshal(adjAppInstList)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// getAdjAppInstances - Get app instances adjacent to app instances registered to AMS
+// Adjacent app instances share the registered app instance name (app descriptor) but have a different app instance ID
+func getAdjAppInstances() []*AdjacentAppInstanceInfo {
+	// Get registered app instances
+	registeredAppIds := make(map[string]bool)
+	for _, regInfo := range regInfoMap {
+		appId := regInfo.ServiceConsumerId.AppInstanceId
+		if appId == "" {
+			appId = regInfo.ServiceConsumerId.MepId
+		}
+		if _, err := getApp(appId); err == nil {
+			registeredAppIds[appId] = true
+		}
+	}
+
+	// Find adjacent app instances
+	adjAppInstList := []*AdjacentAppInstanceInfo{}
+	for registeredAppId := range registeredAppIds {
+		registeredAppInfo := appInfoMap[registeredAppId]
+		for adjAppId, adjAppInfo := range appInfoMap {
+			if adjAppInfo[fieldName] != registeredAppInfo[fieldName] || adjAppId == registeredAppId {
+				continue
+			}
+			hostId := map[string]interface{}{fieldNode: adjAppInfo[fieldNode]}
+			adjAppInst := &AdjacentAppInstanceInfo{
+				AppDId:              adjAppInfo[fieldName],
+				AppInstanceCommLink: []CommunicationInterface{},
+				AppInstanceId:       adjAppId,
+				MecHostInformation: &MecHostInformation{
+					HostName: adjAppInfo[fieldNode],
+					HostId:   &hostId,
+				},
+				RegisteredInstanceId: registeredAppId,
+			}
+			adjAppInstList = append(adjAppInstList, adjAppInst)
+		}
+	}
+
+	// Sort returned list by registered & adjacent app instance ID
+	sort.Slice(adjAppInstList, func(i, j int) bool {
+		if adjAppInstList[i].RegisteredInstanceId != adjAppInstList[j].RegisteredInstanceId {
+			return adjAppInstList[i].RegisteredInstanceId < adjAppInstList[j].RegisteredInstanceId
+		}
+		return adjAppInstList[i].AppInstanceId < adjAppInstList[j].AppInstanceId
+	})
+	return adjAppInstList
+}
+
+// parseAdjAppInstFilter - Parse attribute-based filter expression (ETSI GS MEC 009)
+// Example: (eq,appDId,myApp);(neq,mecHostInformation/hostName,mep1)
+func parseAdjAppInstFilter(filter string) ([]AttrFilter, error) {
+	var filters []AttrFilter
+	if filter == "" {
+		return filters, nil
+	}
+	for _, expr := range strings.Split(filter, ";") {
+		if !strings.HasPrefix(expr, "(") || !strings.HasSuffix(expr, ")") {
+			return nil, errors.New("Invalid filter expression: " + expr)
+		}
+		tokens := strings.Split(strings.TrimSuffix(strings.TrimPrefix(expr, "("), ")"), ",")
+		if len(tokens) < 3 {
+			return nil, errors.New("Invalid filter expression: " + expr)
+		}
+		attrFilter := AttrFilter{
+			Op:     tokens[0],
+			Attr:   tokens[1],
+			Values: tokens[2:],
+		}
+		if !validateQueryParamValue(attrFilter.Op, []string{filterOpEq, filterOpNeq, filterOpIn, filterOpNin}) {
+			return nil, errors.New("Unsupported filter operator: " + attrFilter.Op)
+		}
+		if (attrFilter.Op == filterOpEq || attrFilter.Op == filterOpNeq) && len(attrFilter.Values) != 1 {
+			return nil, errors.New("Filter operator " + attrFilter.Op + " expects a single value")
+		}
+		if !validateQueryParamValue(attrFilter.Attr, []string{filterAttrAppDId, filterAttrAppInstanceId, filterAttrRegisteredInstanceId, filterAttrHostName}) {
+			return nil, errors.New("Unsupported filter attribute: " + attrFilter.Attr)
+		}
+		filters = append(filters, attrFilter)
+	}
+	return filters, nil
+}
+
+// matchAdjAppInstFilter - Adjacent app instance matches if all filter expressions match
+func matchAdjAppInstFilter(adjAppInst *AdjacentAppInstanceInfo, filters []AttrFilter) bool {
+	for _, filter := range filters {
+		var val string
+		switch filter.Attr {
+		case filterAttrAppDId:
+			val = adjAppInst.AppDId
+		case filterAttrAppInstanceId:
+			val = adjAppInst.AppInstanceId
+		case filterAttrRegisteredInstanceId:
+			val = adjAppInst.RegisteredInstanceId
+		case filterAttrHostName:
+			if adjAppInst.MecHostInformation != nil {
+				val = adjAppInst.MecHostInformation.HostName
+			}
+		}
+		found := false
+		for _, filterVal := range filter.Values {
+			if val == filterVal {
+				found = true
+				break
+			}
+		}
+		if found != (filter.Op == filterOpEq || filter.Op == filterOpIn) {
+			return false
+		}
+	}
+	return true
+}
+
 func cleanUp() {
 	log.Info("Terminate all")
 
@@ -1532,6 +1806,12 @@ func cleanUp() {
 	appInfoMap = make(map[string]AppInfo)
 	devInfoMap = make(map[string]*DevInfo)
 	trackedDevInfoMap = make(map[string]map[string]TrackedDevInfo)
+	for _, ctxTransfer := range ctxTransferMap {
+		if ctxTransfer.timer != nil {
+			ctxTransfer.timer.Stop()
+		}
+	}
+	ctxTransferMap = make(map[string]*CtxTransferInfo)
 }
 
 func updateStoreName(storeName string) {
@@ -1582,6 +1862,11 @@ func createService(appId string, regInfo *RegistrationInfo) error {
 		if err != nil {
 			log.Error(err.Error())
 		}
+
+		// Complete active context transfer reported by source or target App instance
+		if *devInfo.ContextTransferState == USER_CONTEXT_TRANSFER_COMPLETED_ContextTransferState {
+			completeCtxTransfer(appId, devInfo.AssociateId.Value)
+		}
 	}
 	return nil
 }
@@ -1596,7 +1881,18 @@ func deleteServiceById(svcId string) (int, error) {
 	// Delete AMS devices
 	for _, devInfo := range regInfo.DeviceInformation {
 		address := devInfo.AssociateId.Value
+		appName := ""
+		if trackedDev, found := trackedDevInfoMap[svcId][address]; found {
+			if appInfo, err := getApp(trackedDev[FieldAppInstanceId]); err == nil {
+				appName = appInfo[fieldName]
+			}
+		}
 		_ = delTrackedDevInfo(svcId, address)
+
+		// Release traffic steering of devices no longer tracked by the application
+		if appName != "" && !isDevTracked(appName, address) {
+			setMgUeTransferState(appName, address, mgUeTransferUntracked)
+		}
 	}
 
 	// Delete AMS resource
@@ -1755,6 +2051,9 @@ func refreshTrackedDevCtxOwner(appName string) {
 				log.Error(err.Error())
 				continue
 			}
+
+			// Allow traffic steering hold on next device context transfer
+			setMgUeTransferState(appName, address, mgUeTransferTracked)
 		} else {
 			// Perform context transfer only if current App is no longer a valid target
 			ctxTransferRequired := true
@@ -1779,6 +2078,9 @@ func refreshTrackedDevCtxOwner(appName string) {
 					continue
 				}
 
+				// Start device context transfer from current to target MEC App
+				ctxTransfer := startCtxTransfer(appName, address, currentAppId, targetAppId)
+
 				// Send MP Notification for subscriptions to current MEC App
 				// NOTE: Only send for notifications for the source AM service dtracked devices
 				modelType := UE_I_PV4_ADDRESS_AssociateIdType
@@ -1787,13 +2089,171 @@ func refreshTrackedDevCtxOwner(appName string) {
 						Type_: &modelType,
 						Value: address,
 					}
-					sendMpNotifications(currentAppId, targetAppId, &assocId)
+					sendMpNotifications(currentAppId, targetAppId, &assocId, ctxTransfer)
 				}
 			}
 		}
 	}
 }
 
+// startCtxTransfer - Start device context transfer between App instances of the same application
+// Traffic steering to the target App instance is held until the transfer completes
+func startCtxTransfer(appName string, address string, sourceAppId string, targetAppId string) *CtxTransferInfo {
+	key := appName + ":" + address
+
+	// Ignore if transfer is already active; new transfer supersedes any other active transfer
+	currentCtxTransfer, found := ctxTransferMap[key]
+	if found && isCtxTransferActive(currentCtxTransfer) {
+		if currentCtxTransfer.SourceAppId == sourceAppId && currentCtxTransfer.TargetAppId == targetAppId {
+			return currentCtxTransfer
+		}
+		endCtxTransfer(currentCtxTransfer, CtxTransferFailed)
+	}
+
+	// Create new context transfer
+	ctxTransfer := &CtxTransferInfo{
+		AppName:     appName,
+		Address:     address,
+		SourceAppId: sourceAppId,
+		TargetAppId: targetAppId,
+		State:       CtxTransferInitiated,
+		StartTime:   time.Now(),
+	}
+	ctxTransferMap[key] = ctxTransfer
+	err := setCtxTransferInfo(ctxTransfer)
+	if err != nil {
+		log.Error(err.Error())
+	}
+	log.Info("Context transfer for device " + address + " initiated from " + sourceAppId + " to " + targetAppId)
+
+	// Time out context transfer if not completed in time
+	ctxTransfer.timer = time.AfterFunc(ctxTransferTimeout, func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if isCtxTransferActive(ctxTransfer) {
+			endCtxTransfer(ctxTransfer, CtxTransferTimedOut)
+			setMgUeTransferState(appName, address, mgUeTransferCancelled)
+		}
+	})
+
+	// Hold traffic steering until transfer completes
+	setMgUeTransferState(appName, address, mgUeTransferInProgress)
+	return ctxTransfer
+}
+
+// updateCtxTransferDelivery - Update context transfer state on MP notification delivery
+// Transfer is in progress once the source App instance is notified; it fails if no notification is delivered
+func updateCtxTransferDelivery(ctxTransfer *CtxTransferInfo, delivered bool) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ctxTransfer.pendingNotifs--
+	if delivered {
+		ctxTransfer.notified = true
+	}
+	if !isCtxTransferActive(ctxTransfer) {
+		return
+	}
+
+	if delivered && ctxTransfer.State == CtxTransferInitiated {
+		ctxTransfer.State = CtxTransferInProgress
+		err := setCtxTransferInfo(ctxTransfer)
+		if err != nil {
+			log.Error(err.Error())
+		}
+		log.Info("Context transfer for device " + ctxTransfer.Address + " in progress from " + ctxTransfer.SourceAppId)
+	} else if ctxTransfer.pendingNotifs == 0 && !ctxTransfer.notified {
+		endCtxTransfer(ctxTransfer, CtxTransferFailed)
+		setMgUeTransferState(ctxTransfer.AppName, ctxTransfer.Address, mgUeTransferCancelled)
+	}
+}
+
+// completeCtxTransfer - Complete active device context transfer involving the provided App instance
+func completeCtxTransfer(appId string, address string) {
+	appInfo, err := getApp(appId)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	ctxTransfer, found := ctxTransferMap[appInfo[fieldName]+":"+address]
+	if !found || !isCtxTransferActive(ctxTransfer) {
+		return
+	}
+	if appId != ctxTransfer.SourceAppId && appId != ctxTransfer.TargetAppId {
+		return
+	}
+	endCtxTransfer(ctxTransfer, CtxTransferCompleted)
+
+	// Release traffic steering to target App instance
+	setMgUeTransferState(ctxTransfer.AppName, address, mgUeTransferCompleted)
+}
+
+// endCtxTransfer - Set final context transfer state & record transfer duration
+func endCtxTransfer(ctxTransfer *CtxTransferInfo, state string) {
+	if ctxTransfer.timer != nil {
+		ctxTransfer.timer.Stop()
+	}
+	ctxTransfer.State = state
+	err := setCtxTransferInfo(ctxTransfer)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	duration := float64(time.Since(ctxTransfer.StartTime).Microseconds()) / 1000.0
+	met.ObserveContextTransfer(sandboxName, serviceName, ctxTransfer.AppName, state, duration)
+	log.Info("Context transfer for device " + ctxTransfer.Address + " from " + ctxTransfer.SourceAppId + " to " +
+		ctxTransfer.TargetAppId + " ended with state " + state + " after " + strconv.FormatFloat(duration, 'f', 3, 64) + " ms")
+}
+
+func isCtxTransferActive(ctxTransfer *CtxTransferInfo) bool {
+	return ctxTransfer.State == CtxTransferInitiated || ctxTransfer.State == CtxTransferInProgress
+}
+
+// isDevTracked - Indicates if device is tracked by any AMS service of the provided application
+func isDevTracked(appName string, address string) bool {
+	for _, infoMap := range trackedDevInfoMap {
+		if trackedDev, found := infoMap[address]; found {
+			appInfo, err := getApp(trackedDev[FieldAppInstanceId])
+			if err == nil && appInfo[fieldName] == appName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setMgUeTransferState - Queue device context transfer state update for Mobility Group Manager
+func setMgUeTransferState(mgName string, ueId string, state string) {
+	if mgManagerClient == nil || mgUeTransferQueue == nil {
+		return
+	}
+	select {
+	case mgUeTransferQueue <- MgUeTransferState{MgName: mgName, UeId: ueId, State: state}:
+	default:
+		log.Error("Failed to queue UE transfer state " + state + " for device: " + ueId)
+	}
+}
+
+// sendMgUeTransferStates - Inform Mobility Group Manager of device context transfer states, in order
+// Applications that are not part of a mobility group are ignored
+func sendMgUeTransferStates(queue chan MgUeTransferState) {
+	for update := range queue {
+		client := mgManagerClient
+		if client == nil {
+			continue
+		}
+		ueTransfer := mgmc.MobilityGroupUeTransfer{State: update.State}
+		resp, err := client.StateTransferApi.SetUeTransferState(context.TODO(), update.MgName, update.UeId, ueTransfer)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				log.Debug("No mobility group for app: ", update.MgName)
+				continue
+			}
+			log.Error("Failed to set UE transfer state with error: ", err.Error())
+		}
+	}
+}
+
 func getTargetApps(appName string, address string) ([]string, error) {
 	// Get device info using provided address
 	devInfo, err := getDev(address)
@@ -2311,6 +2771,23 @@ func setTrackedDevInfo(trackedDevInfo TrackedDevInfo) error {
 	return nil
 }
 
+func setCtxTransferInfo(ctxTransfer *CtxTransferInfo) error {
+	entry := make(map[string]interface{}, 7)
+	entry[FieldCtxAppName] = ctxTransfer.AppName
+	entry[FieldCtxAddress] = ctxTransfer.Address
+	entry[FieldCtxSourceAppId] = ctxTransfer.SourceAppId
+	entry[FieldCtxTargetAppId] = ctxTransfer.TargetAppId
+	entry[FieldCtxState] = ctxTransfer.State
+	entry[FieldCtxStartTime] = ctxTransfer.StartTime.Format(time.RFC3339Nano)
+	if !isCtxTransferActive(ctxTransfer) {
+		entry[FieldCtxDuration] = strconv.FormatInt(time.Since(ctxTransfer.StartTime).Milliseconds(), 10)
+	}
+
+	// Store entry
+	key := baseKey + "ctx:" + ctxTransfer.AppName + ":" + ctxTransfer.Address
+	return rc.SetEntry(key, entry)
+}
+
 func delTrackedDevInfo(svcId string, address string) error {
 	// Remove from cache
 	if _, found := trackedDevInfoMap[svcId]; found {
@@ -30,6 +30,7 @@ import (
 
 	apps "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-applications"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mgmc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-manager-client"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
 
@@ -717,7 +718,6 @@ func TestFailServices(t *testing.T) {
 	terminateScenario()
 }
 
-/*
 func TestServicesDeregister(t *testing.T) {
 	fmt.Println("--- ", t.Name())
 	log.MeepTextLogInit(t.Name())
@@ -737,15 +737,191 @@ func TestServicesDeregister(t *testing.T) {
 	initialiseScenario(testScenario)
 
 	//post
-	_ = testServicesPost(t)
+	svcId, expectedGetResp := testServicesPost(t)
+
+	//get
+	testServicesGet(t, svcId, expectedGetResp)
+
+	//deregister
+	testServicesDeregister(t, "invalidSvcId", false)
+	testServicesDeregister(t, svcId, true)
+
+	//get
+	testServicesGet(t, svcId, "")
+
+	terminateScenario()
+}
+
+func TestCtxTransfer(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	// No Mobility Group Manager in test environment
+	mgManagerClient = nil
+	ctxTransferTimeout = 200 * time.Millisecond
+
+	// Transfer in progress once source app is notified
+	mutex.Lock()
+	ctxTransfer1 := startCtxTransfer("app1", "ue1", "app1-1", "app1-2")
+	ctxTransfer1.pendingNotifs = 1
+	if ctxTransfer1.State != CtxTransferInitiated {
+		t.Fatalf("Unexpected context transfer state: " + ctxTransfer1.State)
+	}
+	if startCtxTransfer("app1", "ue1", "app1-1", "app1-2") != ctxTransfer1 {
+		t.Fatalf("Duplicate context transfer created")
+	}
+	mutex.Unlock()
+	updateCtxTransferDelivery(ctxTransfer1, true)
+	if ctxTransfer1.State != CtxTransferInProgress {
+		t.Fatalf("Unexpected context transfer state: " + ctxTransfer1.State)
+	}
+
+	// New transfer supersedes active transfer
+	mutex.Lock()
+	ctxTransfer2 := startCtxTransfer("app1", "ue1", "app1-2", "app1-3")
+	mutex.Unlock()
+	if ctxTransfer1.State != CtxTransferFailed || ctxTransfer2.State != CtxTransferInitiated {
+		t.Fatalf("Unexpected context transfer states: " + ctxTransfer1.State + ", " + ctxTransfer2.State)
+	}
+
+	// Transfer fails if no notification is delivered
+	mutex.Lock()
+	ctxTransfer3 := startCtxTransfer("app1", "ue2", "app1-1", "app1-2")
+	ctxTransfer3.pendingNotifs = 1
+	mutex.Unlock()
+	updateCtxTransferDelivery(ctxTransfer3, false)
+	if ctxTransfer3.State != CtxTransferFailed {
+		t.Fatalf("Unexpected context transfer state: " + ctxTransfer3.State)
+	}
+
+	// Transfer times out if not completed in time
+	time.Sleep(400 * time.Millisecond)
+	mutex.Lock()
+	state := ctxTransfer2.State
+	mutex.Unlock()
+	if state != CtxTransferTimedOut {
+		t.Fatalf("Unexpected context transfer state: " + state)
+	}
+
+	ctxTransferTimeout = defaultCtxTransferTimeout * time.Second
+	terminateScenario()
+}
+
+func TestMgUeTransferStateOrder(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Mobility Group Manager stub recording received UE transfer states
+	states := make(chan string, 10)
+	mgManager := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ueTransfer mgmc.MobilityGroupUeTransfer
+		_ = json.NewDecoder(r.Body).Decode(&ueTransfer)
+		states <- ueTransfer.State
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mgManager.Close()
+
+	mgManagerClientCfg := mgmc.NewConfiguration()
+	mgManagerClientCfg.BasePath = mgManager.URL + "/mgm/v1"
+	mgManagerClient = mgmc.NewAPIClient(mgManagerClientCfg)
+	queue := make(chan MgUeTransferState, mgUeTransferQueueSize)
+	mgUeTransferQueue = queue
+	go sendMgUeTransferStates(queue)
+
+	// States must be received in the order they were set
+	expectedStates := []string{mgUeTransferTracked, mgUeTransferInProgress, mgUeTransferCompleted, mgUeTransferUntracked}
+	for _, state := range expectedStates {
+		setMgUeTransferState("app1", "ue1", state)
+	}
+	for _, expectedState := range expectedStates {
+		select {
+		case state := <-states:
+			if state != expectedState {
+				t.Fatalf("Unexpected UE transfer state: %s, expected: %s", state, expectedState)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Missing UE transfer state: %s", expectedState)
+		}
+	}
+
+	mgManagerClient = nil
+	mgUeTransferQueue = nil
+	close(queue)
+}
+
+func TestAdjAppInstGet(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	// Add adjacent app instance on another MEC host
+	app := &apps.Application{
+		Id:      "myApp2",
+		Name:    "myAppName",
+		Type:    "USER",
+		Node:    "mep2",
+		Persist: false,
+	}
+	err = appStore.Set(app, nil)
+	if err != nil {
+		t.Fatalf("Failed to set app")
+	}
+	err = refreshApps()
+	if err != nil {
+		t.Fatalf("Failed to refresh apps")
+	}
+
+	//no registered app instance
+	testAdjAppInstGet(t, "", http.StatusOK, []string{})
+
+	//post
+	svcId, _ := testServicesPost(t)
+
+	//get adjacent app instances
+	testAdjAppInstGet(t, "", http.StatusOK, []string{"myApp2"})
+	testAdjAppInstGet(t, "(eq,appDId,myAppName)", http.StatusOK, []string{"myApp2"})
+	testAdjAppInstGet(t, "(eq,registeredInstanceId,myApp);(eq,mecHostInformation/hostName,mep2)", http.StatusOK, []string{"myApp2"})
+	testAdjAppInstGet(t, "(neq,mecHostInformation/hostName,mep2)", http.StatusOK, []string{})
+	testAdjAppInstGet(t, "(in,appInstanceId,myApp1,myApp3)", http.StatusOK, []string{})
+	testAdjAppInstGet(t, "(eq,appDId,myAppName", http.StatusBadRequest, nil)
+	testAdjAppInstGet(t, "(gt,appDId,myAppName)", http.StatusBadRequest, nil)
+	testAdjAppInstGet(t, "(eq,unknownAttr,myAppName)", http.StatusBadRequest, nil)
 
 	//delete
-	testServicesDeregister(t, strconv.Itoa(nextServiceIdAvailable-2), false)
-	testServicesDeregister(t, strconv.Itoa(nextServiceIdAvailable-1), true)
+	testServicesDelete(t, svcId, true)
+
+	//get adjacent app instances
+	testAdjAppInstGet(t, "", http.StatusOK, []string{})
 
 	terminateScenario()
 }
-*/
 func TestServicesListGet(t *testing.T) {
 	fmt.Println("--- ", t.Name())
 	log.MeepTextLogInit(t.Name())
@@ -1073,7 +1249,6 @@ func testServicesDelete(t *testing.T, serviceId string, expectSuccess bool) {
 	}
 }
 
-/*
 func testServicesDeregister(t *testing.T, serviceId string, expectSuccess bool) {
 
 	// ******************************
@@ -1099,18 +1274,58 @@ func testServicesDeregister(t *testing.T, serviceId string, expectSuccess bool)
 	// ******************************
 
 	if expectSuccess {
-		_, err := sendRequest(http.MethodPost, "/services", nil, vars, nil, http.StatusNoContent, AppMobilityServiceDerPOST)
+		_, err := sendRequest(http.MethodPost, "/services/deregister_task", nil, vars, nil, http.StatusNoContent, AppMobilityServiceDerPOST)
 		if err != nil {
 			t.Fatalf("Failed to get expected response")
 		}
 	} else {
-		_, err := sendRequest(http.MethodPost, "/services", nil, vars, nil, http.StatusNotFound, AppMobilityServiceDerPOST)
+		_, err := sendRequest(http.MethodPost, "/services/deregister_task", nil, vars, nil, http.StatusNotFound, AppMobilityServiceDerPOST)
 		if err != nil {
 			t.Fatalf("Failed to get expected response")
 		}
 	}
 }
-*/
+func testAdjAppInstGet(t *testing.T, filter string, expectedCode int, expectedAppIds []string) {
+
+	// ******************************
+	// * request queries section
+	// ******************************
+	var queries map[string]string
+	if filter != "" {
+		queries = make(map[string]string)
+		queries["filter"] = filter
+	}
+
+	// ******************************
+	// * request execution section
+	// ******************************
+
+	rr, err := sendRequest(http.MethodGet, "/queries/adjacent_app_instances", nil, nil, queries, expectedCode, AdjAppInstGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if expectedCode != http.StatusOK {
+		return
+	}
+
+	var adjAppInstList []AdjacentAppInstanceInfo
+	err = json.Unmarshal([]byte(rr), &adjAppInstList)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if len(adjAppInstList) != len(expectedAppIds) {
+		t.Fatalf("Failed to get expected response")
+	}
+	for i, adjAppInst := range adjAppInstList {
+		if adjAppInst.AppInstanceId != expectedAppIds[i] || adjAppInst.AppDId != "myAppName" || adjAppInst.RegisteredInstanceId != "myApp" {
+			t.Fatalf("Failed to get expected response")
+		}
+		if adjAppInst.MecHostInformation == nil || adjAppInst.MecHostInformation.HostName != "mep2" {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
 func testSubscriptionMobilityProcedurePost(t *testing.T) (string, string) {
 
 	/******************************
@@ -27,6 +27,10 @@ import (
 	"net/http"
 )
 
+func AdjAppInstGET(w http.ResponseWriter, r *http.Request) {
+	adjAppInstGET(w, r)
+}
+
 func AppMobilityServiceByIdDELETE(w http.ResponseWriter, r *http.Request) {
 	appMobilityServiceByIdDELETE(w, r)
 }
@@ -39,6 +43,10 @@ func AppMobilityServiceByIdPUT(w http.ResponseWriter, r *http.Request) {
 	appMobilityServiceByIdPUT(w, r)
 }
 
+func AppMobilityServiceDerPOST(w http.ResponseWriter, r *http.Request) {
+	appMobilityServiceDerPOST(w, r)
+}
+
 func AppMobilityServiceGET(w http.ResponseWriter, r *http.Request) {
 	appMobilityServiceGET(w, r)
 }
@@ -35,6 +35,18 @@ func ApplicationsConfirmTerminationPOST(w http.ResponseWriter, r *http.Request)
 	applicationsConfirmTerminationPOST(w, r)
 }
 
+func ApplicationsDnsRuleGET(w http.ResponseWriter, r *http.Request) {
+	applicationsDnsRuleGET(w, r)
+}
+
+func ApplicationsDnsRulePUT(w http.ResponseWriter, r *http.Request) {
+	applicationsDnsRulePUT(w, r)
+}
+
+func ApplicationsDnsRulesGET(w http.ResponseWriter, r *http.Request) {
+	applicationsDnsRulesGET(w, r)
+}
+
 func ApplicationsSubscriptionDELETE(w http.ResponseWriter, r *http.Request) {
 	applicationsSubscriptionDELETE(w, r)
 }
@@ -51,6 +63,18 @@ func ApplicationsSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
 	applicationsSubscriptionsPOST(w, r)
 }
 
+func ApplicationsTrafficRuleGET(w http.ResponseWriter, r *http.Request) {
+	applicationsTrafficRuleGET(w, r)
+}
+
+func ApplicationsTrafficRulePUT(w http.ResponseWriter, r *http.Request) {
+	applicationsTrafficRulePUT(w, r)
+}
+
+func ApplicationsTrafficRulesGET(w http.ResponseWriter, r *http.Request) {
+	applicationsTrafficRulesGET(w, r)
+}
+
 func TimingCapsGET(w http.ResponseWriter, r *http.Request) {
 	timingCapsGET(w, r)
 }
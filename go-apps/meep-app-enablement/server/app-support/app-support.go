@@ -20,9 +20,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +40,7 @@ import (
 )
 
 const moduleName = "meep-app-enablement"
+const moduleTcEngine = "meep-tc-engine"
 const appSupportBasePath = "mec_app_support/v1/"
 const appEnablementKey = "app-enablement"
 const globalMepName = "global"
@@ -81,11 +84,6 @@ var appStore *apps.ApplicationStore
 var appInfoMap map[string]map[string]string
 var gracefulTerminateMap = map[string]chan bool{}
 
-func notImplemented(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	w.WriteHeader(http.StatusNotImplemented)
-}
-
 func Init(sandbox string, mep string, host *url.URL, msgQueue *mq.MsgQueue, globalMutex *sync.Mutex) (err error) {
 	sandboxName = sandbox
 	hostUrl = host
@@ -576,6 +574,332 @@ func applicationsSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, convertMecAppSuptApiSubscriptionLinkListToJson(subscriptionLinkList))
 }
 
+func applicationsDnsRulesGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	appId := vars["appInstanceId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get App instance info
+	appInfo, err := getApp(appId)
+	if err != nil {
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Validate App info
+	code, problemDetails, err := validateAppInfo(appInfo)
+	if err != nil {
+		log.Error(err.Error())
+		if problemDetails != "" {
+			w.WriteHeader(code)
+			fmt.Fprint(w, problemDetails)
+		} else {
+			errHandlerProblemDetails(w, err.Error(), code)
+		}
+		return
+	}
+
+	// Get DNS rules for App instance
+	dnsRuleList := make([]DnsRule, 0)
+	key := baseKey + "app:" + appId + ":dns:*"
+	err = rc.ForEachJSONEntry(key, populateDnsRuleList, &dnsRuleList)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	jsonResponse, err := json.Marshal(dnsRuleList)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func applicationsDnsRuleGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	appId := vars["appInstanceId"]
+	dnsRuleId := vars["dnsRuleId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get App instance info
+	appInfo, err := getApp(appId)
+	if err != nil {
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Validate App info
+	code, problemDetails, err := validateAppInfo(appInfo)
+	if err != nil {
+		log.Error(err.Error())
+		if problemDetails != "" {
+			w.WriteHeader(code)
+			fmt.Fprint(w, problemDetails)
+		} else {
+			errHandlerProblemDetails(w, err.Error(), code)
+		}
+		return
+	}
+
+	// Find DNS rule by ID
+	dnsRuleJson, err := getDnsRule(appId, dnsRuleId)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, dnsRuleJson)
+}
+
+func applicationsDnsRulePUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	appId := vars["appInstanceId"]
+	dnsRuleId := vars["dnsRuleId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get App instance info
+	appInfo, err := getApp(appId)
+	if err != nil {
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Validate App info
+	code, problemDetails, err := validateAppInfo(appInfo)
+	if err != nil {
+		log.Error(err.Error())
+		if problemDetails != "" {
+			w.WriteHeader(code)
+			fmt.Fprint(w, problemDetails)
+		} else {
+			errHandlerProblemDetails(w, err.Error(), code)
+		}
+		return
+	}
+
+	// Retrieve DNS rule from request body
+	var dnsRule DnsRule
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&dnsRule)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Make sure DNS rule ID matches resource
+	if dnsRule.DnsRuleId == "" {
+		dnsRule.DnsRuleId = dnsRuleId
+	} else if dnsRule.DnsRuleId != dnsRuleId {
+		log.Error("DnsRuleId mismatch")
+		errHandlerProblemDetails(w, "DnsRuleId does not match request URI", http.StatusBadRequest)
+		return
+	}
+
+	// Validate DNS rule
+	err = validateDnsRule(&dnsRule)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Store DNS rule
+	err = setDnsRule(appId, &dnsRule)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Inform TC Engine of DNS rule update
+	sendDnsRulesUpdate(appId)
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertDnsRuleToJson(&dnsRule))
+}
+
+func applicationsTrafficRulesGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	appId := vars["appInstanceId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get App instance info
+	appInfo, err := getApp(appId)
+	if err != nil {
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Validate App info
+	code, problemDetails, err := validateAppInfo(appInfo)
+	if err != nil {
+		log.Error(err.Error())
+		if problemDetails != "" {
+			w.WriteHeader(code)
+			fmt.Fprint(w, problemDetails)
+		} else {
+			errHandlerProblemDetails(w, err.Error(), code)
+		}
+		return
+	}
+
+	// Get traffic rules for App instance
+	trafficRuleList := make([]TrafficRule, 0)
+	key := baseKey + "app:" + appId + ":traffic:*"
+	err = rc.ForEachJSONEntry(key, populateTrafficRuleList, &trafficRuleList)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	jsonResponse, err := json.Marshal(trafficRuleList)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func applicationsTrafficRuleGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	appId := vars["appInstanceId"]
+	trafficRuleId := vars["trafficRuleId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get App instance info
+	appInfo, err := getApp(appId)
+	if err != nil {
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Validate App info
+	code, problemDetails, err := validateAppInfo(appInfo)
+	if err != nil {
+		log.Error(err.Error())
+		if problemDetails != "" {
+			w.WriteHeader(code)
+			fmt.Fprint(w, problemDetails)
+		} else {
+			errHandlerProblemDetails(w, err.Error(), code)
+		}
+		return
+	}
+
+	// Find traffic rule by ID
+	trafficRuleJson, err := getTrafficRule(appId, trafficRuleId)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, trafficRuleJson)
+}
+
+func applicationsTrafficRulePUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	appId := vars["appInstanceId"]
+	trafficRuleId := vars["trafficRuleId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get App instance info
+	appInfo, err := getApp(appId)
+	if err != nil {
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Validate App info
+	code, problemDetails, err := validateAppInfo(appInfo)
+	if err != nil {
+		log.Error(err.Error())
+		if problemDetails != "" {
+			w.WriteHeader(code)
+			fmt.Fprint(w, problemDetails)
+		} else {
+			errHandlerProblemDetails(w, err.Error(), code)
+		}
+		return
+	}
+
+	// Retrieve traffic rule from request body
+	var trafficRule TrafficRule
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&trafficRule)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Make sure traffic rule ID matches resource
+	if trafficRule.TrafficRuleId == "" {
+		trafficRule.TrafficRuleId = trafficRuleId
+	} else if trafficRule.TrafficRuleId != trafficRuleId {
+		log.Error("TrafficRuleId mismatch")
+		errHandlerProblemDetails(w, "TrafficRuleId does not match request URI", http.StatusBadRequest)
+		return
+	}
+
+	// Validate traffic rule
+	err = validateTrafficRule(&trafficRule)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Store traffic rule
+	err = setTrafficRule(appId, &trafficRule)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Inform TC Engine of traffic rule update
+	sendTrafficRulesUpdate(appId)
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertTrafficRuleToJson(&trafficRule))
+}
+
 func timingCapsGET(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	log.Info("timingCapsGET")
@@ -981,6 +1305,266 @@ func flushApps(persist bool) error {
 	return nil
 }
 
+func populateDnsRuleList(key string, jsonInfo string, userData interface{}) error {
+	dnsRuleList := userData.(*[]DnsRule)
+
+	// Add DNS rule to list
+	dnsRule := convertJsonToDnsRule(jsonInfo)
+	if dnsRule == nil {
+		return errors.New("Failed to parse DNS rule")
+	}
+	*dnsRuleList = append(*dnsRuleList, *dnsRule)
+	return nil
+}
+
+func getDnsRule(appId string, dnsRuleId string) (string, error) {
+	key := baseKey + "app:" + appId + ":dns:" + dnsRuleId
+	dnsRuleJson, err := rc.JSONGetEntry(key, ".")
+	if err != nil || dnsRuleJson == "" {
+		return "", errors.New("DNS rule not found")
+	}
+	return dnsRuleJson, nil
+}
+
+func setDnsRule(appId string, dnsRule *DnsRule) error {
+	dnsRuleJson := convertDnsRuleToJson(dnsRule)
+	if dnsRuleJson == "" {
+		return errors.New("Failed to marshal DNS rule")
+	}
+	key := baseKey + "app:" + appId + ":dns:" + dnsRule.DnsRuleId
+	return rc.JSONSetEntry(key, ".", dnsRuleJson)
+}
+
+func validateDnsRule(dnsRule *DnsRule) error {
+	// Domain name
+	if dnsRule.DomainName == "" {
+		return errors.New("Mandatory DomainName not present")
+	}
+	if !isValidDomainName(dnsRule.DomainName) {
+		return errors.New("DomainName not valid")
+	}
+
+	// IP address & type
+	if dnsRule.IpAddressType == nil {
+		return errors.New("Mandatory IpAddressType not present")
+	}
+	if dnsRule.IpAddress == "" {
+		return errors.New("Mandatory IpAddress not present")
+	}
+	ip := net.ParseIP(dnsRule.IpAddress)
+	if ip == nil {
+		return errors.New("IpAddress not valid")
+	}
+	switch *dnsRule.IpAddressType {
+	case V4_DnsRuleIpAddressType:
+		if ip.To4() == nil {
+			return errors.New("IpAddress does not match IpAddressType")
+		}
+	case V6_DnsRuleIpAddressType:
+		if ip.To4() != nil {
+			return errors.New("IpAddress does not match IpAddressType")
+		}
+	default:
+		return errors.New("IpAddressType not valid")
+	}
+
+	// TTL
+	if dnsRule.Ttl < 0 {
+		return errors.New("Ttl not valid")
+	}
+
+	// State
+	if dnsRule.State == nil {
+		return errors.New("Mandatory State not present")
+	}
+	switch *dnsRule.State {
+	case ACTIVE_DnsRuleState, INACTIVE_DnsRuleState:
+	default:
+		return errors.New("State not valid")
+	}
+	return nil
+}
+
+func isValidDomainName(domainName string) bool {
+	domainName = strings.TrimSuffix(domainName, ".")
+	if domainName == "" || len(domainName) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(domainName, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') && c != '-' && c != '_' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func populateTrafficRuleList(key string, jsonInfo string, userData interface{}) error {
+	trafficRuleList := userData.(*[]TrafficRule)
+
+	// Add traffic rule to list
+	trafficRule := convertJsonToTrafficRule(jsonInfo)
+	if trafficRule == nil {
+		return errors.New("Failed to parse traffic rule")
+	}
+	*trafficRuleList = append(*trafficRuleList, *trafficRule)
+	return nil
+}
+
+func getTrafficRule(appId string, trafficRuleId string) (string, error) {
+	key := baseKey + "app:" + appId + ":traffic:" + trafficRuleId
+	trafficRuleJson, err := rc.JSONGetEntry(key, ".")
+	if err != nil || trafficRuleJson == "" {
+		return "", errors.New("Traffic rule not found")
+	}
+	return trafficRuleJson, nil
+}
+
+func setTrafficRule(appId string, trafficRule *TrafficRule) error {
+	trafficRuleJson := convertTrafficRuleToJson(trafficRule)
+	if trafficRuleJson == "" {
+		return errors.New("Failed to marshal traffic rule")
+	}
+	key := baseKey + "app:" + appId + ":traffic:" + trafficRule.TrafficRuleId
+	return rc.JSONSetEntry(key, ".", trafficRuleJson)
+}
+
+func validateTrafficRule(trafficRule *TrafficRule) error {
+	// Filter type
+	if trafficRule.FilterType == nil {
+		return errors.New("Mandatory FilterType not present")
+	}
+	switch *trafficRule.FilterType {
+	case FLOW_TrafficRuleFilterType, PACKET_TrafficRuleFilterType:
+	default:
+		return errors.New("FilterType not valid")
+	}
+
+	// Priority
+	if trafficRule.Priority < 0 || trafficRule.Priority > 255 {
+		return errors.New("Priority not valid")
+	}
+
+	// State
+	if trafficRule.State == nil {
+		return errors.New("Mandatory State not present")
+	}
+	switch *trafficRule.State {
+	case ACTIVE_TrafficRuleState, INACTIVE_TrafficRuleState:
+	default:
+		return errors.New("State not valid")
+	}
+
+	// Traffic filters
+	if len(trafficRule.TrafficFilter) == 0 {
+		return errors.New("Mandatory TrafficFilter not present")
+	}
+	for _, trafficFilter := range trafficRule.TrafficFilter {
+		err := validateTrafficFilter(&trafficFilter)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Action & number of destination interfaces
+	if trafficRule.Action == nil {
+		return errors.New("Mandatory Action not present")
+	}
+	minDstInterfaces, maxDstInterfaces := 0, 0
+	switch *trafficRule.Action {
+	case DROP_TrafficRuleAction:
+	case PASSTHROUGH_TrafficRuleAction:
+		maxDstInterfaces = 1
+	case FORWARD_DECAPSULATED_TrafficRuleAction, FORWARD_ENCAPSULATED_TrafficRuleAction:
+		minDstInterfaces, maxDstInterfaces = 1, 1
+	case DUPLICATE_DECAPSULATED_TrafficRuleAction, DUPLICATE_ENCAPSULATED_TrafficRuleAction:
+		minDstInterfaces, maxDstInterfaces = 1, 2
+	default:
+		return errors.New("Action not valid")
+	}
+	if len(trafficRule.DstInterface) < minDstInterfaces || len(trafficRule.DstInterface) > maxDstInterfaces {
+		return errors.New("Invalid number of DstInterface entries for action " + string(*trafficRule.Action))
+	}
+
+	// Destination interfaces
+	// NOTE: Only IP destination interfaces are supported by the sandbox data plane
+	for _, dstInterface := range trafficRule.DstInterface {
+		if dstInterface.InterfaceType == nil {
+			return errors.New("Mandatory InterfaceType not present")
+		}
+		if *dstInterface.InterfaceType != IP_DestinationInterfaceInterfaceType {
+			return errors.New("Unsupported InterfaceType: " + string(*dstInterface.InterfaceType))
+		}
+		if dstInterface.DstIpAddress == "" {
+			return errors.New("Mandatory DstIpAddress not present")
+		}
+	}
+	return nil
+}
+
+func validateTrafficFilter(trafficFilter *TrafficFilter) error {
+	// Reject criteria that cannot be enforced by the sandbox data plane
+	if len(trafficFilter.Token) != 0 || len(trafficFilter.SrcTunnelAddress) != 0 ||
+		len(trafficFilter.TgtTunnelAddress) != 0 || len(trafficFilter.SrcTunnelPort) != 0 ||
+		len(trafficFilter.DstTunnelPort) != 0 || trafficFilter.QCI != 0 || trafficFilter.TC != 0 {
+		return errors.New("Unsupported traffic filter criteria")
+	}
+
+	// Addresses
+	for _, address := range append(trafficFilter.SrcAddress, trafficFilter.DstAddress...) {
+		if address == "" {
+			return errors.New("Empty traffic filter address")
+		}
+	}
+
+	// Ports
+	for _, port := range append(trafficFilter.SrcPort, trafficFilter.DstPort...) {
+		if !isValidPortRange(port) {
+			return errors.New("Invalid traffic filter port: " + port)
+		}
+	}
+
+	// Protocols
+	hasPorts := len(trafficFilter.SrcPort) != 0 || len(trafficFilter.DstPort) != 0
+	for _, protocol := range trafficFilter.Protocol {
+		switch strings.ToUpper(protocol) {
+		case "TCP", "UDP", "SCTP":
+		case "ICMP":
+			if hasPorts {
+				return errors.New("Traffic filter ports not supported with protocol: " + protocol)
+			}
+		default:
+			return errors.New("Unsupported traffic filter protocol: " + protocol)
+		}
+	}
+
+	// DSCP
+	if trafficFilter.DSCP < 0 || trafficFilter.DSCP > 63 {
+		return errors.New("Invalid traffic filter DSCP")
+	}
+	return nil
+}
+
+func isValidPortRange(portRange string) bool {
+	ports := strings.Split(portRange, "-")
+	if len(ports) > 2 {
+		return false
+	}
+	prevPort := -1
+	for _, port := range ports {
+		value, err := strconv.Atoi(strings.TrimSpace(port))
+		if err != nil || value < 0 || value > 65535 || value < prevPort {
+			return false
+		}
+		prevPort = value
+	}
+	return true
+}
+
 func newAppTerminationNotifSubCfg(sub *AppTerminationNotificationSubscription, subId string, appId string) *subs.SubscriptionCfg {
 	subCfg := &subs.SubscriptionCfg{
 		Id:                  subId,
@@ -1011,6 +1595,34 @@ func sendAppRemoveCnf(id string) {
 	}
 }
 
+func sendTrafficRulesUpdate(id string) {
+	// Create message to send on MQ
+	msg := mqLocal.CreateMsg(mq.MsgTrafficRulesUpdate, moduleTcEngine, sandboxName)
+	msg.Payload[mqFieldAppId] = id
+
+	// Send message to inform TC Engine of traffic rule update
+	log.Debug("TX MSG: ", mq.PrintMsg(msg))
+	err := mqLocal.SendMsg(msg)
+	if err != nil {
+		log.Error("Failed to send message. Error: ", err.Error())
+		return
+	}
+}
+
+func sendDnsRulesUpdate(id string) {
+	// Create message to send on MQ
+	msg := mqLocal.CreateMsg(mq.MsgDnsRulesUpdate, moduleTcEngine, sandboxName)
+	msg.Payload[mqFieldAppId] = id
+
+	// Send message to inform TC Engine of DNS rule update
+	log.Debug("TX MSG: ", mq.PrintMsg(msg))
+	err := mqLocal.SendMsg(msg)
+	if err != nil {
+		log.Error("Failed to send message. Error: ", err.Error())
+		return
+	}
+}
+
 func errHandlerProblemDetails(w http.ResponseWriter, error string, code int) {
 	var pd ProblemDetails
 	pd.Detail = error
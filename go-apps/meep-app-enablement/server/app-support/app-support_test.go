@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestValidateDnsRule(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	ipV4 := V4_DnsRuleIpAddressType
+	ipV6 := V6_DnsRuleIpAddressType
+	invalidType := DnsRuleIpAddressType("IP_V5")
+	active := ACTIVE_DnsRuleState
+	inactive := INACTIVE_DnsRuleState
+	invalidState := DnsRuleState("UNKNOWN")
+
+	tests := []struct {
+		name  string
+		rule  DnsRule
+		valid bool
+	}{
+		{"IPv4 rule", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV4, IpAddress: "10.0.0.1", Ttl: 30, State: &active}, true},
+		{"IPv6 rule", DnsRule{DomainName: "app.example.com.", IpAddressType: &ipV6, IpAddress: "2001:db8::1", State: &inactive}, true},
+		{"underscore label", DnsRule{DomainName: "_svc.app-1.example", IpAddressType: &ipV4, IpAddress: "10.0.0.1", State: &active}, true},
+		{"missing domain name", DnsRule{IpAddressType: &ipV4, IpAddress: "10.0.0.1", State: &active}, false},
+		{"empty label", DnsRule{DomainName: "app..example.com", IpAddressType: &ipV4, IpAddress: "10.0.0.1", State: &active}, false},
+		{"hyphen label", DnsRule{DomainName: "-app.example.com", IpAddressType: &ipV4, IpAddress: "10.0.0.1", State: &active}, false},
+		{"invalid character", DnsRule{DomainName: "app!.example.com", IpAddressType: &ipV4, IpAddress: "10.0.0.1", State: &active}, false},
+		{"long label", DnsRule{DomainName: strings.Repeat("a", 64) + ".com", IpAddressType: &ipV4, IpAddress: "10.0.0.1", State: &active}, false},
+		{"missing address type", DnsRule{DomainName: "app.example.com", IpAddress: "10.0.0.1", State: &active}, false},
+		{"invalid address type", DnsRule{DomainName: "app.example.com", IpAddressType: &invalidType, IpAddress: "10.0.0.1", State: &active}, false},
+		{"missing address", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV4, State: &active}, false},
+		{"invalid address", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV4, IpAddress: "10.0.0", State: &active}, false},
+		{"IPv6 address with IPv4 type", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV4, IpAddress: "2001:db8::1", State: &active}, false},
+		{"IPv4 address with IPv6 type", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV6, IpAddress: "10.0.0.1", State: &active}, false},
+		{"negative TTL", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV4, IpAddress: "10.0.0.1", Ttl: -1, State: &active}, false},
+		{"missing state", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV4, IpAddress: "10.0.0.1"}, false},
+		{"invalid state", DnsRule{DomainName: "app.example.com", IpAddressType: &ipV4, IpAddress: "10.0.0.1", State: &invalidState}, false},
+	}
+	for _, test := range tests {
+		err := validateDnsRule(&test.rule)
+		if test.valid && err != nil {
+			t.Fatalf("Valid rule %s failed validation: %s", test.name, err.Error())
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("Invalid rule %s passed validation", test.name)
+		}
+	}
+}
@@ -66,3 +66,41 @@ func convertProblemDetailstoJson(probdetails *ProblemDetails) string {
 	}
 	return string(jsonInfo)
 }
+
+func convertTrafficRuleToJson(obj *TrafficRule) string {
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertJsonToTrafficRule(jsonInfo string) *TrafficRule {
+	var obj TrafficRule
+	err := json.Unmarshal([]byte(jsonInfo), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertDnsRuleToJson(obj *DnsRule) string {
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertJsonToDnsRule(jsonInfo string) *DnsRule {
+	var obj DnsRule
+	err := json.Unmarshal([]byte(jsonInfo), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
@@ -52,6 +52,10 @@ func GetWithinRangeByName(w http.ResponseWriter, r *http.Request) {
 	geGetWithinRangeGeoDataByName(w, r)
 }
 
+func GetWithinPolygonByName(w http.ResponseWriter, r *http.Request) {
+	geGetWithinPolygonGeoDataByName(w, r)
+}
+
 func UpdateGeoDataByName(w http.ResponseWriter, r *http.Request) {
 	geUpdateGeoDataByName(w, r)
 }
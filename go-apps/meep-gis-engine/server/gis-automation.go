@@ -330,9 +330,9 @@ func runAutoNetChar(ueMap map[string]*am.Ue, poaMap map[string]*am.Poa) {
 			var event sbox.Event
 			var netCharEvent sbox.EventNetworkCharacteristicsUpdate
 			event.Type_ = AutoTypeNetChar
-			// Shallow copy network characteristics
-			newNetChar := client.NetworkCharacteristics(netChar)
-			netCharEvent = sbox.EventNetworkCharacteristicsUpdate{ElementName: ue.Name, ElementType: mod.NodeTypeUE, NetChar: &newNetChar}
+			// Copy network characteristics
+			newNetChar := convertNetCharToClient(&netChar)
+			netCharEvent = sbox.EventNetworkCharacteristicsUpdate{ElementName: ue.Name, ElementType: mod.NodeTypeUE, NetChar: newNetChar}
 			event.EventNetworkCharacteristicsUpdate = &netCharEvent
 
 			go func() {
@@ -375,9 +375,9 @@ func resetAutoNetChar() {
 			var event sbox.Event
 			var netCharEvent sbox.EventNetworkCharacteristicsUpdate
 			event.Type_ = AutoTypeNetChar
-			// Shallow copy network characteristics
-			newNetChar := client.NetworkCharacteristics(netChar)
-			netCharEvent = sbox.EventNetworkCharacteristicsUpdate{ElementName: ue.Name, ElementType: mod.NodeTypeUE, NetChar: &newNetChar}
+			// Copy network characteristics
+			newNetChar := convertNetCharToClient(&netChar)
+			netCharEvent = sbox.EventNetworkCharacteristicsUpdate{ElementName: ue.Name, ElementType: mod.NodeTypeUE, NetChar: newNetChar}
 			event.EventNetworkCharacteristicsUpdate = &netCharEvent
 
 			go func() {
@@ -504,3 +504,17 @@ func geSetAutomationStateByName(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
 }
+
+// Convert network characteristics to sandbox controller client model
+func convertNetCharToClient(netChar *dataModel.NetworkCharacteristics) *client.NetworkCharacteristics {
+	var newNetChar client.NetworkCharacteristics
+	jsonNetChar, err := json.Marshal(netChar)
+	if err == nil {
+		err = json.Unmarshal(jsonNetChar, &newNetChar)
+	}
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &newNetChar
+}
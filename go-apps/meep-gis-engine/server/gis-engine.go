@@ -78,6 +78,8 @@ type Asset struct {
 	geoData      *AssetGeoData
 	connected    bool
 	wirelessType string
+	propagation  am.Propagation
+	handover     am.Handover
 }
 
 type UeInfo struct {
@@ -195,6 +197,9 @@ func Init() (err error) {
 	}
 	log.Info("Created new GIS Engine DB tables")
 
+	// Update GIS cache when a pending UE handover is re-evaluated
+	_ = ge.assetMgr.SetHandoverListener(handoverCb)
+
 	// Initialize GIS Asset Manager with current active scenario assets
 	processScenarioActivate()
 
@@ -527,10 +532,12 @@ func setUe(asset *Asset, pl *dataModel.PhysicalLocation, geoData *AssetGeoData)
 		}
 
 		// Fill UE data
+		handover := getHandover(pl)
 		ueData[am.FieldConnected] = pl.Connected
 		ueData[am.FieldPriority] = initWirelessType(pl.Wireless, pl.WirelessType)
 		ueData[am.FieldPosition] = geoData.position
 		ueData[am.FieldRadius] = geoData.radius
+		ueData[am.FieldHandover] = &handover
 		if geoData.path != "" {
 			ueData[am.FieldPath] = geoData.path
 			// Set default EOP mode to LOOP if not provided
@@ -548,6 +555,7 @@ func setUe(asset *Asset, pl *dataModel.PhysicalLocation, geoData *AssetGeoData)
 		}
 		log.Debug("GeoData created for UE: ", asset.name)
 		asset.geoData = geoData
+		asset.handover = handover
 
 	} else {
 		// Update Geodata
@@ -578,6 +586,13 @@ func setUe(asset *Asset, pl *dataModel.PhysicalLocation, geoData *AssetGeoData)
 			asset.wirelessType = wirelessType
 		}
 
+		// Update handover policy
+		handover := getHandover(pl)
+		if handover != asset.handover {
+			ueData[am.FieldHandover] = &handover
+			asset.handover = handover
+		}
+
 		// Update UE if necessary
 		if len(ueData) > 0 {
 			err := ge.assetMgr.UpdateUe(asset.name, ueData)
@@ -591,6 +606,30 @@ func setUe(asset *Asset, pl *dataModel.PhysicalLocation, geoData *AssetGeoData)
 	return nil
 }
 
+func getHandover(pl *dataModel.PhysicalLocation) am.Handover {
+	// Use UE handover policy if set, otherwise use domain handover policy
+	ho := pl.HandoverConfig
+	if ho == nil {
+		ctx := ge.activeModel.GetNodeContext(pl.Name)
+		if ctx != nil {
+			if domain, ok := ge.activeModel.GetNode(ctx.Parents[mod.Domain]).(*dataModel.Domain); ok {
+				ho = domain.HandoverConfig
+			}
+		}
+	}
+
+	// Default to distance-based policy
+	if ho == nil || ho.Policy == "" {
+		return am.Handover{Policy: am.HoPolicyDistance}
+	}
+	return am.Handover{
+		Policy:        ho.Policy,
+		A3Offset:      ho.A3Offset,
+		Hysteresis:    ho.Hysteresis,
+		TimeToTrigger: ho.TimeToTrigger,
+	}
+}
+
 func setPoa(asset *Asset, nl *dataModel.NetworkLocation, geoData *AssetGeoData) error {
 	// Get POA Data
 	poaData := make(map[string]interface{})
@@ -603,9 +642,11 @@ func setPoa(asset *Asset, nl *dataModel.NetworkLocation, geoData *AssetGeoData)
 		}
 
 		// Get POA data
+		propagation := getPropagation(nl)
 		poaData[am.FieldSubtype] = asset.typ
 		poaData[am.FieldRadius] = geoData.radius
 		poaData[am.FieldPosition] = geoData.position
+		poaData[am.FieldPropagation] = &propagation
 
 		// Create POA
 		err := ge.assetMgr.CreatePoa(nl.Id, asset.name, poaData)
@@ -614,6 +655,7 @@ func setPoa(asset *Asset, nl *dataModel.NetworkLocation, geoData *AssetGeoData)
 		}
 		log.Debug("GeoData stored for POA: ", asset.name)
 		asset.geoData = geoData
+		asset.propagation = propagation
 	} else {
 		// Update Geodata
 		if geoData != nil {
@@ -625,6 +667,13 @@ func setPoa(asset *Asset, nl *dataModel.NetworkLocation, geoData *AssetGeoData)
 			}
 		}
 
+		// Update propagation model
+		propagation := getPropagation(nl)
+		if propagation != asset.propagation {
+			poaData[am.FieldPropagation] = &propagation
+			asset.propagation = propagation
+		}
+
 		// Update POA
 		if len(poaData) > 0 {
 			err := ge.assetMgr.UpdatePoa(asset.name, poaData)
@@ -637,6 +686,38 @@ func setPoa(asset *Asset, nl *dataModel.NetworkLocation, geoData *AssetGeoData)
 	return nil
 }
 
+func getPropagation(nl *dataModel.NetworkLocation) am.Propagation {
+	// Get POA type-specific propagation configuration
+	var prop *dataModel.PropagationConfig
+	switch nl.Type_ {
+	case mod.NodeTypePoa4G:
+		if nl.Poa4GConfig != nil {
+			prop = nl.Poa4GConfig.Propagation
+		}
+	case mod.NodeTypePoa5G:
+		if nl.Poa5GConfig != nil {
+			prop = nl.Poa5GConfig.Propagation
+		}
+	case mod.NodeTypePoaWifi:
+		if nl.PoaWifiConfig != nil {
+			prop = nl.PoaWifiConfig.Propagation
+		}
+	}
+
+	// Default to linear model
+	if prop == nil || prop.Model == "" {
+		return am.Propagation{Model: am.PropModelLinear}
+	}
+	return am.Propagation{
+		Model:            prop.Model,
+		TxPower:          prop.TxPower,
+		Frequency:        prop.Frequency,
+		AntennaHeight:    prop.AntennaHeight,
+		UeAntennaHeight:  prop.UeAntennaHeight,
+		PathLossExponent: prop.PathLossExponent,
+	}
+}
+
 func setCompute(asset *Asset, pl *dataModel.PhysicalLocation, geoData *AssetGeoData) error {
 	// Get Compute Data
 	computeData := make(map[string]interface{})
@@ -847,6 +928,14 @@ func initWirelessType(wireless bool, wirelessType string) string {
 	return wt
 }
 
+func handoverCb(ueName string) {
+	ge.mutex.Lock()
+	defer ge.mutex.Unlock()
+
+	log.Debug("Handover check completed for UE: ", ueName)
+	updateCache()
+}
+
 func notifyCacheUpdate(cacheUpdated *bool) {
 	if *cacheUpdated {
 		// Send GIS cache update message on local Message Queue
@@ -942,23 +1031,26 @@ func updateCache() {
 
 		// Update POA measurements if different from cached value
 		for _, poaMeas := range ue.PoaMeasurements {
+			connected := ue.Poa != "" && ue.Poa == poaMeas.Poa
 			updateRequired := false
 			cachedPoaMeas, found := cachedPoaMeasMap[ue.Name]
 			if !found {
 				updateRequired = true
 			} else {
 				cachedMeas, found := cachedPoaMeas.Measurements[poaMeas.Poa]
-				if !found || cachedMeas.Distance != poaMeas.Distance || cachedMeas.Rssi != poaMeas.Rssi || cachedMeas.Rsrp != poaMeas.Rsrp || cachedMeas.Rsrq != poaMeas.Rsrq {
+				if !found || cachedMeas.Distance != poaMeas.Distance || cachedMeas.Rssi != poaMeas.Rssi || cachedMeas.Rsrp != poaMeas.Rsrp || cachedMeas.Rsrq != poaMeas.Rsrq || cachedMeas.InRange != poaMeas.InRange || cachedMeas.Connected != connected {
 					updateRequired = true
 				}
 			}
 
 			if updateRequired {
 				measurement := &gc.PoaMeasurement{
-					Rssi:     poaMeas.Rssi,
-					Rsrp:     poaMeas.Rsrp,
-					Rsrq:     poaMeas.Rsrq,
-					Distance: poaMeas.Distance,
+					Rssi:      poaMeas.Rssi,
+					Rsrp:      poaMeas.Rsrp,
+					Rsrq:      poaMeas.Rsrq,
+					Distance:  poaMeas.Distance,
+					InRange:   poaMeas.InRange,
+					Connected: connected,
 				}
 				_ = ge.gisCache.SetPoaMeasurement(ue.Name, AssetTypeUe, poaMeas.Poa, poaMeas.SubType, measurement)
 				cacheUpdated = true
@@ -1538,6 +1630,114 @@ func geGetWithinRangeGeoDataByName(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, string(jsonResponse))
 }
 
+func geGetWithinPolygonGeoDataByName(w http.ResponseWriter, r *http.Request) {
+
+	// Get asset name from request path parameters
+	vars := mux.Vars(r)
+	assetName := vars["assetName"]
+	log.Debug("Get Within Polygon GeoData for asset: ", assetName)
+
+	// Make sure scenario is active
+	if ge.activeModel.GetScenarioName() == "" {
+		err := errors.New("No active scenario")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	srcAsset := ge.assets[assetName]
+	if srcAsset == nil {
+		err := errors.New("Asset not in scenario")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	position, err := ge.gisCache.GetPosition("*", assetName)
+	if err != nil || position == nil {
+		err := errors.New("Asset has no geo location")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	srcLongStr := strconv.FormatFloat(float64(position.Longitude), 'f', -1, 32)
+	srcLatStr := strconv.FormatFloat(float64(position.Latitude), 'f', -1, 32)
+
+	// Retrieve Within Polygon parameters from request body
+	var withinPolygonParam TargetPolygon
+	if r.Body == nil {
+		err := errors.New("Request body is missing")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&withinPolygonParam)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate polygon
+	polygon := withinPolygonParam.Polygon
+	if polygon == nil || polygon.Type_ != "Polygon" || len(polygon.Coordinates) == 0 {
+		err := errors.New("Invalid polygon")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, ring := range polygon.Coordinates {
+		if len(ring) < 4 {
+			err := errors.New("Invalid polygon: linear ring must have at least 4 positions")
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if withinPolygonParam.Distance < 0 {
+		err := errors.New("Invalid distance")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	polygonStr, err := json.Marshal(polygon)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	srcCoordinates := "(" + srcLongStr + " " + srcLatStr + ")"
+	distance := strconv.FormatFloat(float64(withinPolygonParam.Distance), 'f', -1, 32)
+
+	withinPolygon, err := ge.assetMgr.GetWithinPolygonDistance(srcCoordinates, string(polygonStr), distance)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Create Response to return
+	var resp WithinPolygon
+	resp.Within = withinPolygon
+	resp.SrcLongitude = position.Longitude
+	resp.SrcLatitude = position.Latitude
+
+	// Format response
+	jsonResponse, err := json.Marshal(&resp)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
 func geGetGeoDataByName(w http.ResponseWriter, r *http.Request) {
 
 	// Get asset name from request path parameters
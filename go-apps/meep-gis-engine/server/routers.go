@@ -149,6 +149,13 @@ var routes = Routes{
 		GetWithinRangeByName,
 	},
 
+	Route{
+		"GetWithinPolygonByName",
+		strings.ToUpper("Post"),
+		"/gis/v1/geodata/{assetName}/withinPolygon",
+		GetWithinPolygonByName,
+	},
+
 	Route{
 		"UpdateGeoDataByName",
 		strings.ToUpper("Post"),
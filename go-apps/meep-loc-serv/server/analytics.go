@@ -0,0 +1,554 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	met "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metrics"
+)
+
+const defaultAnalyticsDuration = 3600
+const defaultOccupancyInterval = 60
+const maxOccupancyIntervals = 1440
+const defaultDwellTimeBinSize = 60
+const defaultHeatmapGridSize = 10
+const maxHeatmapGridSize = 100
+
+// Time at which each address entered its current zone & access point; used to compute dwell times
+var addrZoneEntryTime = map[string]time.Time{}
+var addrApEntryTime = map[string]time.Time{}
+
+type occupancySample struct {
+	time  time.Time
+	users int32
+}
+
+func resetAnalytics() {
+	addrZoneEntryTime = map[string]time.Time{}
+	addrApEntryTime = map[string]time.Time{}
+}
+
+func recordUserPresence(address string, oldZoneId string, zoneId string, oldApId string, apId string) {
+	if metricStore == nil {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	now := time.Now()
+	if zoneId != oldZoneId {
+		if oldZoneId != "" {
+			dwellTime := getDwellTime(addrZoneEntryTime, address, now)
+			setPresenceMetric(met.PresMetScopeZone, oldZoneId, oldApId, address, met.PresMetEventLeaving, dwellTime)
+		}
+		if zoneId != "" {
+			addrZoneEntryTime[address] = now
+			setPresenceMetric(met.PresMetScopeZone, zoneId, apId, address, met.PresMetEventEntering, 0)
+		}
+	}
+	if apId != oldApId {
+		if oldApId != "" {
+			dwellTime := getDwellTime(addrApEntryTime, address, now)
+			setPresenceMetric(met.PresMetScopePoa, oldZoneId, oldApId, address, met.PresMetEventLeaving, dwellTime)
+		}
+		if apId != "" {
+			addrApEntryTime[address] = now
+			setPresenceMetric(met.PresMetScopePoa, zoneId, apId, address, met.PresMetEventEntering, 0)
+		}
+	}
+}
+
+// getDwellTime - Returns the time (in seconds) spent since entry; 0 if entry time is unknown
+func getDwellTime(entryTimeMap map[string]time.Time, address string, now time.Time) float64 {
+	entryTime, found := entryTimeMap[address]
+	if !found {
+		return 0
+	}
+	delete(entryTimeMap, address)
+	return now.Sub(entryTime).Seconds()
+}
+
+func setPresenceMetric(scope string, zoneId string, apId string, address string, event string, dwellTime float64) {
+	var metric met.PresenceMetric
+	metric.Scope = scope
+	metric.Zone = zoneId
+	metric.Poa = apId
+	metric.Address = address
+	metric.Event = event
+	metric.DwellTime = dwellTime
+	err := metricStore.SetPresenceMetric(metric)
+	if err != nil {
+		log.Error("Failed to set presence metric: ", err.Error())
+	}
+}
+
+func recordUserPosition(address string, zoneId string, longitude *float32, latitude *float32) {
+	if metricStore == nil || longitude == nil || latitude == nil {
+		return
+	}
+
+	var metric met.PositionMetric
+	metric.Zone = zoneId
+	metric.Address = address
+	metric.Latitude = float64(*latitude)
+	metric.Longitude = float64(*longitude)
+	err := metricStore.SetPositionMetric(metric)
+	if err != nil {
+		log.Error("Failed to set position metric: ", err.Error())
+	}
+}
+
+func recordOccupancy(scope string, zoneId string, apId string, previousNbUsers int32, nbUsers int) {
+	if metricStore == nil || nbUsers == -1 || int32(nbUsers) == previousNbUsers {
+		return
+	}
+
+	var metric met.OccupancyMetric
+	metric.Scope = scope
+	metric.Zone = zoneId
+	metric.Poa = apId
+	metric.Users = int32(nbUsers)
+	err := metricStore.SetOccupancyMetric(metric)
+	if err != nil {
+		log.Error("Failed to set occupancy metric: ", err.Error())
+	}
+}
+
+func occupancyGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Retrieve query parameters
+	q := r.URL.Query()
+	err := validateAnalyticsQueryParams(q, []string{"zoneId", "accessPointId", "duration", "interval"})
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	zoneId := q.Get("zoneId")
+	apId := q.Get("accessPointId")
+	if zoneId == "" {
+		log.Error("Mandatory zoneId parameter not present")
+		errHandlerProblemDetails(w, "Mandatory zoneId parameter not present", http.StatusBadRequest)
+		return
+	}
+	duration, err := getAnalyticsIntParam(q, "duration", defaultAnalyticsDuration)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	interval, err := getAnalyticsIntParam(q, "interval", defaultOccupancyInterval)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	count := (duration + interval - 1) / interval
+	if count > maxOccupancyIntervals {
+		err := errors.New("Too many intervals: maximum is " + strconv.Itoa(maxOccupancyIntervals))
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if metricStore == nil {
+		log.Error("Metric store not available")
+		errHandlerProblemDetails(w, "Metric store not available", http.StatusInternalServerError)
+		return
+	}
+
+	scope := met.OccMetScopeZone
+	if apId != "" {
+		scope = met.OccMetScopePoa
+	}
+
+	// Last sample before the period is needed to determine the number of users at the start of the period
+	intervalDuration := time.Duration(interval) * time.Second
+	start := time.Now().Add(-time.Duration(count) * intervalDuration)
+	samples, err := metricStore.GetOccupancyMetricSince(scope, zoneId, apId, start)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	events, err := metricStore.GetPresenceMetric(scope, zoneId, apId, strconv.Itoa(count*interval)+"s", 0)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var response InlineOccupancyAnalytics
+	var occupancy OccupancyAnalytics
+	occupancy.ZoneId = zoneId
+	occupancy.AccessPointId = apId
+	occupancy.Interval = int32(interval)
+	occupancy.ResourceURL = hostUrl.String() + basePath + "analytics/occupancy"
+	occupancy.OccupancyInterval = computeOccupancyIntervals(samples, events, start, intervalDuration, count)
+	response.OccupancyAnalytics = &occupancy
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func dwellTimeGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Retrieve query parameters
+	q := r.URL.Query()
+	err := validateAnalyticsQueryParams(q, []string{"zoneId", "accessPointId", "duration", "binSize"})
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	zoneId := q.Get("zoneId")
+	apId := q.Get("accessPointId")
+	if zoneId == "" {
+		log.Error("Mandatory zoneId parameter not present")
+		errHandlerProblemDetails(w, "Mandatory zoneId parameter not present", http.StatusBadRequest)
+		return
+	}
+	duration, err := getAnalyticsIntParam(q, "duration", defaultAnalyticsDuration)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	binSize, err := getAnalyticsIntParam(q, "binSize", defaultDwellTimeBinSize)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if metricStore == nil {
+		log.Error("Metric store not available")
+		errHandlerProblemDetails(w, "Metric store not available", http.StatusInternalServerError)
+		return
+	}
+
+	scope := met.PresMetScopeZone
+	if apId != "" {
+		scope = met.PresMetScopePoa
+	}
+	events, err := metricStore.GetPresenceMetric(scope, zoneId, apId, strconv.Itoa(duration)+"s", 0)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var response InlineDwellTimeAnalytics
+	dwellTime := computeDwellTimeAnalytics(events, int32(binSize))
+	dwellTime.ZoneId = zoneId
+	dwellTime.AccessPointId = apId
+	dwellTime.ResourceURL = hostUrl.String() + basePath + "analytics/dwellTime"
+	response.DwellTimeAnalytics = dwellTime
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func heatmapGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Retrieve query parameters
+	q := r.URL.Query()
+	err := validateAnalyticsQueryParams(q, []string{"minLatitude", "minLongitude", "maxLatitude", "maxLongitude", "rows", "columns", "zoneId", "duration"})
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var heatmap HeatmapAnalytics
+	bounds := []*float32{&heatmap.MinLatitude, &heatmap.MinLongitude, &heatmap.MaxLatitude, &heatmap.MaxLongitude}
+	for index, name := range []string{"minLatitude", "minLongitude", "maxLatitude", "maxLongitude"} {
+		if q.Get(name) == "" {
+			log.Error("Mandatory " + name + " parameter not present")
+			errHandlerProblemDetails(w, "Mandatory "+name+" parameter not present", http.StatusBadRequest)
+			return
+		}
+		value, err := strconv.ParseFloat(q.Get(name), 32)
+		if err != nil {
+			log.Error("Invalid " + name + " parameter value")
+			errHandlerProblemDetails(w, "Invalid "+name+" parameter value", http.StatusBadRequest)
+			return
+		}
+		*bounds[index] = float32(value)
+	}
+	if heatmap.MinLatitude >= heatmap.MaxLatitude || heatmap.MinLongitude >= heatmap.MaxLongitude {
+		log.Error("Invalid bounding box")
+		errHandlerProblemDetails(w, "Invalid bounding box", http.StatusBadRequest)
+		return
+	}
+	rows, err := getAnalyticsIntParam(q, "rows", defaultHeatmapGridSize)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	columns, err := getAnalyticsIntParam(q, "columns", defaultHeatmapGridSize)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if rows > maxHeatmapGridSize || columns > maxHeatmapGridSize {
+		err := errors.New("Grid too large: maximum is " + strconv.Itoa(maxHeatmapGridSize) + " rows and columns")
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	duration, err := getAnalyticsIntParam(q, "duration", defaultAnalyticsDuration)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if metricStore == nil {
+		log.Error("Metric store not available")
+		errHandlerProblemDetails(w, "Metric store not available", http.StatusInternalServerError)
+		return
+	}
+
+	positions, err := metricStore.GetPositionMetric(q.Get("zoneId"), strconv.Itoa(duration)+"s", 0)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var response InlineHeatmapAnalytics
+	heatmap.Rows = int32(rows)
+	heatmap.Columns = int32(columns)
+	heatmap.Cell, heatmap.NumberOfSamples = computeHeatmap(positions, heatmap.MinLatitude, heatmap.MinLongitude, heatmap.MaxLatitude, heatmap.MaxLongitude, rows, columns)
+	heatmap.ResourceURL = hostUrl.String() + basePath + "analytics/heatmap"
+	response.HeatmapAnalytics = &heatmap
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func validateAnalyticsQueryParams(q url.Values, validQueryParams []string) error {
+	for queryParam := range q {
+		found := false
+		for _, validQueryParam := range validQueryParams {
+			if queryParam == validQueryParam {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New("Query param not valid: " + queryParam)
+		}
+	}
+	return nil
+}
+
+func getAnalyticsIntParam(q url.Values, name string, defaultValue int) (int, error) {
+	valueStr := q.Get(name)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil || value <= 0 {
+		return 0, errors.New("Invalid " + name + " parameter value")
+	}
+	return value, nil
+}
+
+// computeOccupancyIntervals - Computes per-interval occupancy from user count samples & presence events
+func computeOccupancyIntervals(samples []met.OccupancyMetric, events []met.PresenceMetric, start time.Time, interval time.Duration, count int) []OccupancyInterval {
+	// Sort user count samples chronologically
+	sortedSamples := []occupancySample{}
+	for _, sample := range samples {
+		sampleTime, err := parseMetricTime(sample.Time)
+		if err != nil {
+			continue
+		}
+		sortedSamples = append(sortedSamples, occupancySample{sampleTime, sample.Users})
+	}
+	sort.Slice(sortedSamples, func(i, j int) bool {
+		return sortedSamples[i].time.Before(sortedSamples[j].time)
+	})
+
+	// Get number of users at the start of the period
+	index := 0
+	users := int32(0)
+	for index < len(sortedSamples) && !sortedSamples[index].time.After(start) {
+		users = sortedSamples[index].users
+		index++
+	}
+
+	intervals := make([]OccupancyInterval, count)
+	for i := range intervals {
+		intervalStart := start.Add(time.Duration(i) * interval)
+		intervalEnd := intervalStart.Add(interval)
+		occupancyInterval := &intervals[i]
+		occupancyInterval.Timestamp = &TimeStamp{Seconds: int32(intervalStart.Unix())}
+		occupancyInterval.MinUsers = users
+		occupancyInterval.MaxUsers = users
+
+		// Compute time-weighted average using user count changes within the interval
+		weightedUsers := 0.0
+		lastChange := intervalStart
+		for index < len(sortedSamples) && sortedSamples[index].time.Before(intervalEnd) {
+			weightedUsers += float64(users) * sortedSamples[index].time.Sub(lastChange).Seconds()
+			lastChange = sortedSamples[index].time
+			users = sortedSamples[index].users
+			index++
+			if users < occupancyInterval.MinUsers {
+				occupancyInterval.MinUsers = users
+			}
+			if users > occupancyInterval.MaxUsers {
+				occupancyInterval.MaxUsers = users
+			}
+		}
+		weightedUsers += float64(users) * intervalEnd.Sub(lastChange).Seconds()
+		occupancyInterval.AvgUsers = float32(weightedUsers / interval.Seconds())
+	}
+
+	// Count entries & exits per interval
+	for _, event := range events {
+		eventTime, err := parseMetricTime(event.Time)
+		if err != nil || eventTime.Before(start) {
+			continue
+		}
+		i := int(eventTime.Sub(start) / interval)
+		if i >= count {
+			continue
+		}
+		switch event.Event {
+		case met.PresMetEventEntering:
+			intervals[i].Entries++
+		case met.PresMetEventLeaving:
+			intervals[i].Exits++
+		}
+	}
+	return intervals
+}
+
+// computeDwellTimeAnalytics - Computes dwell time statistics & histogram from leaving presence events
+func computeDwellTimeAnalytics(events []met.PresenceMetric, binSize int32) *DwellTimeAnalytics {
+	var dwellTime DwellTimeAnalytics
+	dwellTime.BinSize = binSize
+
+	// Ignore visits with unknown dwell time (e.g. entry not observed)
+	dwellTimes := []float64{}
+	for _, event := range events {
+		if event.Event == met.PresMetEventLeaving && event.DwellTime > 0 {
+			dwellTimes = append(dwellTimes, event.DwellTime)
+		}
+	}
+	if len(dwellTimes) == 0 {
+		return &dwellTime
+	}
+	sort.Float64s(dwellTimes)
+
+	total := 0.0
+	for _, value := range dwellTimes {
+		total += value
+	}
+	nbVisits := len(dwellTimes)
+	dwellTime.NumberOfVisits = int32(nbVisits)
+	dwellTime.MinDwellTime = float32(dwellTimes[0])
+	dwellTime.MaxDwellTime = float32(dwellTimes[nbVisits-1])
+	dwellTime.AvgDwellTime = float32(total / float64(nbVisits))
+	if nbVisits%2 == 1 {
+		dwellTime.MedianDwellTime = float32(dwellTimes[nbVisits/2])
+	} else {
+		dwellTime.MedianDwellTime = float32((dwellTimes[nbVisits/2-1] + dwellTimes[nbVisits/2]) / 2)
+	}
+
+	// Build histogram
+	nbBins := int(math.Floor(dwellTimes[nbVisits-1]/float64(binSize))) + 1
+	dwellTime.DwellTimeBin = make([]DwellTimeBin, nbBins)
+	for i := range dwellTime.DwellTimeBin {
+		dwellTime.DwellTimeBin[i].LowerBound = int32(i) * binSize
+		dwellTime.DwellTimeBin[i].UpperBound = int32(i+1) * binSize
+	}
+	for _, value := range dwellTimes {
+		dwellTime.DwellTimeBin[int(value/float64(binSize))].Count++
+	}
+	return &dwellTime
+}
+
+// computeHeatmap - Counts position samples per grid cell over the bounding box
+func computeHeatmap(positions []met.PositionMetric, minLat float32, minLong float32, maxLat float32, maxLong float32, rows int, columns int) ([][]int32, int32) {
+	cells := make([][]int32, rows)
+	for row := range cells {
+		cells[row] = make([]int32, columns)
+	}
+
+	nbSamples := int32(0)
+	latStep := float64(maxLat-minLat) / float64(rows)
+	longStep := float64(maxLong-minLong) / float64(columns)
+	for _, position := range positions {
+		if position.Latitude < float64(minLat) || position.Latitude > float64(maxLat) ||
+			position.Longitude < float64(minLong) || position.Longitude > float64(maxLong) {
+			continue
+		}
+		row := int((position.Latitude - float64(minLat)) / latStep)
+		if row >= rows {
+			row = rows - 1
+		}
+		column := int((position.Longitude - float64(minLong)) / longStep)
+		if column >= columns {
+			column = columns - 1
+		}
+		cells[row][column]++
+		nbSamples++
+	}
+	return cells, nbSamples
+}
+
+func parseMetricTime(metricTime interface{}) (time.Time, error) {
+	timeStr, ok := metricTime.(string)
+	if !ok {
+		return time.Time{}, errors.New("Invalid metric time")
+	}
+	return time.Parse(time.RFC3339, timeStr)
+}
@@ -55,6 +55,26 @@ func AreaCircleSubPUT(w http.ResponseWriter, r *http.Request) {
 	areaCircleSubPut(w, r)
 }
 
+func AreaPolygonSubDELETE(w http.ResponseWriter, r *http.Request) {
+	areaPolygonSubDelete(w, r)
+}
+
+func AreaPolygonSubGET(w http.ResponseWriter, r *http.Request) {
+	areaPolygonSubGet(w, r)
+}
+
+func AreaPolygonSubListGET(w http.ResponseWriter, r *http.Request) {
+	areaPolygonSubListGet(w, r)
+}
+
+func AreaPolygonSubPOST(w http.ResponseWriter, r *http.Request) {
+	areaPolygonSubPost(w, r)
+}
+
+func AreaPolygonSubPUT(w http.ResponseWriter, r *http.Request) {
+	areaPolygonSubPut(w, r)
+}
+
 func DistanceGET(w http.ResponseWriter, r *http.Request) {
 	distanceGet(w, r)
 }
@@ -79,10 +99,22 @@ func DistanceSubPUT(w http.ResponseWriter, r *http.Request) {
 	distanceSubPut(w, r)
 }
 
+func DwellTimeGET(w http.ResponseWriter, r *http.Request) {
+	dwellTimeGet(w, r)
+}
+
+func HeatmapGET(w http.ResponseWriter, r *http.Request) {
+	heatmapGet(w, r)
+}
+
 func Mec011AppTerminationPOST(w http.ResponseWriter, r *http.Request) {
 	mec011AppTerminationPost(w, r)
 }
 
+func OccupancyGET(w http.ResponseWriter, r *http.Request) {
+	occupancyGet(w, r)
+}
+
 func PeriodicSubDELETE(w http.ResponseWriter, r *http.Request) {
 	periodicSubDelete(w, r)
 }
@@ -19,6 +19,7 @@ package server
 import (
 	"encoding/json"
 
+	gisClient "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-gis-engine-client"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 )
 
@@ -217,6 +218,27 @@ func convertAreaCircleSubscriptionToJson(circleSubs *CircleNotificationSubscript
 	return string(jsonInfo)
 }
 
+func convertAreaPolygonSubscriptionToJson(polygonSubs *PolygonNotificationSubscription) string {
+
+	jsonInfo, err := json.Marshal(*polygonSubs)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertPolygonToGisPolygon(polygon *Polygon) *gisClient.Polygon {
+	if polygon == nil {
+		return nil
+	}
+	var gisPolygon gisClient.Polygon
+	gisPolygon.Type_ = polygon.Type_
+	gisPolygon.Coordinates = polygon.Coordinates
+	return &gisPolygon
+}
+
 /*
 func convertJsonToAreaCircleSubscription(jsonInfo string) *CircleNotificationSubscription {
 
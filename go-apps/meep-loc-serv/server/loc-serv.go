@@ -31,6 +31,7 @@ import (
 	"time"
 
 	sbi "github.com/InterDigitalInc/AdvantEDGE/go-apps/meep-loc-serv/sbi"
+	v3 "github.com/InterDigitalInc/AdvantEDGE/go-apps/meep-loc-serv/server/v3"
 	asc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-app-support-client"
 	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
 	gisClient "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-gis-engine-client"
@@ -62,6 +63,7 @@ const typeUserSubscription = "usersubs"
 const typeZoneStatusSubscription = "zonestatus"
 const typeDistanceSubscription = "distance"
 const typeAreaCircleSubscription = "areacircle"
+const typeAreaPolygonSubscription = "areapolygon"
 const typePeriodicSubscription = "periodic"
 
 const (
@@ -92,6 +94,7 @@ var nextUserSubscriptionIdAvailable int
 var nextZoneStatusSubscriptionIdAvailable int
 var nextDistanceSubscriptionIdAvailable int
 var nextAreaCircleSubscriptionIdAvailable int
+var nextAreaPolygonSubscriptionIdAvailable int
 var nextPeriodicSubscriptionIdAvailable int
 
 var zonalSubscriptionEnteringMap = map[int]string{}
@@ -108,6 +111,7 @@ var zoneStatusSubscriptionMap = map[int]*ZoneStatusCheck{}
 var distanceSubscriptionMap = map[int]*DistanceCheck{}
 var periodicTicker *time.Ticker
 var areaCircleSubscriptionMap = map[int]*AreaCircleCheck{}
+var areaPolygonSubscriptionMap = map[int]*AreaPolygonCheck{}
 var periodicSubscriptionMap = map[int]*PeriodicCheck{}
 
 var addressConnectedMap = map[string]bool{}
@@ -136,6 +140,17 @@ type AreaCircleCheck struct {
 	Subscription           *CircleNotificationSubscription
 }
 
+type AreaPolygonCheck struct {
+	NextTts                int32 //next time to send, derived from frequency
+	AddrInArea             map[string]bool
+	AddrStateTime          map[string]int64 //time of last area state change, used for dwell time
+	AddrNotified           map[string]bool  //current area state already notified
+	AddrLocation           map[string]*gisClient.WithinPolygon
+	NbNotificationsSent    int32
+	NotificationCheckReady bool
+	Subscription           *PolygonNotificationSubscription
+}
+
 type PeriodicCheck struct {
 	NextTts      int32 //next time to send, derived from frequency
 	Subscription *PeriodicNotificationSubscription
@@ -162,6 +177,7 @@ var baseKey string
 var mutex sync.Mutex
 
 var gisAppClient *gisClient.APIClient
+var metricStore *met.MetricStore
 var gisAppClientUrl string = "http://meep-gis-engine"
 
 const serviceAppVersion = "2.2.1"
@@ -294,8 +310,23 @@ func Init() (err error) {
 	zoneStatusReInit()
 	distanceReInit()
 	areaCircleReInit()
+	areaPolygonReInit()
 	periodicReInit()
 
+	// Initialize Location API v3
+	v3Cfg := v3.ServiceCfg{
+		Sandbox:    sandboxName,
+		Mep:        mepName,
+		HostUrl:    hostUrl,
+		InstanceId: instanceId,
+		RedisAddr:  redisAddr,
+	}
+	err = v3.Init(v3Cfg)
+	if err != nil {
+		log.Error("Failed to initialize Location API v3. Error: ", err)
+		return err
+	}
+
 	// Initialize SBI
 	sbiCfg := sbi.SbiCfg{
 		ModuleName:     moduleName,
@@ -362,6 +393,7 @@ func Run() (err error) {
 		for range periodicTicker.C {
 			checkNotificationDistancePeriodicTrigger()
 			updateNotificationAreaCirclePeriodicTrigger()
+			updateNotificationAreaPolygonPeriodicTrigger()
 			checkNotificationPeriodicTrigger()
 		}
 	}()
@@ -989,6 +1021,159 @@ func checkNotificationAreaCircle(addressToCheck string) {
 	}
 }
 
+func updateNotificationAreaPolygonPeriodicTrigger() {
+	//only check if there is at least one subscription
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for subsId, areaPolygonCheck := range areaPolygonSubscriptionMap {
+		if areaPolygonCheck != nil && areaPolygonCheck.Subscription != nil {
+			if areaPolygonCheck.NextTts != 0 {
+				areaPolygonCheck.NextTts--
+			}
+			if areaPolygonCheck.NextTts == 0 {
+				areaPolygonCheck.NotificationCheckReady = true
+			} else {
+				areaPolygonCheck.NotificationCheckReady = false
+			}
+
+			//send pending notifications for which the dwell time has expired, or periodic inside notifications
+			for addr := range areaPolygonCheck.AddrInArea {
+				processNotificationAreaPolygon(subsId, areaPolygonCheck, addr)
+			}
+		}
+	}
+}
+
+func checkNotificationAreaPolygon(addressToCheck string) {
+	//only check if there is at least one subscription
+	mutex.Lock()
+	defer mutex.Unlock()
+	//check all that applies
+	for subsId, areaPolygonCheck := range areaPolygonSubscriptionMap {
+		if areaPolygonCheck != nil && areaPolygonCheck.Subscription != nil {
+			if areaPolygonCheck.Subscription.Count != 0 && areaPolygonCheck.NbNotificationsSent >= areaPolygonCheck.Subscription.Count {
+				continue
+			}
+
+			//loop through every reference address
+			for _, addr := range areaPolygonCheck.Subscription.Address {
+				if addr != addressToCheck {
+					continue
+				}
+				if !addressConnectedMap[addr] {
+					continue
+				}
+
+				//once inside, the hysteresis distance around the polygon is used to determine if the address left the area
+				inArea, found := areaPolygonCheck.AddrInArea[addr]
+				var withinPolygonParam gisClient.TargetPolygon
+				withinPolygonParam.Polygon = convertPolygonToGisPolygon(areaPolygonCheck.Subscription.Polygon)
+				if inArea {
+					withinPolygonParam.Distance = areaPolygonCheck.Subscription.Hysteresis
+				}
+
+				within := false
+				withinPolygonResp, httpResp, err := gisAppClient.GeospatialDataApi.GetWithinPolygonByName(context.TODO(), addr, withinPolygonParam)
+				if err != nil {
+					//getting element that is not in the DB (not in scenario, not connected) returns error code 400 (bad parameters) in the API. Using that error code to track that request made it to GIS but no good result, so consider the address outside the area
+					if httpResp == nil || httpResp.StatusCode != http.StatusBadRequest {
+						log.Error("Failed to communicate with gis engine: ", err)
+						return
+					}
+				} else {
+					within = withinPolygonResp.Within
+					areaPolygonCheck.AddrLocation[addr] = &withinPolygonResp
+				}
+
+				//check if there is a change; an address initially outside the area is not a leaving event
+				if !found || within != inArea {
+					areaPolygonCheck.AddrInArea[addr] = within
+					areaPolygonCheck.AddrStateTime[addr] = time.Now().Unix()
+					areaPolygonCheck.AddrNotified[addr] = !found && !within
+				}
+
+				processNotificationAreaPolygon(subsId, areaPolygonCheck, addr)
+			}
+		}
+	}
+}
+
+func processNotificationAreaPolygon(subsId int, areaPolygonCheck *AreaPolygonCheck, addr string) {
+	subscription := areaPolygonCheck.Subscription
+	if subscription.Count != 0 && areaPolygonCheck.NbNotificationsSent >= subscription.Count {
+		return
+	}
+	if !areaPolygonCheck.NotificationCheckReady {
+		return
+	}
+	inArea, found := areaPolygonCheck.AddrInArea[addr]
+	if !found {
+		return
+	}
+
+	//address must remain in its current state for the dwell time before being notified
+	if time.Now().Unix()-areaPolygonCheck.AddrStateTime[addr] < int64(subscription.DwellTime) {
+		return
+	}
+
+	switch *subscription.EnteringLeavingCriteria {
+	case ENTERING_EnteringLeavingCriteria:
+		if !inArea || areaPolygonCheck.AddrNotified[addr] {
+			return
+		}
+	case LEAVING_EnteringLeavingCriteria:
+		if inArea || areaPolygonCheck.AddrNotified[addr] {
+			return
+		}
+	case INSIDE_EnteringLeavingCriteria:
+		//sent periodically, based on frequency, while inside the area
+		if !inArea {
+			return
+		}
+	default:
+		return
+	}
+
+	subsIdStr := strconv.Itoa(subsId)
+	var areaPolygonNotif SubscriptionNotification
+
+	areaPolygonNotif.EnteringLeavingCriteria = subscription.EnteringLeavingCriteria
+	areaPolygonNotif.IsFinalNotification = false
+	areaPolygonNotif.Link = subscription.Link
+	var terminalLocationList []TerminalLocation
+	var terminalLocation TerminalLocation
+	terminalLocation.Address = addr
+	seconds := time.Now().Unix()
+	var timestamp TimeStamp
+	timestamp.Seconds = int32(seconds)
+	if location := areaPolygonCheck.AddrLocation[addr]; location != nil {
+		var locationInfo LocationInfo
+		locationInfo.Latitude = append(locationInfo.Latitude, location.SrcLatitude)
+		locationInfo.Longitude = append(locationInfo.Longitude, location.SrcLongitude)
+		locationInfo.Shape = 2
+		locationInfo.Timestamp = &timestamp
+		terminalLocation.CurrentLocation = &locationInfo
+		retrievalStatus := RETRIEVED_RetrievalStatus
+		terminalLocation.LocationRetrievalStatus = &retrievalStatus
+	} else {
+		retrievalStatus := NOT_RETRIEVED_RetrievalStatus
+		terminalLocation.LocationRetrievalStatus = &retrievalStatus
+	}
+	terminalLocationList = append(terminalLocationList, terminalLocation)
+
+	areaPolygonNotif.TerminalLocation = terminalLocationList
+	areaPolygonNotif.CallbackData = subscription.CallbackReference.CallbackData
+	var inlinePolygonSubscriptionNotification InlineSubscriptionNotification
+	inlinePolygonSubscriptionNotification.SubscriptionNotification = &areaPolygonNotif
+	areaPolygonCheck.AddrNotified[addr] = true
+	areaPolygonCheck.NbNotificationsSent++
+	sendSubscriptionNotification(subscription.CallbackReference.NotifyURL, inlinePolygonSubscriptionNotification)
+	log.Info("Area Polygon Notification" + "(" + subsIdStr + ") For " + addr + " when " + string(*subscription.EnteringLeavingCriteria) + " area")
+	areaPolygonCheck.NextTts = subscription.Frequency
+	areaPolygonCheck.NotificationCheckReady = false
+}
+
 func checkNotificationPeriodicTrigger() {
 
 	//only check if there is at least one subscription
@@ -1118,6 +1303,42 @@ func registerAreaCircle(areaCircleSub *CircleNotificationSubscription, subsIdStr
 	areaCircleSubscriptionMap[subsId] = &areaCircleCheck
 }
 
+func deregisterAreaPolygon(subsIdStr string) {
+	subsId, err := strconv.Atoi(subsIdStr)
+	if err != nil {
+		log.Error(err)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	areaPolygonSubscriptionMap[subsId] = nil
+}
+
+func registerAreaPolygon(areaPolygonSub *PolygonNotificationSubscription, subsIdStr string) {
+
+	subsId, err := strconv.Atoi(subsIdStr)
+	if err != nil {
+		log.Error(err)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	areaPolygonSubscriptionMap[subsId] = newAreaPolygonCheck(areaPolygonSub)
+}
+
+func newAreaPolygonCheck(areaPolygonSub *PolygonNotificationSubscription) *AreaPolygonCheck {
+	var areaPolygonCheck AreaPolygonCheck
+	areaPolygonCheck.Subscription = areaPolygonSub
+	areaPolygonCheck.NbNotificationsSent = 0
+	areaPolygonCheck.AddrInArea = map[string]bool{}
+	areaPolygonCheck.AddrStateTime = map[string]int64{}
+	areaPolygonCheck.AddrNotified = map[string]bool{}
+	areaPolygonCheck.AddrLocation = map[string]*gisClient.WithinPolygon{}
+	//checkImmediate ignored, will be hit on next check anyway
+	areaPolygonCheck.NextTts = 0 //next time periodic trigger hits, will be forced to trigger
+	return &areaPolygonCheck
+}
+
 func deregisterPeriodic(subsIdStr string) {
 	subsId, err := strconv.Atoi(subsIdStr)
 	if err != nil {
@@ -2147,6 +2368,10 @@ func areaCircleSubPost(w http.ResponseWriter, r *http.Request) {
 	} else {
 		switch *areaCircleSub.EnteringLeavingCriteria {
 		case ENTERING_EnteringLeavingCriteria, LEAVING_EnteringLeavingCriteria:
+		case INSIDE_EnteringLeavingCriteria:
+			log.Error("Inside EnteringLeavingCriteria only supported by area polygon subscriptions")
+			errHandlerProblemDetails(w, "Inside EnteringLeavingCriteria only supported by area polygon subscriptions", http.StatusBadRequest)
+			return
 		default:
 			log.Error("Invalid Mandatory EnteringLeavingCriteria parameter value")
 			errHandlerProblemDetails(w, "Invalid Mandatory EnteringLeavingCriteria parameter value", http.StatusBadRequest)
@@ -2258,6 +2483,18 @@ func areaCircleSubPut(w http.ResponseWriter, r *http.Request) {
 		log.Error("Mandatory EnteringLeavingCriteria parameter not present")
 		errHandlerProblemDetails(w, "Mandatory EnteringLeavingCriteria parameter not present", http.StatusBadRequest)
 		return
+	} else {
+		switch *areaCircleSub.EnteringLeavingCriteria {
+		case ENTERING_EnteringLeavingCriteria, LEAVING_EnteringLeavingCriteria:
+		case INSIDE_EnteringLeavingCriteria:
+			log.Error("Inside EnteringLeavingCriteria only supported by area polygon subscriptions")
+			errHandlerProblemDetails(w, "Inside EnteringLeavingCriteria only supported by area polygon subscriptions", http.StatusBadRequest)
+			return
+		default:
+			log.Error("Invalid Mandatory EnteringLeavingCriteria parameter value")
+			errHandlerProblemDetails(w, "Invalid Mandatory EnteringLeavingCriteria parameter value", http.StatusBadRequest)
+			return
+		}
 	}
 	if areaCircleSub.Frequency == 0 {
 		log.Error("Mandatory Frequency parameter not present")
@@ -2346,6 +2583,350 @@ func populateAreaCircleList(key string, jsonInfo string, userData interface{}) e
 	return nil
 }
 
+func areaPolygonSubDelete(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	present, _ := rc.JSONGetEntry(baseKey+typeAreaPolygonSubscription+":"+vars["subscriptionId"], ".")
+	if present == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := rc.JSONDelEntry(baseKey+typeAreaPolygonSubscription+":"+vars["subscriptionId"], ".")
+	if err != nil {
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	deregisterAreaPolygon(vars["subscriptionId"])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func areaPolygonSubListGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineNotificationSubscriptionList
+	var areaPolygonSubList NotificationSubscriptionList
+	areaPolygonSubList.ResourceURL = hostUrl.String() + basePath + "subscriptions/area/polygon"
+	response.NotificationSubscriptionList = &areaPolygonSubList
+
+	keyName := baseKey + typeAreaPolygonSubscription + "*"
+	err := rc.ForEachJSONEntry(keyName, populateAreaPolygonList, &areaPolygonSubList)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func areaPolygonSubGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	var response InlinePolygonNotificationSubscription
+	var areaPolygonSub PolygonNotificationSubscription
+	response.PolygonNotificationSubscription = &areaPolygonSub
+	jsonAreaPolygonSub, _ := rc.JSONGetEntry(baseKey+typeAreaPolygonSubscription+":"+vars["subscriptionId"], ".")
+	if jsonAreaPolygonSub == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonAreaPolygonSub), &areaPolygonSub)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func areaPolygonSubPost(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	var response InlinePolygonNotificationSubscription
+
+	var body InlinePolygonNotificationSubscription
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	areaPolygonSub := body.PolygonNotificationSubscription
+
+	if areaPolygonSub == nil {
+		log.Error("Body not present")
+		errHandlerProblemDetails(w, "Body not present", http.StatusBadRequest)
+		return
+	}
+
+	//checking for mandatory properties
+	if areaPolygonSub.CallbackReference == nil || areaPolygonSub.CallbackReference.NotifyURL == "" {
+		log.Error("Mandatory CallbackReference parameter not present")
+		errHandlerProblemDetails(w, "Mandatory CallbackReference parameter not present", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.Address == nil {
+		log.Error("Mandatory Address parameter not present")
+		errHandlerProblemDetails(w, "Mandatory Address parameter not present", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.Polygon == nil {
+		log.Error("Mandatory Polygon parameter not present")
+		errHandlerProblemDetails(w, "Mandatory Polygon parameter not present", http.StatusBadRequest)
+		return
+	}
+	err = validatePolygon(areaPolygonSub.Polygon)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.DwellTime < 0 {
+		log.Error("Invalid DwellTime parameter value")
+		errHandlerProblemDetails(w, "Invalid DwellTime parameter value", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.Hysteresis < 0 {
+		log.Error("Invalid Hysteresis parameter value")
+		errHandlerProblemDetails(w, "Invalid Hysteresis parameter value", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.EnteringLeavingCriteria == nil {
+		log.Error("Mandatory EnteringLeavingCriteria parameter not present")
+		errHandlerProblemDetails(w, "Mandatory EnteringLeavingCriteria parameter not present", http.StatusBadRequest)
+		return
+	} else {
+		switch *areaPolygonSub.EnteringLeavingCriteria {
+		case ENTERING_EnteringLeavingCriteria, LEAVING_EnteringLeavingCriteria, INSIDE_EnteringLeavingCriteria:
+		default:
+			log.Error("Invalid Mandatory EnteringLeavingCriteria parameter value")
+			errHandlerProblemDetails(w, "Invalid Mandatory EnteringLeavingCriteria parameter value", http.StatusBadRequest)
+			return
+		}
+	}
+	if areaPolygonSub.Frequency == 0 {
+		log.Error("Mandatory Frequency parameter not present")
+		errHandlerProblemDetails(w, "Mandatory Frequency parameter not present", http.StatusBadRequest)
+		return
+	}
+
+	newSubsId := nextAreaPolygonSubscriptionIdAvailable
+	nextAreaPolygonSubscriptionIdAvailable++
+	subsIdStr := strconv.Itoa(newSubsId)
+	if areaPolygonSub.Duration != 0 {
+		//TODO start a timer mecanism and expire subscription
+		log.Info("Non zero duration")
+	}
+	//else, lasts forever or until subscription is deleted
+
+	areaPolygonSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/area/polygon/" + subsIdStr
+
+	_ = rc.JSONSetEntry(baseKey+typeAreaPolygonSubscription+":"+subsIdStr, ".", convertAreaPolygonSubscriptionToJson(areaPolygonSub))
+
+	registerAreaPolygon(areaPolygonSub, subsIdStr)
+
+	response.PolygonNotificationSubscription = areaPolygonSub
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func areaPolygonSubPut(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	var response InlinePolygonNotificationSubscription
+
+	var body InlinePolygonNotificationSubscription
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	areaPolygonSub := body.PolygonNotificationSubscription
+
+	if areaPolygonSub == nil {
+		log.Error("Body not present")
+		errHandlerProblemDetails(w, "Body not present", http.StatusBadRequest)
+		return
+	}
+
+	//checking for mandatory properties
+	if areaPolygonSub.CallbackReference == nil || areaPolygonSub.CallbackReference.NotifyURL == "" {
+		log.Error("Mandatory CallbackReference parameter not present")
+		errHandlerProblemDetails(w, "Mandatory CallbackReference parameter not present", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.Address == nil {
+		log.Error("Mandatory Address parameter not present")
+		errHandlerProblemDetails(w, "Mandatory Address parameter not present", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.Polygon == nil {
+		log.Error("Mandatory Polygon parameter not present")
+		errHandlerProblemDetails(w, "Mandatory Polygon parameter not present", http.StatusBadRequest)
+		return
+	}
+	err = validatePolygon(areaPolygonSub.Polygon)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.DwellTime < 0 {
+		log.Error("Invalid DwellTime parameter value")
+		errHandlerProblemDetails(w, "Invalid DwellTime parameter value", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.Hysteresis < 0 {
+		log.Error("Invalid Hysteresis parameter value")
+		errHandlerProblemDetails(w, "Invalid Hysteresis parameter value", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.EnteringLeavingCriteria == nil {
+		log.Error("Mandatory EnteringLeavingCriteria parameter not present")
+		errHandlerProblemDetails(w, "Mandatory EnteringLeavingCriteria parameter not present", http.StatusBadRequest)
+		return
+	} else {
+		switch *areaPolygonSub.EnteringLeavingCriteria {
+		case ENTERING_EnteringLeavingCriteria, LEAVING_EnteringLeavingCriteria, INSIDE_EnteringLeavingCriteria:
+		default:
+			log.Error("Invalid Mandatory EnteringLeavingCriteria parameter value")
+			errHandlerProblemDetails(w, "Invalid Mandatory EnteringLeavingCriteria parameter value", http.StatusBadRequest)
+			return
+		}
+	}
+	if areaPolygonSub.Frequency == 0 {
+		log.Error("Mandatory Frequency parameter not present")
+		errHandlerProblemDetails(w, "Mandatory Frequency parameter not present", http.StatusBadRequest)
+		return
+	}
+	if areaPolygonSub.ResourceURL == "" {
+		log.Error("Mandatory ResourceURL parameter not present")
+		errHandlerProblemDetails(w, "Mandatory ResourceURL parameter not present", http.StatusBadRequest)
+		return
+	}
+
+	subsIdParamStr := vars["subscriptionId"]
+
+	selfUrl := strings.Split(areaPolygonSub.ResourceURL, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	//body content not matching parameters
+	if subsIdStr != subsIdParamStr {
+		log.Error("SubscriptionId in endpoint and in body not matching")
+		errHandlerProblemDetails(w, "SubscriptionId in endpoint and in body not matching", http.StatusBadRequest)
+		return
+	}
+
+	areaPolygonSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/area/polygon/" + subsIdStr
+
+	subsId, err := strconv.Atoi(subsIdStr)
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if areaPolygonSubscriptionMap[subsId] == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	_ = rc.JSONSetEntry(baseKey+typeAreaPolygonSubscription+":"+subsIdStr, ".", convertAreaPolygonSubscriptionToJson(areaPolygonSub))
+
+	//store the dynamic states fo the subscription
+	prevAreaPolygonCheck := areaPolygonSubscriptionMap[subsId]
+	deregisterAreaPolygon(subsIdStr)
+	registerAreaPolygon(areaPolygonSub, subsIdStr)
+	areaPolygonSubscriptionMap[subsId].NbNotificationsSent = prevAreaPolygonCheck.NbNotificationsSent
+	areaPolygonSubscriptionMap[subsId].AddrInArea = prevAreaPolygonCheck.AddrInArea
+	areaPolygonSubscriptionMap[subsId].AddrStateTime = prevAreaPolygonCheck.AddrStateTime
+	areaPolygonSubscriptionMap[subsId].AddrNotified = prevAreaPolygonCheck.AddrNotified
+	areaPolygonSubscriptionMap[subsId].AddrLocation = prevAreaPolygonCheck.AddrLocation
+
+	response.PolygonNotificationSubscription = areaPolygonSub
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func populateAreaPolygonList(key string, jsonInfo string, userData interface{}) error {
+
+	areaPolygonList := userData.(*NotificationSubscriptionList)
+	var areaPolygonInfo PolygonNotificationSubscription
+
+	// Format response
+	err := json.Unmarshal([]byte(jsonInfo), &areaPolygonInfo)
+	if err != nil {
+		return err
+	}
+	areaPolygonList.PolygonNotificationSubscription = append(areaPolygonList.PolygonNotificationSubscription, areaPolygonInfo)
+	return nil
+}
+
+func validatePolygon(polygon *Polygon) error {
+	if polygon.Type_ != "Polygon" {
+		return errors.New("Invalid Polygon type")
+	}
+	if len(polygon.Coordinates) == 0 {
+		return errors.New("Invalid Polygon: no linear ring")
+	}
+	for _, ring := range polygon.Coordinates {
+		if len(ring) < 4 {
+			return errors.New("Invalid Polygon: linear ring must have at least 4 positions")
+		}
+		for _, position := range ring {
+			if len(position) != 2 {
+				return errors.New("Invalid Polygon: position must have longitude and latitude")
+			}
+			if position[0] < -180 || position[0] > 180 || position[1] < -90 || position[1] > 90 {
+				return errors.New("Invalid Polygon: position out of range")
+			}
+		}
+		first := ring[0]
+		last := ring[len(ring)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return errors.New("Invalid Polygon: linear ring must be closed")
+		}
+	}
+	return nil
+}
+
 func periodicSubDelete(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
@@ -3309,6 +3890,7 @@ func cleanUp() {
 	nextZoneStatusSubscriptionIdAvailable = 1
 	nextDistanceSubscriptionIdAvailable = 1
 	nextAreaCircleSubscriptionIdAvailable = 1
+	nextAreaPolygonSubscriptionIdAvailable = 1
 	nextPeriodicSubscriptionIdAvailable = 1
 
 	mutex.Lock()
@@ -3326,9 +3908,13 @@ func cleanUp() {
 	zoneStatusSubscriptionMap = map[int]*ZoneStatusCheck{}
 	distanceSubscriptionMap = map[int]*DistanceCheck{}
 	areaCircleSubscriptionMap = map[int]*AreaCircleCheck{}
+	areaPolygonSubscriptionMap = map[int]*AreaPolygonCheck{}
 	periodicSubscriptionMap = map[int]*PeriodicCheck{}
 
 	addressConnectedMap = map[string]bool{}
+	resetAnalytics()
+
+	v3.CleanUp()
 
 	updateStoreName("")
 }
@@ -3342,6 +3928,14 @@ func updateStoreName(storeName string) {
 			logComponent = moduleName + "-" + mepName
 		}
 		_ = httpLog.ReInit(logComponent, sandboxName, storeName, redisAddr, influxAddr)
+
+		// Connect to Metric Store
+		var err error
+		metricStore, err = met.NewMetricStore(storeName, sandboxName, influxAddr, redisAddr)
+		if err != nil {
+			log.Error("Failed connection to metric-store: ", err)
+			metricStore = nil
+		}
 	}
 }
 
@@ -3401,6 +3995,10 @@ func updateUserInfo(address string, zoneId string, accessPointId string, longitu
 	checkNotificationRegisteredUsers(oldZoneId, zoneId, oldApId, accessPointId, address)
 	checkNotificationRegisteredZones(oldZoneId, zoneId, oldApId, accessPointId, address)
 	checkNotificationAreaCircle(address)
+	checkNotificationAreaPolygon(address)
+	recordUserPresence(address, oldZoneId, zoneId, oldApId, accessPointId)
+	recordUserPosition(address, zoneId, longitude, latitude)
+	v3.UpdateUserInfo(address, zoneId, accessPointId, longitude, latitude)
 }
 
 func updateZoneInfo(zoneId string, nbAccessPoints int, nbUnsrvAccessPoints int, nbUsers int) {
@@ -3431,6 +4029,8 @@ func updateZoneInfo(zoneId string, nbAccessPoints int, nbUnsrvAccessPoints int,
 	// Update Zone info in DB & Send notifications
 	_ = rc.JSONSetEntry(baseKey+typeZone+":"+zoneId, ".", convertZoneInfoToJson(zoneInfo))
 	checkNotificationRegisteredZoneStatus(zoneId, "", int32(-1), int32(nbUsers), int32(-1), previousNbUsers)
+	recordOccupancy(met.OccMetScopeZone, zoneId, "", previousNbUsers, nbUsers)
+	v3.UpdateZoneInfo(zoneId, nbUsers)
 }
 
 func updateAccessPointInfo(zoneId string, apId string, conTypeStr string, opStatusStr string, nbUsers int, longitude *float32, latitude *float32) {
@@ -3487,6 +4087,8 @@ func updateAccessPointInfo(zoneId string, apId string, conTypeStr string, opStat
 	// Update AP info in DB & Send notifications
 	_ = rc.JSONSetEntry(baseKey+typeZone+":"+zoneId+":"+typeAccessPoint+":"+apId, ".", convertAccessPointInfoToJson(apInfo))
 	checkNotificationRegisteredZoneStatus(zoneId, apId, int32(nbUsers), int32(-1), previousNbUsers, int32(-1))
+	recordOccupancy(met.OccMetScopePoa, zoneId, apId, previousNbUsers, nbUsers)
+	v3.UpdateAccessPointInfo(zoneId, apId, opStatusStr, nbUsers)
 }
 
 func zoneStatusReInit() {
@@ -3676,6 +4278,36 @@ func areaCircleReInit() {
 	nextAreaCircleSubscriptionIdAvailable = maxAreaCircleSubscriptionId + 1
 }
 
+func areaPolygonReInit() {
+	//reusing the object response for the get multiple zonalSubscription
+	var areaPolygonList NotificationSubscriptionList
+
+	keyName := baseKey + typeAreaPolygonSubscription + "*"
+	_ = rc.ForEachJSONEntry(keyName, populateAreaPolygonList, &areaPolygonList)
+
+	maxAreaPolygonSubscriptionId := 0
+	mutex.Lock()
+	defer mutex.Unlock()
+	for i := range areaPolygonList.PolygonNotificationSubscription {
+		areaPolygonSub := &areaPolygonList.PolygonNotificationSubscription[i]
+		resourceUrl := strings.Split(areaPolygonSub.ResourceURL, "/")
+		subscriptionId, err := strconv.Atoi(resourceUrl[len(resourceUrl)-1])
+		if err != nil {
+			log.Error(err)
+		} else {
+			if subscriptionId > maxAreaPolygonSubscriptionId {
+				maxAreaPolygonSubscriptionId = subscriptionId
+			}
+			areaPolygonCheck := newAreaPolygonCheck(areaPolygonSub)
+			if !areaPolygonSub.CheckImmediate {
+				areaPolygonCheck.NextTts = areaPolygonSub.Frequency
+			}
+			areaPolygonSubscriptionMap[subscriptionId] = areaPolygonCheck
+		}
+	}
+	nextAreaPolygonSubscriptionIdAvailable = maxAreaPolygonSubscriptionId + 1
+}
+
 func periodicReInit() {
 	//reusing the object response for the get multiple zonalSubscription
 	var periodicList NotificationSubscriptionList
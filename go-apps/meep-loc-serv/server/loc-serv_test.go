@@ -1160,6 +1160,333 @@ func testAreaCircleSubscriptionDelete(t *testing.T, subscriptionId string, expec
 	}
 }
 
+func TestAreaPolygonSuccessSubscription(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testAreaPolygonSubscriptionPost(t)
+
+	//get
+	testAreaPolygonSubscriptionGet(t, strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testAreaPolygonSubscriptionPut(t, strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable-1), true)
+
+	//get
+	testAreaPolygonSubscriptionGet(t, strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable-1), expectedGetResp)
+
+	//delete
+	testAreaPolygonSubscriptionDelete(t, strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable-1), true)
+
+	terminateScenario()
+}
+
+func TestFailAreaPolygonSubscription(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testAreaPolygonSubscriptionGet(t, strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable), "")
+
+	//put
+	_ = testAreaPolygonSubscriptionPut(t, strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable), false)
+
+	//delete
+	testAreaPolygonSubscriptionDelete(t, strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable), false)
+
+	terminateScenario()
+}
+
+func testAreaPolygonSubscriptionPost(t *testing.T) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	requestAddress := []string{"ue1"}
+	requestClientCorrelator := "123"
+	requestCallbackReference := "myCallbackRef"
+	requestEnteringLeaving := INSIDE_EnteringLeavingCriteria
+	requestFrequency := int32(1)
+	requestPolygon := Polygon{"Polygon", [][][]float32{{{7.4215, 43.7362}, {7.4221, 43.7362}, {7.4221, 43.7368}, {7.4215, 43.7368}, {7.4215, 43.7362}}}}
+	requestDwellTime := int32(5)
+	requestHysteresis := float32(10)
+	requestDuration := int32(0)
+	requestImmediate := false
+	requestRequester := "requester"
+	requestResourceURL := "/" + testScenarioName + "/location/v2/subscriptions/area/polygon/" + strconv.Itoa(nextAreaPolygonSubscriptionIdAvailable)
+
+	expectedAreaPolygonSubscription := PolygonNotificationSubscription{requestAddress, &CallbackReference{"", nil, requestCallbackReference}, requestImmediate, requestClientCorrelator, 0, requestDwellTime, requestDuration, &requestEnteringLeaving, requestFrequency, requestHysteresis, nil, &requestPolygon, requestRequester, requestResourceURL}
+
+	expectedResponse := InlinePolygonNotificationSubscription{&expectedAreaPolygonSubscription}
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+	expectedBody := InlinePolygonNotificationSubscription{&expectedAreaPolygonSubscription}
+	body, err := json.Marshal(expectedBody)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/area/polygon", bytes.NewBuffer(body), nil, nil, http.StatusCreated, AreaPolygonSubPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlinePolygonNotificationSubscription
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testAreaPolygonSubscriptionPut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	requestAddress := []string{"ue1"}
+	requestClientCorrelator := "123"
+	requestCallbackReference := "myCallbackRef"
+	requestEnteringLeaving := INSIDE_EnteringLeavingCriteria
+	requestFrequency := int32(1)
+	requestPolygon := Polygon{"Polygon", [][][]float32{{{7.4215, 43.7362}, {7.4221, 43.7362}, {7.4221, 43.7368}, {7.4215, 43.7368}, {7.4215, 43.7362}}}}
+	requestDwellTime := int32(5)
+	requestHysteresis := float32(10)
+	requestDuration := int32(0)
+	requestImmediate := false
+	requestRequester := "requester"
+	requestResourceURL := "/" + testScenarioName + "/location/v2/subscriptions/area/polygon/" + subscriptionId
+
+	expectedAreaPolygonSubscription := PolygonNotificationSubscription{requestAddress, &CallbackReference{"", nil, requestCallbackReference}, requestImmediate, requestClientCorrelator, 0, requestDwellTime, requestDuration, &requestEnteringLeaving, requestFrequency, requestHysteresis, nil, &requestPolygon, requestRequester, requestResourceURL}
+
+	expectedResponse := InlinePolygonNotificationSubscription{&expectedAreaPolygonSubscription}
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	expectedBody := InlinePolygonNotificationSubscription{&expectedAreaPolygonSubscription}
+	body, err := json.Marshal(expectedBody)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/area/polygon", bytes.NewBuffer(body), vars, nil, http.StatusOK, AreaPolygonSubPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlinePolygonNotificationSubscription
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/area/polygonz", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, AreaPolygonSubPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testAreaPolygonSubscriptionGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/area/polygon", nil, vars, nil, http.StatusNotFound, AreaPolygonSubGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/area/polygon", nil, vars, nil, http.StatusOK, AreaPolygonSubGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlinePolygonNotificationSubscription
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testAreaPolygonSubscriptionDelete(t *testing.T, subscriptionId string, expectSuccess bool) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	returnCode := http.StatusNoContent
+	if !expectSuccess {
+		returnCode = http.StatusNotFound
+	}
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/area/polygon", nil, vars, nil, returnCode, AreaPolygonSubDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestValidatePolygon(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	ring := [][]float32{{7.4215, 43.7362}, {7.4221, 43.7362}, {7.4221, 43.7368}, {7.4215, 43.7368}, {7.4215, 43.7362}}
+
+	// Valid polygon
+	err := validatePolygon(&Polygon{"Polygon", [][][]float32{ring}})
+	if err != nil {
+		t.Fatalf("Valid polygon rejected: " + err.Error())
+	}
+
+	// Invalid type
+	err = validatePolygon(&Polygon{"LineString", [][][]float32{ring}})
+	if err == nil {
+		t.Fatalf("Invalid polygon type accepted")
+	}
+
+	// No linear ring
+	err = validatePolygon(&Polygon{"Polygon", nil})
+	if err == nil {
+		t.Fatalf("Polygon without linear ring accepted")
+	}
+
+	// Linear ring with too few positions
+	err = validatePolygon(&Polygon{"Polygon", [][][]float32{ring[2:]}})
+	if err == nil {
+		t.Fatalf("Linear ring with too few positions accepted")
+	}
+
+	// Linear ring not closed
+	err = validatePolygon(&Polygon{"Polygon", [][][]float32{ring[:4]}})
+	if err == nil {
+		t.Fatalf("Open linear ring accepted")
+	}
+
+	// Position out of range
+	err = validatePolygon(&Polygon{"Polygon", [][][]float32{{{7.4215, 43.7362}, {7.4221, 93.7362}, {7.4221, 43.7368}, {7.4215, 43.7362}}}})
+	if err == nil {
+		t.Fatalf("Position out of range accepted")
+	}
+}
+
 func TestPeriodicSuccessSubscription(t *testing.T) {
 	fmt.Println("--- ", t.Name())
 	log.MeepTextLogInit(t.Name())
@@ -3232,3 +3559,122 @@ func sendRequest(method string, url string, body io.Reader, vars map[string]stri
 	}
 	return string(rr.Body.String()), nil
 }
+
+func TestOccupancyAnalytics(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	start := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	metricTime := func(offset int) string {
+		return start.Add(time.Duration(offset) * time.Second).Format(time.RFC3339Nano)
+	}
+
+	// 2 users before start, 4 users at +30s, 1 user at +90s, 3 users after end
+	samples := []met.OccupancyMetric{
+		{Time: metricTime(150), Users: 3},
+		{Time: metricTime(90), Users: 1},
+		{Time: metricTime(30), Users: 4},
+		{Time: metricTime(-10), Users: 2},
+	}
+	events := []met.PresenceMetric{
+		{Time: metricTime(-5), Event: met.PresMetEventEntering},
+		{Time: metricTime(30), Event: met.PresMetEventEntering},
+		{Time: metricTime(30), Event: met.PresMetEventEntering},
+		{Time: metricTime(90), Event: met.PresMetEventLeaving},
+		{Time: metricTime(90), Event: met.PresMetEventLeaving},
+		{Time: metricTime(90), Event: met.PresMetEventLeaving},
+		{Time: metricTime(130), Event: met.PresMetEventEntering},
+	}
+
+	intervals := computeOccupancyIntervals(samples, events, start, time.Minute, 2)
+	if len(intervals) != 2 {
+		t.Fatalf("Invalid number of intervals")
+	}
+	if intervals[0].Timestamp.Seconds != int32(start.Unix()) || intervals[1].Timestamp.Seconds != int32(start.Unix()+60) {
+		t.Fatalf("Invalid interval timestamps")
+	}
+	if intervals[0].MinUsers != 2 || intervals[0].MaxUsers != 4 || intervals[0].AvgUsers != 3 {
+		t.Fatalf("Invalid first interval occupancy")
+	}
+	if intervals[1].MinUsers != 1 || intervals[1].MaxUsers != 4 || intervals[1].AvgUsers != 2.5 {
+		t.Fatalf("Invalid second interval occupancy")
+	}
+	if intervals[0].Entries != 2 || intervals[0].Exits != 0 || intervals[1].Entries != 0 || intervals[1].Exits != 3 {
+		t.Fatalf("Invalid entries/exits")
+	}
+
+	// No samples
+	intervals = computeOccupancyIntervals(nil, nil, start, time.Minute, 1)
+	if len(intervals) != 1 || intervals[0].MaxUsers != 0 || intervals[0].AvgUsers != 0 {
+		t.Fatalf("Invalid empty occupancy")
+	}
+}
+
+func TestDwellTimeAnalytics(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	events := []met.PresenceMetric{
+		{Event: met.PresMetEventLeaving, DwellTime: 130},
+		{Event: met.PresMetEventEntering},
+		{Event: met.PresMetEventLeaving, DwellTime: 10},
+		{Event: met.PresMetEventLeaving, DwellTime: 0},
+		{Event: met.PresMetEventLeaving, DwellTime: 50},
+		{Event: met.PresMetEventLeaving, DwellTime: 70},
+	}
+
+	dwellTime := computeDwellTimeAnalytics(events, 60)
+	if dwellTime.NumberOfVisits != 4 || dwellTime.BinSize != 60 {
+		t.Fatalf("Invalid number of visits")
+	}
+	if dwellTime.MinDwellTime != 10 || dwellTime.MaxDwellTime != 130 || dwellTime.AvgDwellTime != 65 || dwellTime.MedianDwellTime != 60 {
+		t.Fatalf("Invalid dwell time statistics")
+	}
+	if len(dwellTime.DwellTimeBin) != 3 {
+		t.Fatalf("Invalid number of bins")
+	}
+	expectedBins := []DwellTimeBin{{2, 0, 60}, {1, 60, 120}, {1, 120, 180}}
+	for i, bin := range dwellTime.DwellTimeBin {
+		if bin != expectedBins[i] {
+			t.Fatalf("Invalid bin %d", i)
+		}
+	}
+
+	// No visits
+	dwellTime = computeDwellTimeAnalytics(nil, 60)
+	if dwellTime.NumberOfVisits != 0 || len(dwellTime.DwellTimeBin) != 0 {
+		t.Fatalf("Invalid empty dwell time")
+	}
+}
+
+func TestHeatmapAnalytics(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Positions are reported as float32 coordinates
+	position := func(lat float32, long float32) met.PositionMetric {
+		return met.PositionMetric{Latitude: float64(lat), Longitude: float64(long)}
+	}
+	positions := []met.PositionMetric{
+		position(43.70, 7.40),
+		position(43.71, 7.41),
+		position(43.79, 7.49),
+		position(43.80, 7.50),
+		position(43.76, 7.42),
+		position(43.81, 7.45),
+		position(43.75, 7.39),
+	}
+
+	cells, nbSamples := computeHeatmap(positions, 43.70, 7.40, 43.80, 7.50, 2, 4)
+	if nbSamples != 5 {
+		t.Fatalf("Invalid number of samples")
+	}
+	expectedCells := [][]int32{{2, 0, 0, 0}, {1, 0, 0, 2}}
+	for row := range expectedCells {
+		for column := range expectedCells[row] {
+			if cells[row][column] != expectedCells[row][column] {
+				t.Fatalf("Invalid cell [%d][%d]", row, column)
+			}
+		}
+	}
+}
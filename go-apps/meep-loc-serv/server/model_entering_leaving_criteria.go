@@ -29,4 +29,6 @@ type EnteringLeavingCriteria string
 const (
 	ENTERING_EnteringLeavingCriteria EnteringLeavingCriteria = "Entering"
 	LEAVING_EnteringLeavingCriteria  EnteringLeavingCriteria = "Leaving"
+	// Inside is only supported by area polygon subscriptions
+	INSIDE_EnteringLeavingCriteria EnteringLeavingCriteria = "Inside"
 )
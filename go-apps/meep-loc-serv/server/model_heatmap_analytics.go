@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Location API
+ *
+ * Location Service is AdvantEDGE's implementation of [ETSI MEC ISG MEC013 Location API](https://www.etsi.org/deliver/etsi_gs/MEC/001_099/013/02.02.01_60/gs_mec013v020201p.pdf) <p>The API is based on the Open Mobile Alliance's specification RESTful Network API for Zonal Presence <p>[Copyright (c) ETSI 2017](https://forge.etsi.org/etsi-forge-copyright-notice.txt) <p>**Micro-service**<br>[meep-loc-serv](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-loc-serv) <p>**Type & Usage**<br>Edge Service used by edge applications that want to get information about Users (UE) and Zone locations <p>**Note**<br>AdvantEDGE supports all of Location API endpoints (see below).
+ *
+ * API version: 2.2.1
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package server
+
+// Grid heatmap of user positions over a bounding box.
+type HeatmapAnalytics struct {
+	// Number of position samples per grid cell; cell[row][column], where row 0 is the southernmost row and column 0 the westernmost column.
+	Cell [][]int32 `json:"cell"`
+	// Number of grid columns (longitude).
+	Columns int32 `json:"columns"`
+	// Northern boundary of the bounding box.
+	MaxLatitude float32 `json:"maxLatitude"`
+	// Eastern boundary of the bounding box.
+	MaxLongitude float32 `json:"maxLongitude"`
+	// Southern boundary of the bounding box.
+	MinLatitude float32 `json:"minLatitude"`
+	// Western boundary of the bounding box.
+	MinLongitude float32 `json:"minLongitude"`
+	// Number of position samples within the bounding box.
+	NumberOfSamples int32 `json:"numberOfSamples"`
+	// Self referring URL
+	ResourceURL string `json:"resourceURL"`
+	// Number of grid rows (latitude).
+	Rows int32 `json:"rows"`
+}
@@ -30,6 +30,8 @@ type NotificationSubscriptionList struct {
 	DistanceNotificationSubscription []DistanceNotificationSubscription `json:"distanceNotificationSubscription,omitempty"`
 	// Collection of PeriodicNotificationSubscription elements, see note 2.
 	PeriodicNotificationSubscription []PeriodicNotificationSubscription `json:"periodicNotificationSubscription,omitempty"`
+	// Collection of PolygonNotificationSubscription elements, see note 2.
+	PolygonNotificationSubscription []PolygonNotificationSubscription `json:"polygonNotificationSubscription,omitempty"`
 	// Self-referring URL, see note 1.
 	ResourceURL string `json:"resourceURL"`
 	// Collection of UserTrackingSubscription elements, see note 1.
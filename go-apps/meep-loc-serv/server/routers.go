@@ -28,6 +28,7 @@ import (
 	"net/http"
 	"strings"
 
+	v3 "github.com/InterDigitalInc/AdvantEDGE/go-apps/meep-loc-serv/server/v3"
 	httpLog "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-http-logger"
 	met "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metrics"
 
@@ -71,6 +72,15 @@ func NewRouter() *mux.Router {
 		PathPrefix("/location/v2/user-api/").
 		Name("UserApi").
 		Handler(handler)
+	// Location API v3 files
+	handler = http.StripPrefix("/location/v3/api/", http.FileServer(http.Dir("./api/")))
+	router.
+		PathPrefix("/location/v3/api/").
+		Name("ApiV3").
+		Handler(handler)
+
+	// Location API v3 websocket endpoints are added to the router on subscription creation
+	v3.SetRouter(router)
 
 	return router
 }
@@ -136,6 +146,41 @@ var routes = Routes{
 		AreaCircleSubPUT,
 	},
 
+	Route{
+		"AreaPolygonSubDELETE",
+		strings.ToUpper("Delete"),
+		"/location/v2/subscriptions/area/polygon/{subscriptionId}",
+		AreaPolygonSubDELETE,
+	},
+
+	Route{
+		"AreaPolygonSubGET",
+		strings.ToUpper("Get"),
+		"/location/v2/subscriptions/area/polygon/{subscriptionId}",
+		AreaPolygonSubGET,
+	},
+
+	Route{
+		"AreaPolygonSubListGET",
+		strings.ToUpper("Get"),
+		"/location/v2/subscriptions/area/polygon",
+		AreaPolygonSubListGET,
+	},
+
+	Route{
+		"AreaPolygonSubPOST",
+		strings.ToUpper("Post"),
+		"/location/v2/subscriptions/area/polygon",
+		AreaPolygonSubPOST,
+	},
+
+	Route{
+		"AreaPolygonSubPUT",
+		strings.ToUpper("Put"),
+		"/location/v2/subscriptions/area/polygon/{subscriptionId}",
+		AreaPolygonSubPUT,
+	},
+
 	Route{
 		"DistanceGET",
 		strings.ToUpper("Get"),
@@ -178,6 +223,20 @@ var routes = Routes{
 		DistanceSubPUT,
 	},
 
+	Route{
+		"DwellTimeGET",
+		strings.ToUpper("Get"),
+		"/location/v2/analytics/dwellTime",
+		DwellTimeGET,
+	},
+
+	Route{
+		"HeatmapGET",
+		strings.ToUpper("Get"),
+		"/location/v2/analytics/heatmap",
+		HeatmapGET,
+	},
+
 	Route{
 		"Mec011AppTerminationPOST",
 		strings.ToUpper("Post"),
@@ -185,6 +244,13 @@ var routes = Routes{
 		Mec011AppTerminationPOST,
 	},
 
+	Route{
+		"OccupancyGET",
+		strings.ToUpper("Get"),
+		"/location/v2/analytics/occupancy",
+		OccupancyGET,
+	},
+
 	Route{
 		"PeriodicSubDELETE",
 		strings.ToUpper("Delete"),
@@ -345,4 +411,81 @@ var routes = Routes{
 		"/location/v2/queries/zones/{zoneId}",
 		ZonesGetById,
 	},
+
+	Route{
+		"IndexV3",
+		"GET",
+		"/location/v3/",
+		Index,
+	},
+
+	Route{
+		"UserSubDELETE",
+		strings.ToUpper("Delete"),
+		"/location/v3/subscriptions/users/{subscriptionId}",
+		v3.UserSubDELETE,
+	},
+
+	Route{
+		"UserSubGET",
+		strings.ToUpper("Get"),
+		"/location/v3/subscriptions/users/{subscriptionId}",
+		v3.UserSubGET,
+	},
+
+	Route{
+		"UserSubListGET",
+		strings.ToUpper("Get"),
+		"/location/v3/subscriptions/users",
+		v3.UserSubListGET,
+	},
+
+	Route{
+		"UserSubPOST",
+		strings.ToUpper("Post"),
+		"/location/v3/subscriptions/users",
+		v3.UserSubPOST,
+	},
+
+	Route{
+		"UserSubPUT",
+		strings.ToUpper("Put"),
+		"/location/v3/subscriptions/users/{subscriptionId}",
+		v3.UserSubPUT,
+	},
+
+	Route{
+		"ZoneSubDELETE",
+		strings.ToUpper("Delete"),
+		"/location/v3/subscriptions/zones/{subscriptionId}",
+		v3.ZoneSubDELETE,
+	},
+
+	Route{
+		"ZoneSubGET",
+		strings.ToUpper("Get"),
+		"/location/v3/subscriptions/zones/{subscriptionId}",
+		v3.ZoneSubGET,
+	},
+
+	Route{
+		"ZoneSubListGET",
+		strings.ToUpper("Get"),
+		"/location/v3/subscriptions/zones",
+		v3.ZoneSubListGET,
+	},
+
+	Route{
+		"ZoneSubPOST",
+		strings.ToUpper("Post"),
+		"/location/v3/subscriptions/zones",
+		v3.ZoneSubPOST,
+	},
+
+	Route{
+		"ZoneSubPUT",
+		strings.ToUpper("Put"),
+		"/location/v3/subscriptions/zones/{subscriptionId}",
+		v3.ZoneSubPUT,
+	},
 }
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func convertJsonToUserData(jsonInfo string) *UserData {
+
+	if jsonInfo == "" {
+		return nil
+	}
+
+	var userData UserData
+	err := json.Unmarshal([]byte(jsonInfo), &userData)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &userData
+}
+
+func convertUserDataToJson(userData *UserData) string {
+
+	jsonInfo, err := json.Marshal(*userData)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertJsonToZoneData(jsonInfo string) *ZoneData {
+
+	if jsonInfo == "" {
+		return nil
+	}
+
+	var zoneData ZoneData
+	err := json.Unmarshal([]byte(jsonInfo), &zoneData)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &zoneData
+}
+
+func convertZoneDataToJson(zoneData *ZoneData) string {
+
+	jsonInfo, err := json.Marshal(*zoneData)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertJsonToAccessPointData(jsonInfo string) *AccessPointData {
+
+	if jsonInfo == "" {
+		return nil
+	}
+
+	var accessPointData AccessPointData
+	err := json.Unmarshal([]byte(jsonInfo), &accessPointData)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &accessPointData
+}
+
+func convertAccessPointDataToJson(accessPointData *AccessPointData) string {
+
+	jsonInfo, err := json.Marshal(*accessPointData)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertJsonToInlineUserSubscription(jsonInfo string) *InlineUserSubscription {
+
+	if jsonInfo == "" {
+		return nil
+	}
+
+	var inlineUserSubscription InlineUserSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &inlineUserSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &inlineUserSubscription
+}
+
+func convertInlineUserSubscriptionToJson(inlineUserSubscription *InlineUserSubscription) string {
+
+	jsonInfo, err := json.Marshal(*inlineUserSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertJsonToInlineZoneSubscription(jsonInfo string) *InlineZoneSubscription {
+
+	if jsonInfo == "" {
+		return nil
+	}
+
+	var inlineZoneSubscription InlineZoneSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &inlineZoneSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &inlineZoneSubscription
+}
+
+func convertInlineZoneSubscriptionToJson(inlineZoneSubscription *InlineZoneSubscription) string {
+
+	jsonInfo, err := json.Marshal(*inlineZoneSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertInlineNotificationSubscriptionListToJson(inlineNotificationSubscriptionList *InlineNotificationSubscriptionList) string {
+
+	jsonInfo, err := json.Marshal(*inlineNotificationSubscriptionList)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertUserLocationEventNotificationToJson(userLocationEventNotification *UserLocationEventNotification) string {
+
+	jsonInfo, err := json.Marshal(*userLocationEventNotification)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertUserLocationPeriodicNotificationToJson(userLocationPeriodicNotification *UserLocationPeriodicNotification) string {
+
+	jsonInfo, err := json.Marshal(*userLocationPeriodicNotification)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertZoneLocationEventNotificationToJson(zoneLocationEventNotification *ZoneLocationEventNotification) string {
+
+	jsonInfo, err := json.Marshal(*zoneLocationEventNotification)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertZoneStatusNotificationToJson(zoneStatusNotification *ZoneStatusNotification) string {
+
+	jsonInfo, err := json.Marshal(*zoneStatusNotification)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertTestNotificationToJson(testNotification *TestNotification) string {
+
+	jsonInfo, err := json.Marshal(*testNotification)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertProblemDetailsToJson(problemDetails *ProblemDetails) string {
+
+	jsonInfo, err := json.Marshal(*problemDetails)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
@@ -0,0 +1,1306 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
+	sm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-subscriptions"
+
+	"github.com/gorilla/mux"
+)
+
+// ServiceCfg - Location Service v3 configuration, provided by the Location Service
+type ServiceCfg struct {
+	Sandbox    string
+	Mep        string
+	HostUrl    *url.URL
+	InstanceId string
+	RedisAddr  string
+}
+
+type UserData struct {
+	Address       string        `json:"address"`
+	ZoneId        string        `json:"zoneId,omitempty"`
+	AccessPointId string        `json:"accessPointId,omitempty"`
+	LocationInfo  *LocationInfo `json:"locationInfo,omitempty"`
+}
+
+type ZoneData struct {
+	ZoneId        string `json:"zoneId"`
+	NumberOfUsers int32  `json:"numberOfUsers"`
+}
+
+type AccessPointData struct {
+	AccessPointId   string          `json:"accessPointId"`
+	NumberOfUsers   int32           `json:"numberOfUsers"`
+	OperationStatus OperationStatus `json:"operationStatus,omitempty"`
+}
+
+const moduleName = "meep-loc-serv"
+const LocServBasePath = "location/v3/"
+const locServKey = "loc-serv:v3"
+const serviceName = "Location Service"
+const defaultMepName = "global"
+
+const typeUser = "user"
+const typeZone = "zone"
+const typeAccessPoint = "ap"
+
+const (
+	USER_LOCATION_EVENT_SUBSCRIPTION    = "UserLocationEventSubscription"
+	USER_LOCATION_PERIODIC_SUBSCRIPTION = "UserLocationPeriodicSubscription"
+	ZONE_LOCATION_EVENT_SUBSCRIPTION    = "ZoneLocationEventSubscription"
+	ZONE_STATUS_SUBSCRIPTION            = "ZoneStatusSubscription"
+)
+
+const (
+	USER_LOCATION_EVENT_NOTIFICATION    = "UserLocationEventNotification"
+	USER_LOCATION_PERIODIC_NOTIFICATION = "UserLocationPeriodicNotification"
+	ZONE_LOCATION_EVENT_NOTIFICATION    = "ZoneLocationEventNotification"
+	ZONE_STATUS_NOTIFICATION            = "ZoneStatusNotification"
+	TEST_NOTIFICATION                   = "TestNotification"
+)
+
+var LOC_SERV_DB = 0
+
+var rc *redis.Connector
+var hostUrl *url.URL
+var instanceId string
+var sandboxName string
+var mepName string = defaultMepName
+var basePath string
+var baseKey string
+var mutex sync.Mutex
+var subMgr *sm.SubscriptionMgr
+var locServRouter *mux.Router
+var periodicCountMap = map[string]int32{}
+
+// Init - Location Service v3 initialization
+func Init(cfg ServiceCfg) (err error) {
+	sandboxName = cfg.Sandbox
+	if sandboxName == "" {
+		err = errors.New("Sandbox name not set")
+		log.Error(err.Error())
+		return err
+	}
+	if cfg.Mep != "" {
+		mepName = cfg.Mep
+	}
+	hostUrl = cfg.HostUrl
+	if hostUrl == nil {
+		hostUrl = new(url.URL)
+	}
+	instanceId = cfg.InstanceId
+
+	// Set base path
+	if mepName == defaultMepName {
+		basePath = "/" + sandboxName + "/" + LocServBasePath
+	} else {
+		basePath = "/" + sandboxName + "/" + mepName + "/" + LocServBasePath
+	}
+
+	// Set base storage key
+	baseKey = dkm.GetKeyRoot(sandboxName) + locServKey + ":mep:" + mepName + ":"
+
+	// Connect to Redis DB
+	rc, err = redis.NewConnector(cfg.RedisAddr, LOC_SERV_DB)
+	if err != nil {
+		log.Error("Failed connection to Redis DB. Error: ", err)
+		return err
+	}
+	_ = rc.DBFlush(baseKey)
+	log.Info("Connected to Redis DB, location service v3 table")
+
+	// Create Subscription Manager
+	subMgrCfg := &sm.SubscriptionMgrCfg{
+		Module:         moduleName,
+		Sandbox:        sandboxName,
+		Mep:            mepName,
+		Service:        serviceName,
+		Basekey:        baseKey,
+		MetricsEnabled: true,
+		ExpiredSubCb:   ExpiredSubscriptionCb,
+		PeriodicSubCb:  PeriodicSubscriptionCb,
+		TestNotifCb:    TestNotificationCb,
+		NewWsCb:        NewWebsocketCb,
+	}
+	subMgr, err = sm.NewSubscriptionMgr(subMgrCfg, cfg.RedisAddr)
+	if err != nil {
+		log.Error("Failed to create Subscription Manager. Error: ", err)
+		return err
+	}
+	log.Info("Created Subscription Manager")
+
+	log.Info("Location Service v3 successfully initialized")
+	return nil
+}
+
+// SetRouter - Store router in server
+func SetRouter(router *mux.Router) {
+	locServRouter = router
+}
+
+// CleanUp - Remove all v3 subscriptions & location information
+func CleanUp() {
+	log.Info("Terminate all v3")
+
+	// Flush subscriptions
+	if subMgr != nil {
+		_ = subMgr.DeleteAllSubscriptions()
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Flush all location information
+	if rc != nil {
+		_ = rc.DBFlush(baseKey)
+	}
+	periodicCountMap = map[string]int32{}
+}
+
+// UpdateUserInfo - Store user location & notify zone entry/exit
+func UpdateUserInfo(address string, zoneId string, accessPointId string, longitude *float32, latitude *float32) {
+	if rc == nil {
+		return
+	}
+
+	mutex.Lock()
+	jsonUserData, _ := rc.JSONGetEntry(baseKey+typeUser+":"+address, ".")
+	userData := convertJsonToUserData(jsonUserData)
+	if userData == nil {
+		userData = new(UserData)
+		userData.Address = address
+	}
+	oldZoneId := userData.ZoneId
+	userData.ZoneId = zoneId
+	userData.AccessPointId = accessPointId
+
+	// Update position; only shape 2 (point) is supported
+	if longitude == nil || latitude == nil {
+		userData.LocationInfo = nil
+	} else {
+		userData.LocationInfo = &LocationInfo{
+			Latitude:  []float32{*latitude},
+			Longitude: []float32{*longitude},
+			Shape:     2,
+		}
+	}
+	_ = rc.JSONSetEntry(baseKey+typeUser+":"+address, ".", convertUserDataToJson(userData))
+	mutex.Unlock()
+
+	// Send zone entry/exit notifications
+	if oldZoneId != zoneId {
+		if oldZoneId != "" {
+			checkUserLocationEventNotification(userData, oldZoneId, LEAVING_AREA_EVENT)
+			checkZoneLocationEventNotification(userData, oldZoneId, LEAVING_AREA_EVENT)
+		}
+		if zoneId != "" {
+			checkUserLocationEventNotification(userData, zoneId, ENTERING_AREA_EVENT)
+			checkZoneLocationEventNotification(userData, zoneId, ENTERING_AREA_EVENT)
+		}
+	}
+}
+
+// UpdateZoneInfo - Store zone user count & notify threshold crossings
+func UpdateZoneInfo(zoneId string, nbUsers int) {
+	if rc == nil || nbUsers == -1 {
+		return
+	}
+
+	mutex.Lock()
+	jsonZoneData, _ := rc.JSONGetEntry(baseKey+typeZone+":"+zoneId, ".")
+	zoneData := convertJsonToZoneData(jsonZoneData)
+	if zoneData == nil {
+		zoneData = new(ZoneData)
+		zoneData.ZoneId = zoneId
+	}
+	previousNbUsers := zoneData.NumberOfUsers
+	zoneData.NumberOfUsers = int32(nbUsers)
+	_ = rc.JSONSetEntry(baseKey+typeZone+":"+zoneId, ".", convertZoneDataToJson(zoneData))
+	mutex.Unlock()
+
+	// Send zone status notifications
+	subList, err := subMgr.GetFilteredSubscriptions(instanceId, ZONE_STATUS_SUBSCRIPTION)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, sub := range subList {
+		zoneStatusSub := getZoneStatusSubscription(sub)
+		if zoneStatusSub == nil || zoneStatusSub.ZoneId != zoneId {
+			continue
+		}
+		if !isThresholdCrossed(previousNbUsers, zoneData.NumberOfUsers,
+			zoneStatusSub.UpperNumberOfUsersZoneThreshold, zoneStatusSub.LowerNumberOfUsersZoneThreshold) {
+			continue
+		}
+		if !subMgr.ReadyToSend(sub) {
+			continue
+		}
+
+		notif := ZoneStatusNotification{
+			NotificationType:    ZONE_STATUS_NOTIFICATION,
+			Links:               &NotificationLinks{Subscription: &LinkType{Href: sub.Cfg.Self}},
+			ZoneId:              zoneId,
+			NumberOfUsersInZone: zoneData.NumberOfUsers,
+			TimeStamp:           getTimeStamp(),
+		}
+		log.Info("Sending Zone Status notification for sub: ", sub.Cfg.Id)
+		_ = subMgr.SendNotification(sub, []byte(convertZoneStatusNotificationToJson(&notif)))
+	}
+}
+
+// UpdateAccessPointInfo - Store access point status & notify threshold crossings or status changes
+func UpdateAccessPointInfo(zoneId string, apId string, opStatusStr string, nbUsers int) {
+	if rc == nil {
+		return
+	}
+
+	mutex.Lock()
+	apKey := baseKey + typeZone + ":" + zoneId + ":" + typeAccessPoint + ":" + apId
+	jsonApData, _ := rc.JSONGetEntry(apKey, ".")
+	apData := convertJsonToAccessPointData(jsonApData)
+	if apData == nil {
+		apData = new(AccessPointData)
+		apData.AccessPointId = apId
+	}
+	previousNbUsers := apData.NumberOfUsers
+	previousOpStatus := apData.OperationStatus
+	if nbUsers != -1 {
+		apData.NumberOfUsers = int32(nbUsers)
+	}
+	if opStatusStr != "" {
+		apData.OperationStatus = OperationStatus(opStatusStr)
+	}
+	_ = rc.JSONSetEntry(apKey, ".", convertAccessPointDataToJson(apData))
+	mutex.Unlock()
+
+	// Send zone status notifications
+	subList, err := subMgr.GetFilteredSubscriptions(instanceId, ZONE_STATUS_SUBSCRIPTION)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, sub := range subList {
+		zoneStatusSub := getZoneStatusSubscription(sub)
+		if zoneStatusSub == nil || zoneStatusSub.ZoneId != zoneId {
+			continue
+		}
+		thresholdCrossed := nbUsers != -1 && isThresholdCrossed(previousNbUsers, apData.NumberOfUsers,
+			zoneStatusSub.UpperNumberOfUsersAPThreshold, zoneStatusSub.LowerNumberOfUsersAPThreshold)
+		opStatusChanged := previousOpStatus != "" && previousOpStatus != apData.OperationStatus &&
+			isOperationStatusPresent(zoneStatusSub.OperationStatus, apData.OperationStatus)
+		if !thresholdCrossed && !opStatusChanged {
+			continue
+		}
+		if !subMgr.ReadyToSend(sub) {
+			continue
+		}
+
+		notif := ZoneStatusNotification{
+			NotificationType: ZONE_STATUS_NOTIFICATION,
+			Links:            &NotificationLinks{Subscription: &LinkType{Href: sub.Cfg.Self}},
+			ZoneId:           zoneId,
+			AccessPointId:    apId,
+			TimeStamp:        getTimeStamp(),
+		}
+		if thresholdCrossed {
+			notif.NumberOfUsersInAP = apData.NumberOfUsers
+		}
+		if opStatusChanged {
+			opStatus := apData.OperationStatus
+			notif.OperationStatus = &opStatus
+		}
+		log.Info("Sending Zone Status notification for sub: ", sub.Cfg.Id)
+		_ = subMgr.SendNotification(sub, []byte(convertZoneStatusNotificationToJson(&notif)))
+	}
+}
+
+func checkUserLocationEventNotification(userData *UserData, zoneId string, event LocationEventType) {
+	subList, err := subMgr.GetFilteredSubscriptions(instanceId, USER_LOCATION_EVENT_SUBSCRIPTION)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, sub := range subList {
+		userEventSub := getUserLocationEventSubscription(sub)
+		if userEventSub == nil || userEventSub.Address != userData.Address {
+			continue
+		}
+		if !isLocationEventPresent(userEventSub.LocationEventCriteria, event) {
+			continue
+		}
+		if !subMgr.ReadyToSend(sub) {
+			continue
+		}
+
+		locationEvent := event
+		notif := UserLocationEventNotification{
+			NotificationType:  USER_LOCATION_EVENT_NOTIFICATION,
+			Links:             &NotificationLinks{Subscription: &LinkType{Href: sub.Cfg.Self}},
+			Address:           userData.Address,
+			UserLocationEvent: &locationEvent,
+			ZoneId:            zoneId,
+			CurrentLocation:   userData.LocationInfo,
+			TimeStamp:         getTimeStamp(),
+		}
+		if event == ENTERING_AREA_EVENT {
+			notif.AccessPointId = userData.AccessPointId
+		}
+		log.Info("Sending User Location Event notification for sub: ", sub.Cfg.Id)
+		_ = subMgr.SendNotification(sub, []byte(convertUserLocationEventNotificationToJson(&notif)))
+	}
+}
+
+func checkZoneLocationEventNotification(userData *UserData, zoneId string, event LocationEventType) {
+	subList, err := subMgr.GetFilteredSubscriptions(instanceId, ZONE_LOCATION_EVENT_SUBSCRIPTION)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, sub := range subList {
+		zoneEventSub := getZoneLocationEventSubscription(sub)
+		if zoneEventSub == nil || zoneEventSub.ZoneId != zoneId {
+			continue
+		}
+		if !isAddressPresent(zoneEventSub.AddressList, userData.Address) {
+			continue
+		}
+		if !isLocationEventPresent(zoneEventSub.LocationEventCriteria, event) {
+			continue
+		}
+		if !subMgr.ReadyToSend(sub) {
+			continue
+		}
+
+		locationEvent := event
+		notif := ZoneLocationEventNotification{
+			NotificationType:  ZONE_LOCATION_EVENT_NOTIFICATION,
+			Links:             &NotificationLinks{Subscription: &LinkType{Href: sub.Cfg.Self}},
+			Address:           userData.Address,
+			UserLocationEvent: &locationEvent,
+			ZoneId:            zoneId,
+			TimeStamp:         getTimeStamp(),
+		}
+		if event == ENTERING_AREA_EVENT {
+			notif.AccessPointId = userData.AccessPointId
+		}
+		log.Info("Sending Zone Location Event notification for sub: ", sub.Cfg.Id)
+		_ = subMgr.SendNotification(sub, []byte(convertZoneLocationEventNotificationToJson(&notif)))
+	}
+}
+
+// isThresholdCrossed - Upper threshold is crossed upward or lower threshold is crossed downward; 0 means not set
+func isThresholdCrossed(previous int32, current int32, upper int32, lower int32) bool {
+	if upper > 0 && previous < upper && current >= upper {
+		return true
+	}
+	if lower > 0 && previous > lower && current <= lower {
+		return true
+	}
+	return false
+}
+
+// isLocationEventPresent - Empty criteria list matches all events
+func isLocationEventPresent(criteria []LocationEventType, event LocationEventType) bool {
+	if len(criteria) == 0 {
+		return true
+	}
+	for _, criterion := range criteria {
+		if criterion == event {
+			return true
+		}
+	}
+	return false
+}
+
+// isAddressPresent - Empty address list matches all users
+func isAddressPresent(addressList []string, address string) bool {
+	if len(addressList) == 0 {
+		return true
+	}
+	for _, addr := range addressList {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
+func isOperationStatusPresent(opStatusList []OperationStatus, opStatus OperationStatus) bool {
+	for _, status := range opStatusList {
+		if status == opStatus {
+			return true
+		}
+	}
+	return false
+}
+
+func getTimeStamp() *TimeStamp {
+	now := time.Now()
+	return &TimeStamp{
+		Seconds:     int32(now.Unix()),
+		NanoSeconds: int32(now.Nanosecond()),
+	}
+}
+
+func getUserLocationEventSubscription(sub *sm.Subscription) *UserLocationEventSubscription {
+	inlineSub := convertJsonToInlineUserSubscription(sub.JsonSubOrig)
+	if inlineSub == nil {
+		return nil
+	}
+	return inlineSub.UserLocationEventSubscription
+}
+
+func getUserLocationPeriodicSubscription(sub *sm.Subscription) *UserLocationPeriodicSubscription {
+	inlineSub := convertJsonToInlineUserSubscription(sub.JsonSubOrig)
+	if inlineSub == nil {
+		return nil
+	}
+	return inlineSub.UserLocationPeriodicSubscription
+}
+
+func getZoneLocationEventSubscription(sub *sm.Subscription) *ZoneLocationEventSubscription {
+	inlineSub := convertJsonToInlineZoneSubscription(sub.JsonSubOrig)
+	if inlineSub == nil {
+		return nil
+	}
+	return inlineSub.ZoneLocationEventSubscription
+}
+
+func getZoneStatusSubscription(sub *sm.Subscription) *ZoneStatusSubscription {
+	inlineSub := convertJsonToInlineZoneSubscription(sub.JsonSubOrig)
+	if inlineSub == nil {
+		return nil
+	}
+	return inlineSub.ZoneStatusSubscription
+}
+
+func userSubListGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Validate query params
+	u, _ := url.Parse(r.URL.String())
+	q := u.Query()
+	validQueryParams := []string{"subscription_type", "address"}
+	if !validateQueryParams(q, validQueryParams) {
+		errHandlerProblemDetails(w, "Invalid query parameters", http.StatusBadRequest)
+		return
+	}
+	subType := q.Get("subscription_type")
+	if !validateQueryParamValue(subType, []string{"", "event", "periodic"}) {
+		errHandlerProblemDetails(w, "Invalid subscription_type value", http.StatusBadRequest)
+		return
+	}
+	address := q.Get("address")
+
+	// Find subscriptions by type
+	subscriptionTypes := []string{USER_LOCATION_EVENT_SUBSCRIPTION, USER_LOCATION_PERIODIC_SUBSCRIPTION}
+	if subType == "event" {
+		subscriptionTypes = []string{USER_LOCATION_EVENT_SUBSCRIPTION}
+	} else if subType == "periodic" {
+		subscriptionTypes = []string{USER_LOCATION_PERIODIC_SUBSCRIPTION}
+	}
+
+	subList := new(NotificationSubscriptionList)
+	subList.ResourceURL = &LinkType{Href: hostUrl.String() + basePath + "subscriptions/users"}
+	for _, subscriptionType := range subscriptionTypes {
+		subs, err := subMgr.GetFilteredSubscriptions(instanceId, subscriptionType)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, sub := range subs {
+			if address != "" {
+				inlineSub := convertJsonToInlineUserSubscription(sub.JsonSubOrig)
+				if inlineSub == nil || getUserSubscriptionAddress(inlineSub) != address {
+					continue
+				}
+			}
+			subList.Subscription = append(subList.Subscription, Subscription{Href: sub.Cfg.Self, SubscriptionType: sub.Cfg.Type})
+		}
+	}
+
+	// Send response
+	response := InlineNotificationSubscriptionList{NotificationSubscriptionList: subList}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertInlineNotificationSubscriptionListToJson(&response))
+}
+
+func userSubGet(w http.ResponseWriter, r *http.Request) {
+	subGet(w, r, USER_LOCATION_EVENT_SUBSCRIPTION, USER_LOCATION_PERIODIC_SUBSCRIPTION)
+}
+
+func userSubPost(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var body InlineUserSubscription
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate subscription
+	subscriptionType, err := validateUserSubscription(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get a new subscription ID & set resource link
+	subId := subMgr.GenerateSubscriptionId()
+	links := &Links{Self: &LinkType{Href: hostUrl.String() + basePath + "subscriptions/users/" + subId}}
+	setUserSubscriptionLinks(&body, links)
+
+	// Create & store subscription
+	subCfg := newUserSubscriptionCfg(&body, subscriptionType, subId)
+	sub, err := subMgr.CreateSubscription(subCfg, convertInlineUserSubscriptionToJson(&body))
+	if err != nil {
+		log.Error("Failed to create subscription")
+		errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+		return
+	}
+
+	// Update subscription JSON based on subscription state
+	jsonSub := updateUserSubscriptionJson(&body, sub)
+	err = subMgr.SetSubscriptionJson(sub, jsonSub)
+	if err != nil {
+		log.Error("Failed to create subscription")
+		errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprint(w, jsonSub)
+}
+
+func userSubPut(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subId := vars["subscriptionId"]
+
+	var body InlineUserSubscription
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate subscription
+	subscriptionType, err := validateUserSubscription(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = validateSubscriptionLinks(getUserSubscriptionLinks(&body), subId)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Find subscription by ID
+	sub, err := subMgr.GetSubscription(subId)
+	if err != nil || sub.Cfg.AppId != instanceId ||
+		(sub.Cfg.Type != USER_LOCATION_EVENT_SUBSCRIPTION && sub.Cfg.Type != USER_LOCATION_PERIODIC_SUBSCRIPTION) {
+		log.Error("Subscription not found: ", subId)
+		errHandlerProblemDetails(w, "Subscription not found", http.StatusNotFound)
+		return
+	}
+	if sub.Cfg.Type != subscriptionType {
+		log.Error("Subscription type cannot be changed")
+		errHandlerProblemDetails(w, "Subscription type cannot be changed", http.StatusBadRequest)
+		return
+	}
+
+	// Update subscription
+	sub.Cfg = newUserSubscriptionCfg(&body, subscriptionType, subId)
+	err = subMgr.UpdateSubscription(sub)
+	if err != nil {
+		log.Error("Failed to update subscription")
+		errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+		return
+	}
+
+	// Update subscription JSON based on subscription state
+	jsonSub := updateUserSubscriptionJson(&body, sub)
+	err = subMgr.SetSubscriptionJson(sub, jsonSub)
+	if err != nil {
+		log.Error("Failed to update subscription")
+		errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, jsonSub)
+}
+
+func userSubDelete(w http.ResponseWriter, r *http.Request) {
+	subDelete(w, r, USER_LOCATION_EVENT_SUBSCRIPTION, USER_LOCATION_PERIODIC_SUBSCRIPTION)
+}
+
+func zoneSubListGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Validate query params
+	u, _ := url.Parse(r.URL.String())
+	q := u.Query()
+	validQueryParams := []string{"subscription_type", "zoneId"}
+	if !validateQueryParams(q, validQueryParams) {
+		errHandlerProblemDetails(w, "Invalid query parameters", http.StatusBadRequest)
+		return
+	}
+	subType := q.Get("subscription_type")
+	if !validateQueryParamValue(subType, []string{"", "event", "status"}) {
+		errHandlerProblemDetails(w, "Invalid subscription_type value", http.StatusBadRequest)
+		return
+	}
+	zoneId := q.Get("zoneId")
+
+	// Find subscriptions by type
+	subscriptionTypes := []string{ZONE_LOCATION_EVENT_SUBSCRIPTION, ZONE_STATUS_SUBSCRIPTION}
+	if subType == "event" {
+		subscriptionTypes = []string{ZONE_LOCATION_EVENT_SUBSCRIPTION}
+	} else if subType == "status" {
+		subscriptionTypes = []string{ZONE_STATUS_SUBSCRIPTION}
+	}
+
+	subList := new(NotificationSubscriptionList)
+	subList.ResourceURL = &LinkType{Href: hostUrl.String() + basePath + "subscriptions/zones"}
+	for _, subscriptionType := range subscriptionTypes {
+		subs, err := subMgr.GetFilteredSubscriptions(instanceId, subscriptionType)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, sub := range subs {
+			if zoneId != "" {
+				inlineSub := convertJsonToInlineZoneSubscription(sub.JsonSubOrig)
+				if inlineSub == nil || getZoneSubscriptionZoneId(inlineSub) != zoneId {
+					continue
+				}
+			}
+			subList.Subscription = append(subList.Subscription, Subscription{Href: sub.Cfg.Self, SubscriptionType: sub.Cfg.Type})
+		}
+	}
+
+	// Send response
+	response := InlineNotificationSubscriptionList{NotificationSubscriptionList: subList}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertInlineNotificationSubscriptionListToJson(&response))
+}
+
+func zoneSubGet(w http.ResponseWriter, r *http.Request) {
+	subGet(w, r, ZONE_LOCATION_EVENT_SUBSCRIPTION, ZONE_STATUS_SUBSCRIPTION)
+}
+
+func zoneSubPost(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var body InlineZoneSubscription
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate subscription
+	subscriptionType, err := validateZoneSubscription(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Get a new subscription ID & set resource link
+	subId := subMgr.GenerateSubscriptionId()
+	links := &Links{Self: &LinkType{Href: hostUrl.String() + basePath + "subscriptions/zones/" + subId}}
+	setZoneSubscriptionLinks(&body, links)
+
+	// Create & store subscription
+	subCfg := newZoneSubscriptionCfg(&body, subscriptionType, subId)
+	sub, err := subMgr.CreateSubscription(subCfg, convertInlineZoneSubscriptionToJson(&body))
+	if err != nil {
+		log.Error("Failed to create subscription")
+		errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+		return
+	}
+
+	// Update subscription JSON based on subscription state
+	jsonSub := updateZoneSubscriptionJson(&body, sub)
+	err = subMgr.SetSubscriptionJson(sub, jsonSub)
+	if err != nil {
+		log.Error("Failed to create subscription")
+		errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprint(w, jsonSub)
+}
+
+func zoneSubPut(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subId := vars["subscriptionId"]
+
+	var body InlineZoneSubscription
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate subscription
+	subscriptionType, err := validateZoneSubscription(&body)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = validateSubscriptionLinks(getZoneSubscriptionLinks(&body), subId)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Find subscription by ID
+	sub, err := subMgr.GetSubscription(subId)
+	if err != nil || sub.Cfg.AppId != instanceId ||
+		(sub.Cfg.Type != ZONE_LOCATION_EVENT_SUBSCRIPTION && sub.Cfg.Type != ZONE_STATUS_SUBSCRIPTION) {
+		log.Error("Subscription not found: ", subId)
+		errHandlerProblemDetails(w, "Subscription not found", http.StatusNotFound)
+		return
+	}
+	if sub.Cfg.Type != subscriptionType {
+		log.Error("Subscription type cannot be changed")
+		errHandlerProblemDetails(w, "Subscription type cannot be changed", http.StatusBadRequest)
+		return
+	}
+
+	// Update subscription
+	sub.Cfg = newZoneSubscriptionCfg(&body, subscriptionType, subId)
+	err = subMgr.UpdateSubscription(sub)
+	if err != nil {
+		log.Error("Failed to update subscription")
+		errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+		return
+	}
+
+	// Update subscription JSON based on subscription state
+	jsonSub := updateZoneSubscriptionJson(&body, sub)
+	err = subMgr.SetSubscriptionJson(sub, jsonSub)
+	if err != nil {
+		log.Error("Failed to update subscription")
+		errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, jsonSub)
+}
+
+func zoneSubDelete(w http.ResponseWriter, r *http.Request) {
+	subDelete(w, r, ZONE_LOCATION_EVENT_SUBSCRIPTION, ZONE_STATUS_SUBSCRIPTION)
+}
+
+func subGet(w http.ResponseWriter, r *http.Request, subTypes ...string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subId := vars["subscriptionId"]
+
+	// Find subscription by ID
+	sub, err := subMgr.GetSubscription(subId)
+	if err != nil || sub.Cfg.AppId != instanceId || !isSubscriptionTypePresent(subTypes, sub.Cfg.Type) {
+		log.Error("Subscription not found: ", subId)
+		errHandlerProblemDetails(w, "Subscription not found", http.StatusNotFound)
+		return
+	}
+
+	// Return original marshalled subscription
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, sub.JsonSubOrig)
+}
+
+func subDelete(w http.ResponseWriter, r *http.Request, subTypes ...string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subId := vars["subscriptionId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Find subscription by ID
+	sub, err := subMgr.GetSubscription(subId)
+	if err != nil || sub.Cfg.AppId != instanceId || !isSubscriptionTypePresent(subTypes, sub.Cfg.Type) {
+		log.Error("Subscription not found: ", subId)
+		errHandlerProblemDetails(w, "Subscription not found", http.StatusNotFound)
+		return
+	}
+
+	// Delete subscription
+	err = deleteSubscription(sub)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteSubscription - Disable websocket endpoint & remove subscription; mutex must be held
+func deleteSubscription(sub *sm.Subscription) error {
+	// Disable subscription websocket endpoint
+	if sub.Ws != nil && locServRouter != nil {
+		route := locServRouter.Get(sub.Cfg.Id)
+		if route != nil {
+			route.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+		}
+	}
+	delete(periodicCountMap, sub.Cfg.Id)
+	return subMgr.DeleteSubscription(sub)
+}
+
+func isSubscriptionTypePresent(subTypes []string, subType string) bool {
+	for _, t := range subTypes {
+		if t == subType {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUserSubscription - Validate user subscription & return its type
+func validateUserSubscription(body *InlineUserSubscription) (string, error) {
+	eventSub := body.UserLocationEventSubscription
+	periodicSub := body.UserLocationPeriodicSubscription
+	if (eventSub == nil) == (periodicSub == nil) {
+		return "", errors.New("One and only one subscription shall be present")
+	}
+
+	if eventSub != nil {
+		if eventSub.SubscriptionType != USER_LOCATION_EVENT_SUBSCRIPTION {
+			return "", errors.New("Invalid subscriptionType: " + eventSub.SubscriptionType)
+		}
+		if eventSub.Address == "" {
+			return "", errors.New("Mandatory Address parameter not present")
+		}
+		if !isNotificationChannelPresent(eventSub.CallbackReference, eventSub.WebsockNotifConfig) {
+			return "", errors.New("Mandatory CallbackReference or WebsockNotifConfig parameter not present")
+		}
+		for _, event := range eventSub.LocationEventCriteria {
+			if event != ENTERING_AREA_EVENT && event != LEAVING_AREA_EVENT {
+				return "", errors.New("Invalid locationEventCriteria value: " + string(event))
+			}
+		}
+		return USER_LOCATION_EVENT_SUBSCRIPTION, nil
+	}
+
+	if periodicSub.SubscriptionType != USER_LOCATION_PERIODIC_SUBSCRIPTION {
+		return "", errors.New("Invalid subscriptionType: " + periodicSub.SubscriptionType)
+	}
+	if periodicSub.Address == "" {
+		return "", errors.New("Mandatory Address parameter not present")
+	}
+	if !isNotificationChannelPresent(periodicSub.CallbackReference, periodicSub.WebsockNotifConfig) {
+		return "", errors.New("Mandatory CallbackReference or WebsockNotifConfig parameter not present")
+	}
+	if periodicSub.PeriodicEventInfo == nil || periodicSub.PeriodicEventInfo.ReportingInterval <= 0 {
+		return "", errors.New("Mandatory PeriodicEventInfo reportingInterval parameter not present or invalid")
+	}
+	if periodicSub.PeriodicEventInfo.ReportingAmount < 0 {
+		return "", errors.New("Invalid PeriodicEventInfo reportingAmount parameter")
+	}
+	return USER_LOCATION_PERIODIC_SUBSCRIPTION, nil
+}
+
+// validateZoneSubscription - Validate zone subscription & return its type
+func validateZoneSubscription(body *InlineZoneSubscription) (string, error) {
+	eventSub := body.ZoneLocationEventSubscription
+	statusSub := body.ZoneStatusSubscription
+	if (eventSub == nil) == (statusSub == nil) {
+		return "", errors.New("One and only one subscription shall be present")
+	}
+
+	if eventSub != nil {
+		if eventSub.SubscriptionType != ZONE_LOCATION_EVENT_SUBSCRIPTION {
+			return "", errors.New("Invalid subscriptionType: " + eventSub.SubscriptionType)
+		}
+		if eventSub.ZoneId == "" {
+			return "", errors.New("Mandatory ZoneId parameter not present")
+		}
+		if !isNotificationChannelPresent(eventSub.CallbackReference, eventSub.WebsockNotifConfig) {
+			return "", errors.New("Mandatory CallbackReference or WebsockNotifConfig parameter not present")
+		}
+		for _, event := range eventSub.LocationEventCriteria {
+			if event != ENTERING_AREA_EVENT && event != LEAVING_AREA_EVENT {
+				return "", errors.New("Invalid locationEventCriteria value: " + string(event))
+			}
+		}
+		return ZONE_LOCATION_EVENT_SUBSCRIPTION, nil
+	}
+
+	if statusSub.SubscriptionType != ZONE_STATUS_SUBSCRIPTION {
+		return "", errors.New("Invalid subscriptionType: " + statusSub.SubscriptionType)
+	}
+	if statusSub.ZoneId == "" {
+		return "", errors.New("Mandatory ZoneId parameter not present")
+	}
+	if !isNotificationChannelPresent(statusSub.CallbackReference, statusSub.WebsockNotifConfig) {
+		return "", errors.New("Mandatory CallbackReference or WebsockNotifConfig parameter not present")
+	}
+	if statusSub.UpperNumberOfUsersZoneThreshold < 0 || statusSub.LowerNumberOfUsersZoneThreshold < 0 ||
+		statusSub.UpperNumberOfUsersAPThreshold < 0 || statusSub.LowerNumberOfUsersAPThreshold < 0 {
+		return "", errors.New("Invalid threshold parameter")
+	}
+	if statusSub.UpperNumberOfUsersZoneThreshold == 0 && statusSub.LowerNumberOfUsersZoneThreshold == 0 &&
+		statusSub.UpperNumberOfUsersAPThreshold == 0 && statusSub.LowerNumberOfUsersAPThreshold == 0 &&
+		len(statusSub.OperationStatus) == 0 {
+		return "", errors.New("At least one threshold or OperationStatus parameter shall be present")
+	}
+	for _, opStatus := range statusSub.OperationStatus {
+		if opStatus != SERVICEABLE_OperationStatus && opStatus != UNSERVICEABLE_OperationStatus && opStatus != UNKNOWN_OperationStatus {
+			return "", errors.New("Invalid operationStatus value: " + string(opStatus))
+		}
+	}
+	return ZONE_STATUS_SUBSCRIPTION, nil
+}
+
+func isNotificationChannelPresent(callbackReference string, wsConfig *WebsockNotifConfig) bool {
+	return callbackReference != "" || (wsConfig != nil && wsConfig.RequestWebsocketUri)
+}
+
+func validateSubscriptionLinks(links *Links, subId string) error {
+	if links == nil || links.Self == nil {
+		return errors.New("Mandatory Links parameter not present")
+	}
+	selfUrl := strings.Split(links.Self.Href, "/")
+	if selfUrl[len(selfUrl)-1] != subId {
+		return errors.New("SubscriptionId in endpoint and in body not matching")
+	}
+	return nil
+}
+
+func getUserSubscriptionAddress(body *InlineUserSubscription) string {
+	if body.UserLocationEventSubscription != nil {
+		return body.UserLocationEventSubscription.Address
+	} else if body.UserLocationPeriodicSubscription != nil {
+		return body.UserLocationPeriodicSubscription.Address
+	}
+	return ""
+}
+
+func getUserSubscriptionLinks(body *InlineUserSubscription) *Links {
+	if body.UserLocationEventSubscription != nil {
+		return body.UserLocationEventSubscription.Links
+	} else if body.UserLocationPeriodicSubscription != nil {
+		return body.UserLocationPeriodicSubscription.Links
+	}
+	return nil
+}
+
+func setUserSubscriptionLinks(body *InlineUserSubscription, links *Links) {
+	if body.UserLocationEventSubscription != nil {
+		body.UserLocationEventSubscription.Links = links
+	} else if body.UserLocationPeriodicSubscription != nil {
+		body.UserLocationPeriodicSubscription.Links = links
+	}
+}
+
+func getZoneSubscriptionZoneId(body *InlineZoneSubscription) string {
+	if body.ZoneLocationEventSubscription != nil {
+		return body.ZoneLocationEventSubscription.ZoneId
+	} else if body.ZoneStatusSubscription != nil {
+		return body.ZoneStatusSubscription.ZoneId
+	}
+	return ""
+}
+
+func getZoneSubscriptionLinks(body *InlineZoneSubscription) *Links {
+	if body.ZoneLocationEventSubscription != nil {
+		return body.ZoneLocationEventSubscription.Links
+	} else if body.ZoneStatusSubscription != nil {
+		return body.ZoneStatusSubscription.Links
+	}
+	return nil
+}
+
+func setZoneSubscriptionLinks(body *InlineZoneSubscription, links *Links) {
+	if body.ZoneLocationEventSubscription != nil {
+		body.ZoneLocationEventSubscription.Links = links
+	} else if body.ZoneStatusSubscription != nil {
+		body.ZoneStatusSubscription.Links = links
+	}
+}
+
+func newSubscriptionCfg(subId string, subType string, notifType string, links *Links, callbackReference string,
+	expiryDeadline *TimeStamp, periodicInterval int32, reqTestNotif bool, wsConfig *WebsockNotifConfig) *sm.SubscriptionCfg {
+
+	reqWsUri := false
+	if wsConfig != nil {
+		reqWsUri = wsConfig.RequestWebsocketUri
+	}
+	var expiryTime *time.Time
+	if expiryDeadline != nil {
+		expiry := time.Unix(int64(expiryDeadline.Seconds), 0)
+		expiryTime = &expiry
+	}
+	subCfg := &sm.SubscriptionCfg{
+		Id:                  subId,
+		AppId:               instanceId,
+		Type:                subType,
+		NotifType:           notifType,
+		Self:                links.Self.Href,
+		NotifyUrl:           callbackReference,
+		ExpiryTime:          expiryTime,
+		PeriodicInterval:    periodicInterval,
+		RequestTestNotif:    reqTestNotif,
+		RequestWebsocketUri: reqWsUri,
+	}
+	return subCfg
+}
+
+func newUserSubscriptionCfg(body *InlineUserSubscription, subType string, subId string) *sm.SubscriptionCfg {
+	if subType == USER_LOCATION_EVENT_SUBSCRIPTION {
+		sub := body.UserLocationEventSubscription
+		return newSubscriptionCfg(subId, subType, USER_LOCATION_EVENT_NOTIFICATION, sub.Links, sub.CallbackReference,
+			sub.ExpiryDeadline, 0, sub.RequestTestNotification, sub.WebsockNotifConfig)
+	}
+	sub := body.UserLocationPeriodicSubscription
+	return newSubscriptionCfg(subId, subType, USER_LOCATION_PERIODIC_NOTIFICATION, sub.Links, sub.CallbackReference,
+		sub.ExpiryDeadline, sub.PeriodicEventInfo.ReportingInterval, sub.RequestTestNotification, sub.WebsockNotifConfig)
+}
+
+func newZoneSubscriptionCfg(body *InlineZoneSubscription, subType string, subId string) *sm.SubscriptionCfg {
+	if subType == ZONE_LOCATION_EVENT_SUBSCRIPTION {
+		sub := body.ZoneLocationEventSubscription
+		return newSubscriptionCfg(subId, subType, ZONE_LOCATION_EVENT_NOTIFICATION, sub.Links, sub.CallbackReference,
+			sub.ExpiryDeadline, 0, sub.RequestTestNotification, sub.WebsockNotifConfig)
+	}
+	sub := body.ZoneStatusSubscription
+	return newSubscriptionCfg(subId, subType, ZONE_STATUS_NOTIFICATION, sub.Links, sub.CallbackReference,
+		sub.ExpiryDeadline, 0, sub.RequestTestNotification, sub.WebsockNotifConfig)
+}
+
+func updateWebsockNotifConfig(wsConfig *WebsockNotifConfig, sub *sm.Subscription) *WebsockNotifConfig {
+	if sub.Ws == nil {
+		return nil
+	}
+	if wsConfig == nil {
+		wsConfig = new(WebsockNotifConfig)
+	}
+	wsConfig.RequestWebsocketUri = true
+	wsConfig.WebsocketUri = sub.Ws.Uri
+	return wsConfig
+}
+
+func updateUserSubscriptionJson(body *InlineUserSubscription, sub *sm.Subscription) string {
+	if eventSub := body.UserLocationEventSubscription; eventSub != nil {
+		eventSub.CallbackReference = sub.Cfg.NotifyUrl
+		eventSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+		eventSub.WebsockNotifConfig = updateWebsockNotifConfig(eventSub.WebsockNotifConfig, sub)
+	} else if periodicSub := body.UserLocationPeriodicSubscription; periodicSub != nil {
+		periodicSub.CallbackReference = sub.Cfg.NotifyUrl
+		periodicSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+		periodicSub.WebsockNotifConfig = updateWebsockNotifConfig(periodicSub.WebsockNotifConfig, sub)
+	}
+	return convertInlineUserSubscriptionToJson(body)
+}
+
+func updateZoneSubscriptionJson(body *InlineZoneSubscription, sub *sm.Subscription) string {
+	if eventSub := body.ZoneLocationEventSubscription; eventSub != nil {
+		eventSub.CallbackReference = sub.Cfg.NotifyUrl
+		eventSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+		eventSub.WebsockNotifConfig = updateWebsockNotifConfig(eventSub.WebsockNotifConfig, sub)
+	} else if statusSub := body.ZoneStatusSubscription; statusSub != nil {
+		statusSub.CallbackReference = sub.Cfg.NotifyUrl
+		statusSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+		statusSub.WebsockNotifConfig = updateWebsockNotifConfig(statusSub.WebsockNotifConfig, sub)
+	}
+	return convertInlineZoneSubscriptionToJson(body)
+}
+
+func ExpiredSubscriptionCb(sub *sm.Subscription) {
+	// MEC013 v3 defines no expiry notification; subscription is removed by the subscription manager
+	log.Info("Subscription expired: ", sub.Cfg.Id)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(periodicCountMap, sub.Cfg.Id)
+}
+
+func PeriodicSubscriptionCb(sub *sm.Subscription) {
+	if sub.Cfg.Type != USER_LOCATION_PERIODIC_SUBSCRIPTION {
+		log.Error("Unsupported subscription type: ", sub.Cfg.Type)
+		return
+	}
+	periodicSub := getUserLocationPeriodicSubscription(sub)
+	if periodicSub == nil || periodicSub.PeriodicEventInfo == nil {
+		log.Error("Invalid periodic subscription: ", sub.Cfg.Id)
+		return
+	}
+
+	mutex.Lock()
+	jsonUserData, _ := rc.JSONGetEntry(baseKey+typeUser+":"+periodicSub.Address, ".")
+	periodicCountMap[sub.Cfg.Id]++
+	count := periodicCountMap[sub.Cfg.Id]
+	mutex.Unlock()
+
+	// Build periodic notification
+	result := SUCCESS_NotificationResult
+	notif := UserLocationPeriodicNotification{
+		NotificationType: USER_LOCATION_PERIODIC_NOTIFICATION,
+		Links:            &NotificationLinks{Subscription: &LinkType{Href: sub.Cfg.Self}},
+		Address:          periodicSub.Address,
+		Result:           &result,
+		TimeStamp:        getTimeStamp(),
+	}
+	userData := convertJsonToUserData(jsonUserData)
+	if userData == nil || userData.LocationInfo == nil {
+		result = ERROR_NotificationResult
+	}
+	if userData != nil {
+		notif.ZoneId = userData.ZoneId
+		notif.AccessPointId = userData.AccessPointId
+		notif.CurrentLocation = userData.LocationInfo
+	}
+	reportingAmount := periodicSub.PeriodicEventInfo.ReportingAmount
+	if reportingAmount > 0 && count >= reportingAmount {
+		notif.IsFinalNotification = true
+	}
+
+	// Send periodic notification
+	log.Info("Sending User Location Periodic notification for sub: ", sub.Cfg.Id)
+	_ = subMgr.SendNotification(sub, []byte(convertUserLocationPeriodicNotificationToJson(&notif)))
+
+	// Remove subscription after final notification
+	if notif.IsFinalNotification {
+		mutex.Lock()
+		defer mutex.Unlock()
+		_ = deleteSubscription(sub)
+	}
+}
+
+func TestNotificationCb(sub *sm.Subscription) error {
+	// Build test notification
+	notif := TestNotification{
+		NotificationType: TEST_NOTIFICATION,
+		Links:            &NotificationLinks{Subscription: &LinkType{Href: sub.Cfg.Self}},
+	}
+
+	// Send test notification
+	log.Info("Sending Test notification for sub: ", sub.Cfg.Id)
+	return subMgr.SendNotification(sub, []byte(convertTestNotificationToJson(&notif)))
+}
+
+func NewWebsocketCb(sub *sm.Subscription) (string, error) {
+	if locServRouter == nil {
+		return "", errors.New("Router not set")
+	}
+
+	// Add Websocket endpoint
+	wsPath := "/" + LocServBasePath + sub.Ws.Endpoint
+	locServRouter.HandleFunc(wsPath, sub.Ws.ConnectionHandler).Name(sub.Cfg.Id)
+	log.Info("Created websocket endpoint ", wsPath, " for subscription ", sub.Cfg.Id)
+
+	// Update Websocket URI
+	wsUrl, err := url.Parse(hostUrl.String())
+	if err != nil {
+		log.Error(err.Error())
+		return "", err
+	}
+	wsUrl.Scheme = "wss"
+	websocketUri := wsUrl.String() + basePath + sub.Ws.Endpoint
+
+	return websocketUri, nil
+}
+
+func errHandlerProblemDetails(w http.ResponseWriter, error string, code int) {
+	var pd ProblemDetails
+	pd.Detail = error
+	pd.Status = int32(code)
+
+	jsonResponse := convertProblemDetailsToJson(&pd)
+
+	w.WriteHeader(code)
+	fmt.Fprint(w, jsonResponse)
+}
+
+func validateQueryParams(params url.Values, validParamList []string) bool {
+	for param := range params {
+		found := false
+		for _, validParam := range validParamList {
+			if param == validParam {
+				found = true
+				break
+			}
+		}
+		if !found {
+			log.Error("Invalid query param: ", param)
+			return false
+		}
+	}
+	return true
+}
+
+func validateQueryParamValue(val string, validValues []string) bool {
+	for _, validVal := range validValues {
+		if val == validVal {
+			return true
+		}
+	}
+	log.Error("Invalid query param value: ", val)
+	return false
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestThresholdCrossing(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Upper threshold crossed upward only
+	if !isThresholdCrossed(2, 3, 3, 0) {
+		t.Fatalf("Upper threshold crossing not detected")
+	}
+	if isThresholdCrossed(3, 4, 3, 0) {
+		t.Fatalf("Unexpected upper threshold crossing")
+	}
+	if isThresholdCrossed(4, 2, 3, 0) {
+		t.Fatalf("Unexpected upper threshold crossing on downward change")
+	}
+
+	// Lower threshold crossed downward only
+	if !isThresholdCrossed(2, 1, 0, 1) {
+		t.Fatalf("Lower threshold crossing not detected")
+	}
+	if isThresholdCrossed(1, 0, 0, 1) {
+		t.Fatalf("Unexpected lower threshold crossing")
+	}
+	if isThresholdCrossed(0, 2, 0, 1) {
+		t.Fatalf("Unexpected lower threshold crossing on upward change")
+	}
+
+	// Unset thresholds
+	if isThresholdCrossed(0, 10, 0, 0) {
+		t.Fatalf("Unexpected crossing with unset thresholds")
+	}
+}
+
+func TestEventCriteria(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	if !isLocationEventPresent(nil, ENTERING_AREA_EVENT) || !isLocationEventPresent(nil, LEAVING_AREA_EVENT) {
+		t.Fatalf("Empty criteria must match all events")
+	}
+	criteria := []LocationEventType{LEAVING_AREA_EVENT}
+	if isLocationEventPresent(criteria, ENTERING_AREA_EVENT) || !isLocationEventPresent(criteria, LEAVING_AREA_EVENT) {
+		t.Fatalf("Invalid event criteria match")
+	}
+	if !isAddressPresent(nil, "ue1") {
+		t.Fatalf("Empty address list must match all users")
+	}
+	if isAddressPresent([]string{"ue2"}, "ue1") || !isAddressPresent([]string{"ue1", "ue2"}, "ue1") {
+		t.Fatalf("Invalid address list match")
+	}
+}
+
+func TestValidateSubscriptions(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// User subscriptions
+	eventSub := &UserLocationEventSubscription{
+		SubscriptionType:  USER_LOCATION_EVENT_SUBSCRIPTION,
+		Address:           "ue1",
+		CallbackReference: "http://callback",
+	}
+	periodicSub := &UserLocationPeriodicSubscription{
+		SubscriptionType:   USER_LOCATION_PERIODIC_SUBSCRIPTION,
+		Address:            "ue1",
+		WebsockNotifConfig: &WebsockNotifConfig{RequestWebsocketUri: true},
+		PeriodicEventInfo:  &PeriodicEventInfo{ReportingAmount: 2, ReportingInterval: 1},
+	}
+	subType, err := validateUserSubscription(&InlineUserSubscription{UserLocationEventSubscription: eventSub})
+	if err != nil || subType != USER_LOCATION_EVENT_SUBSCRIPTION {
+		t.Fatalf("Failed to validate user event subscription")
+	}
+	subType, err = validateUserSubscription(&InlineUserSubscription{UserLocationPeriodicSubscription: periodicSub})
+	if err != nil || subType != USER_LOCATION_PERIODIC_SUBSCRIPTION {
+		t.Fatalf("Failed to validate user periodic subscription")
+	}
+	_, err = validateUserSubscription(&InlineUserSubscription{})
+	if err == nil {
+		t.Fatalf("Empty user subscription must fail")
+	}
+	_, err = validateUserSubscription(&InlineUserSubscription{UserLocationEventSubscription: eventSub, UserLocationPeriodicSubscription: periodicSub})
+	if err == nil {
+		t.Fatalf("Multiple user subscriptions must fail")
+	}
+	periodicSub.PeriodicEventInfo.ReportingInterval = 0
+	_, err = validateUserSubscription(&InlineUserSubscription{UserLocationPeriodicSubscription: periodicSub})
+	if err == nil {
+		t.Fatalf("Periodic subscription without reporting interval must fail")
+	}
+	eventSub.CallbackReference = ""
+	_, err = validateUserSubscription(&InlineUserSubscription{UserLocationEventSubscription: eventSub})
+	if err == nil {
+		t.Fatalf("User subscription without notification channel must fail")
+	}
+
+	// Zone subscriptions
+	zoneEventSub := &ZoneLocationEventSubscription{
+		SubscriptionType:  ZONE_LOCATION_EVENT_SUBSCRIPTION,
+		ZoneId:            "zone1",
+		CallbackReference: "http://callback",
+	}
+	zoneStatusSub := &ZoneStatusSubscription{
+		SubscriptionType:  ZONE_STATUS_SUBSCRIPTION,
+		ZoneId:            "zone1",
+		CallbackReference: "http://callback",
+	}
+	subType, err = validateZoneSubscription(&InlineZoneSubscription{ZoneLocationEventSubscription: zoneEventSub})
+	if err != nil || subType != ZONE_LOCATION_EVENT_SUBSCRIPTION {
+		t.Fatalf("Failed to validate zone event subscription")
+	}
+	_, err = validateZoneSubscription(&InlineZoneSubscription{ZoneStatusSubscription: zoneStatusSub})
+	if err == nil {
+		t.Fatalf("Zone status subscription without criteria must fail")
+	}
+	zoneStatusSub.OperationStatus = []OperationStatus{UNSERVICEABLE_OperationStatus}
+	subType, err = validateZoneSubscription(&InlineZoneSubscription{ZoneStatusSubscription: zoneStatusSub})
+	if err != nil || subType != ZONE_STATUS_SUBSCRIPTION {
+		t.Fatalf("Failed to validate zone status subscription")
+	}
+	zoneEventSub.ZoneId = ""
+	_, err = validateZoneSubscription(&InlineZoneSubscription{ZoneLocationEventSubscription: zoneEventSub})
+	if err == nil {
+		t.Fatalf("Zone subscription without zoneId must fail")
+	}
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Location API
+ *
+ * Location Service is AdvantEDGE's implementation of [ETSI MEC ISG MEC013 Location API](https://www.etsi.org/deliver/etsi_gs/MEC/001_099/013/03.01.01_60/gs_mec013v030101p.pdf) <p>[Copyright (c) ETSI 2017](https://forge.etsi.org/etsi-forge-copyright-notice.txt) <p>**Micro-service**<br>[meep-loc-serv](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-loc-serv) <p>**Type & Usage**<br>Edge Service used by edge applications that want to get information about Users (UE) and Zone locations <p>**Note**<br>AdvantEDGE supports a selected subset of Location API v3 subscription types. <p>Supported subscriptions: <p> - UserLocationEventSubscription <p> - UserLocationPeriodicSubscription <p> - ZoneLocationEventSubscription <p> - ZoneStatusSubscription <p>Location queries remain available on the Location API v2.
+ *
+ * API version: 3.1.1
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package server
+
+type UserLocationEventNotification struct {
+	Links *NotificationLinks `json:"_links"`
+	// The identity of the access point the user is currently on.
+	AccessPointId string `json:"accessPointId,omitempty"`
+	// Address of user (e.g. \"sip\" URI, \"tel\" URI, \"acr\" URI).
+	Address         string        `json:"address"`
+	CurrentLocation *LocationInfo `json:"currentLocation,omitempty"`
+	// Shall be set to \"UserLocationEventNotification\".
+	NotificationType  string             `json:"notificationType"`
+	TimeStamp         *TimeStamp         `json:"timeStamp,omitempty"`
+	UserLocationEvent *LocationEventType `json:"userLocationEvent"`
+	// The identity of the zone the user entered or left.
+	ZoneId string `json:"zoneId,omitempty"`
+}
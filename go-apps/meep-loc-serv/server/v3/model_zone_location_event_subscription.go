@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Location API
+ *
+ * Location Service is AdvantEDGE's implementation of [ETSI MEC ISG MEC013 Location API](https://www.etsi.org/deliver/etsi_gs/MEC/001_099/013/03.01.01_60/gs_mec013v030101p.pdf) <p>[Copyright (c) ETSI 2017](https://forge.etsi.org/etsi-forge-copyright-notice.txt) <p>**Micro-service**<br>[meep-loc-serv](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-loc-serv) <p>**Type & Usage**<br>Edge Service used by edge applications that want to get information about Users (UE) and Zone locations <p>**Note**<br>AdvantEDGE supports a selected subset of Location API v3 subscription types. <p>Supported subscriptions: <p> - UserLocationEventSubscription <p> - UserLocationPeriodicSubscription <p> - ZoneLocationEventSubscription <p> - ZoneStatusSubscription <p>Location queries remain available on the Location API v2.
+ *
+ * API version: 3.1.1
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package server
+
+type ZoneLocationEventSubscription struct {
+	Links *Links `json:"_links,omitempty"`
+	// List of the users to be monitored. If not present, all the users need to be monitored.
+	AddressList []string `json:"addressList,omitempty"`
+	// URI exposed by the client on which to receive notifications via HTTP. See note 1.
+	CallbackReference string `json:"callbackReference,omitempty"`
+	// A correlator that the client can use to tag this particular resource representation during a request to create a resource on the server.
+	ClientCorrelator string     `json:"clientCorrelator,omitempty"`
+	ExpiryDeadline   *TimeStamp `json:"expiryDeadline,omitempty"`
+	// List of user event values to generate notifications for. If omitted, notifications are generated for all events.
+	LocationEventCriteria []LocationEventType `json:"locationEventCriteria,omitempty"`
+	// Shall be set to TRUE by the service consumer to request a test notification via HTTP on the callbackReference URI.
+	RequestTestNotification bool `json:"requestTestNotification,omitempty"`
+	// Shall be set to \"ZoneLocationEventSubscription\".
+	SubscriptionType   string              `json:"subscriptionType"`
+	WebsockNotifConfig *WebsockNotifConfig `json:"websockNotifConfig,omitempty"`
+	// Identifier of zone (e.g. zone001) to monitor.
+	ZoneId string `json:"zoneId"`
+}
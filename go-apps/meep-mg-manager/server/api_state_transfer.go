@@ -28,6 +28,10 @@ import (
 	"net/http"
 )
 
+func SetUeTransferState(w http.ResponseWriter, r *http.Request) {
+	mgSetUeTransferState(w, r)
+}
+
 func TransferAppState(w http.ResponseWriter, r *http.Request) {
 	mgTransferAppState(w, r)
 }
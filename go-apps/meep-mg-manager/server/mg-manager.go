@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -65,6 +66,13 @@ const eventTypeStateTransferStart = "STATE-TRANSFER-START"
 const eventTypeStateTransferComplete = "STATE-TRANSFER-COMPLETE"
 const eventTypeStateTransferCancel = "STATE-TRANSFER-CANCEL"
 
+const ueTransferTracked = "TRACKED"
+const ueTransferUntracked = "UNTRACKED"
+const ueTransferInProgress = "IN-PROGRESS"
+const ueTransferCompleted = "COMPLETED"
+const ueTransferCancelled = "CANCELLED"
+const defaultTransferHoldTimeout = 30 // seconds
+
 // const stateTransModeStateDirect = "STATE-DIRECT"
 const stateTransModeStateManaged = "STATE-MANAGED"
 
@@ -96,6 +104,13 @@ type mgInfo struct {
 	ueInfoMap           map[string]*ueInfo
 	netLocAppMap        map[string]string
 	defaultNetLocAppMap map[string]string
+	ueTransferMap       map[string]*ueTransferInfo
+}
+
+type ueTransferInfo struct {
+	tracked bool
+	hold    bool
+	timer   *time.Timer
 }
 
 type appInfo struct {
@@ -160,6 +175,12 @@ type MgManager struct {
 
 	// Mobility Group Data Map
 	mgInfoMap map[string]*mgInfo
+
+	// Serializes MQ, REST API & transfer hold timer events
+	eventMutex sync.Mutex
+
+	// UE state transfer hold
+	transferHoldTimeout time.Duration
 }
 
 var mgm *MgManager
@@ -182,6 +203,17 @@ func Init() (err error) {
 	}
 	log.Info("MEEP_SANDBOX_NAME: ", mgm.sandboxName)
 
+	// Get UE state transfer hold timeout; must match AMS context transfer timeout
+	mgm.transferHoldTimeout = defaultTransferHoldTimeout * time.Second
+	transferHoldTimeoutEnv := strings.TrimSpace(os.Getenv("MEEP_CTX_TRANSFER_TIMEOUT"))
+	if transferHoldTimeoutEnv != "" {
+		value, err := strconv.Atoi(transferHoldTimeoutEnv)
+		if err == nil && value > 0 {
+			mgm.transferHoldTimeout = time.Duration(value) * time.Second
+		}
+	}
+	log.Info("MEEP_CTX_TRANSFER_TIMEOUT: ", mgm.transferHoldTimeout)
+
 	// Create message queue
 	mgm.mqLocal, err = mq.NewMsgQueue(mq.GetLocalName(mgm.sandboxName), moduleName, mgm.sandboxName, redisAddr)
 	if err != nil {
@@ -280,6 +312,9 @@ func Stop() {
 
 // Message Queue handler
 func msgHandler(msg *mq.Msg, userData interface{}) {
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
+
 	switch msg.Message {
 	case mq.MsgScenarioActivate:
 		log.Debug("RX MSG: ", mq.PrintMsg(msg))
@@ -641,6 +676,17 @@ func refreshMgSvcMapping() {
 				continue
 			}
 
+			// Keep current mapping while UE state transfer is in progress
+			if svcMap := netElemInfo.mgSvcMap[mgInfo.mg.Name]; svcMap != nil {
+				bestApp := mgInfo.netLocAppMap[netElemInfo.netLoc]
+				if len(mgInfo.appInfoMap) == 0 {
+					bestApp = mgInfo.defaultNetLocAppMap[netElemInfo.netLoc]
+				}
+				if holdUeTransfer(mgInfo, netElemInfo.phyLoc, svcMap.lbSvcName, bestApp) {
+					continue
+				}
+			}
+
 			// PATCH: If no registered app instances, use default net loc app map
 			if len(mgInfo.appInfoMap) == 0 {
 				setSvcMap(netElemInfo, mgInfo.mg.Name, mgInfo.defaultNetLocAppMap[netElemInfo.netLoc])
@@ -884,6 +930,7 @@ func mgCreate(mg *mgModel.MobilityGroup) error {
 	mgInfo.ueInfoMap = make(map[string]*ueInfo)
 	mgInfo.netLocAppMap = make(map[string]string)
 	mgInfo.defaultNetLocAppMap = make(map[string]string)
+	mgInfo.ueTransferMap = make(map[string]*ueTransferInfo)
 
 	// Add to MG map
 	mgm.mgInfoMap[mg.Name] = mgInfo
@@ -1144,9 +1191,111 @@ func processAppState(mgName string, appID string, mgAppState *mgModel.MobilityGr
 	return nil
 }
 
+func processUeTransferState(mgName string, ueId string, ueTransfer *mgModel.MobilityGroupUeTransfer) error {
+	log.Info("Processing transfer state " + ueTransfer.State + " for UE: " + ueId + " in group: " + mgName)
+
+	// Retrieve MG info
+	mgInfo := mgm.mgInfoMap[mgName]
+	if mgInfo == nil {
+		err := errors.New("Mobility group does not exist: " + mgName)
+		log.Error(err.Error())
+		return err
+	}
+
+	// Tracked UE traffic steering is held on its current App instance as soon as a new
+	// App instance is selected, until the transfer is completed, cancelled or timed out
+	released := false
+	transferInfo := mgInfo.ueTransferMap[ueId]
+	if transferInfo == nil {
+		transferInfo = new(ueTransferInfo)
+		mgInfo.ueTransferMap[ueId] = transferInfo
+	}
+	switch ueTransfer.State {
+	case ueTransferTracked:
+		transferInfo.tracked = true
+	case ueTransferUntracked:
+		transferInfo.tracked = false
+		released = releaseUeTransferHold(transferInfo)
+		delete(mgInfo.ueTransferMap, ueId)
+	case ueTransferInProgress:
+		transferInfo.tracked = true
+		if !transferInfo.hold {
+			startUeTransferHold(mgInfo, ueId, transferInfo)
+		}
+	case ueTransferCompleted, ueTransferCancelled:
+		released = releaseUeTransferHold(transferInfo)
+	}
+
+	if released {
+		refreshUeTransferMapping()
+	}
+	return nil
+}
+
+// holdUeTransfer - Indicates if UE traffic steering must remain on its current App instance
+// Hold starts when a new App instance is selected for a tracked UE
+func holdUeTransfer(mgInfo *mgInfo, ueId string, currentApp string, bestApp string) bool {
+	transferInfo := mgInfo.ueTransferMap[ueId]
+	if transferInfo == nil {
+		return false
+	}
+	if !transferInfo.hold && transferInfo.tracked && currentApp != "" && currentApp != bestApp {
+		startUeTransferHold(mgInfo, ueId, transferInfo)
+	}
+	return transferInfo.hold
+}
+
+// startUeTransferHold - Hold UE traffic steering until transfer ends or hold times out
+// NOTE: Must be called with event mutex locked
+func startUeTransferHold(mgInfo *mgInfo, ueId string, transferInfo *ueTransferInfo) {
+	log.Info("Holding traffic steering for UE: " + ueId + " in group: " + mgInfo.mg.Name)
+	transferInfo.hold = true
+
+	var timer *time.Timer
+	timer = time.AfterFunc(mgm.transferHoldTimeout, func() {
+		mgm.eventMutex.Lock()
+		defer mgm.eventMutex.Unlock()
+
+		expired := transferInfo.timer == timer && releaseUeTransferHold(transferInfo)
+		if expired && mgm.mgInfoMap[mgInfo.mg.Name] == mgInfo {
+			log.Warn("Traffic steering hold timed out for UE: " + ueId + " in group: " + mgInfo.mg.Name)
+			refreshUeTransferMapping()
+		}
+	})
+	transferInfo.timer = timer
+}
+
+// releaseUeTransferHold - Release UE traffic steering hold, if any
+// NOTE: Must be called with event mutex locked
+func releaseUeTransferHold(transferInfo *ueTransferInfo) bool {
+	if !transferInfo.hold {
+		return false
+	}
+	transferInfo.hold = false
+	if transferInfo.timer != nil {
+		transferInfo.timer.Stop()
+		transferInfo.timer = nil
+	}
+	return true
+}
+
+// refreshUeTransferMapping - Apply MG Service mapping once a UE traffic steering hold is released
+func refreshUeTransferMapping() {
+	// Re-evaluate MG Service mapping
+	refreshMgSvcMapping()
+
+	// Store & Apply latest MG Service mappings
+	applyMgSvcMapping()
+
+	// Inform TC Engine of LB rules updatge
+	publishLbRulesUpdate()
+}
+
 // GET Mobility Group List
 func mgGetMobilityGroupList(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgGetMobilityGroupList")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Make list from MG map
 	mgList := make([]mgModel.MobilityGroup, 0, len(mgm.mgInfoMap))
@@ -1171,6 +1320,8 @@ func mgGetMobilityGroupList(w http.ResponseWriter, r *http.Request) {
 // GET Mobility Group
 func mgGetMobilityGroup(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgGetMobilityGroup")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1208,6 +1359,8 @@ func mgGetMobilityGroup(w http.ResponseWriter, r *http.Request) {
 // POST Mobility Group
 func mgCreateMobilityGroup(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgCreateMobilityGroup")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Retrieve MG parameters from request body
 	var mg mgModel.MobilityGroup
@@ -1234,6 +1387,8 @@ func mgCreateMobilityGroup(w http.ResponseWriter, r *http.Request) {
 // PUT Mobility Group
 func mgSetMobilityGroup(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgSetMobilityGroup")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Retrieve MG parameters from request body
 	var mg mgModel.MobilityGroup
@@ -1260,6 +1415,8 @@ func mgSetMobilityGroup(w http.ResponseWriter, r *http.Request) {
 // DELETE Mobility Group
 func mgDeleteMobilityGroup(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgDeleteMobilityGroup")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1287,6 +1444,8 @@ func mgDeleteMobilityGroup(w http.ResponseWriter, r *http.Request) {
 // GET Mobility Group App List
 func mgGetMobilityGroupAppList(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgGetMobilityGroupAppList")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1329,6 +1488,8 @@ func mgGetMobilityGroupAppList(w http.ResponseWriter, r *http.Request) {
 // GET Mobility Group App
 func mgGetMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgGetMobilityGroupApp")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1378,6 +1539,8 @@ func mgGetMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 // POST Mobility Group App
 func mgCreateMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgCreateMobilityGroupApp")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1415,6 +1578,8 @@ func mgCreateMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 // PUT Mobility Group App
 func mgSetMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgSetMobilityGroupApp")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1452,6 +1617,8 @@ func mgSetMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 // DELETE Mobility Group App
 func mgDeleteMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgDeleteMobilityGroupApp")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1486,6 +1653,8 @@ func mgDeleteMobilityGroupApp(w http.ResponseWriter, r *http.Request) {
 // POST Mobility Group UE
 func mgCreateMobilityGroupUe(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgCreateMobilityGroupUe")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1529,6 +1698,8 @@ func mgCreateMobilityGroupUe(w http.ResponseWriter, r *http.Request) {
 
 func mgTransferAppState(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgTransferAppState")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
 
 	// Get MG name from request parameters
 	vars := mux.Vars(r)
@@ -1570,6 +1741,65 @@ func mgTransferAppState(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func mgSetUeTransferState(w http.ResponseWriter, r *http.Request) {
+	log.Debug("mgSetUeTransferState")
+	mgm.eventMutex.Lock()
+	defer mgm.eventMutex.Unlock()
+
+	// Get MG name & UE ID from request parameters
+	vars := mux.Vars(r)
+	mgName := vars["mgName"]
+	ueId := vars["ueId"]
+
+	// Validate MG name
+	if mgName == "" {
+		log.Debug("Invalid MG name")
+		http.Error(w, "Invalid MG name", http.StatusBadRequest)
+		return
+	}
+	// Validate MG UE ID
+	if ueId == "" {
+		log.Debug("Invalid MG UE ID")
+		http.Error(w, "Invalid MG UE ID", http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve UE transfer state from request body
+	var ueTransfer mgModel.MobilityGroupUeTransfer
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&ueTransfer)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	switch ueTransfer.State {
+	case ueTransferTracked, ueTransferUntracked, ueTransferInProgress, ueTransferCompleted, ueTransferCancelled:
+	default:
+		log.Debug("Invalid UE transfer state: ", ueTransfer.State)
+		http.Error(w, "Invalid UE transfer state", http.StatusBadRequest)
+		return
+	}
+
+	// Make sure group exists
+	if mgm.mgInfoMap[mgName] == nil {
+		log.Debug("Mobility group does not exist: ", mgName)
+		http.Error(w, "Mobility group does not exist", http.StatusNotFound)
+		return
+	}
+
+	// Process UE transfer state update
+	err = processUeTransferState(mgName, ueId, &ueTransfer)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+}
+
 // func mgmDebug(str string) {
 // 	log.Debug("+++++ " + str + " +++++")
 // 	log.Debug("+++ netLocList:")
@@ -165,6 +165,13 @@ var routes = Routes{
 		SetMobilityGroupApp,
 	},
 
+	Route{
+		"SetUeTransferState",
+		strings.ToUpper("Put"),
+		"/mgm/v1/mg/{mgName}/ue/{ueId}/transfer",
+		SetUeTransferState,
+	},
+
 	Route{
 		"TransferAppState",
 		strings.ToUpper("Post"),
@@ -48,11 +48,31 @@ func GetScenario(w http.ResponseWriter, r *http.Request) {
 	pcGetScenario(w, r)
 }
 
+// GetScenarioDiff - Get structural differences between two scenario versions
+func GetScenarioDiff(w http.ResponseWriter, r *http.Request) {
+	pcGetScenarioDiff(w, r)
+}
+
 // GetScenarioList - Retrieve list of scenarios in MEEP store
 func GetScenarioList(w http.ResponseWriter, r *http.Request) {
 	pcGetScenarioList(w, r)
 }
 
+// GetScenarioVersion - Retrieve scenario version from MEEP store
+func GetScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	pcGetScenarioVersion(w, r)
+}
+
+// GetScenarioVersionList - Retrieve list of scenario versions in MEEP store
+func GetScenarioVersionList(w http.ResponseWriter, r *http.Request) {
+	pcGetScenarioVersionList(w, r)
+}
+
+// RestoreScenarioVersion - Restore scenario version in MEEP store
+func RestoreScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	pcRestoreScenarioVersion(w, r)
+}
+
 // SetScenario - Update scenario in MEEP store
 func SetScenario(w http.ResponseWriter, r *http.Request) {
 	pcSetScenario(w, r)
@@ -26,6 +26,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -39,6 +40,7 @@ import (
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 	ss "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-store"
+	sm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sessions"
 	sam "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-swagger-api-mgr"
 )
 
@@ -48,8 +50,11 @@ type Scenario struct {
 
 type PlatformCtrl struct {
 	scenarioStore    *couch.Connector
+	versionStore     *couch.Connector
+	versionMutex     sync.Mutex
 	rc               *redis.Connector
 	sandboxStore     *ss.SandboxStore
+	sessionStore     *sm.SessionStore
 	mqGlobal         *mq.MsgQueue
 	apiMgr           *sam.SwaggerApiMgr
 	garbageCollector *gc.GarbageCollector
@@ -198,15 +203,23 @@ func Init() (err error) {
 	}
 	log.Info("Connected to Scenario Store")
 
+	// Connect to Scenario Version Store
+	pfmCtrl.versionStore, err = couch.NewConnector(couchDBAddr, scenarioVersionDBName)
+	if err != nil {
+		log.Error("Failed connection to Scenario Version Store. Error: ", err)
+		return err
+	}
+	log.Info("Connected to Scenario Version Store")
+
 	// Retrieve scenario list from DB
-	_, scenarioList, err := pfmCtrl.scenarioStore.GetDocList()
+	scenarioNameList, scenarioList, err := pfmCtrl.scenarioStore.GetDocList()
 	if err != nil {
 		log.Error(err.Error())
 		return err
 	}
 
 	// Validate DB scenarios & upgrade them if compatible
-	for _, scenario := range scenarioList {
+	for i, scenario := range scenarioList {
 		validScenario, status, err := mod.ValidateScenario(scenario, "")
 		if err == nil && status == mod.ValidatorStatusUpdated {
 			// Retrieve scenario name
@@ -222,6 +235,16 @@ func Init() (err error) {
 				return errors.New("Failed to update scenario with error: " + err.Error())
 			}
 			log.Debug("Scenario updated with rev: ", rev)
+			scenario = validScenario
+		}
+
+		// Create initial version of scenarios without version history
+		versions, err := getScenarioVersionNumbers(scenarioNameList[i])
+		if err == nil && len(versions) == 0 {
+			_, err = addScenarioVersion(scenarioNameList[i], scenario, "", "Initial version")
+		}
+		if err != nil {
+			log.Error("Failed to create initial scenario version: ", err.Error())
 		}
 	}
 
@@ -233,6 +256,14 @@ func Init() (err error) {
 	}
 	log.Info("Connected to Sandbox Store")
 
+	// Connect to Session Store
+	pfmCtrl.sessionStore, err = sm.NewSessionStore(redisDBAddr)
+	if err != nil {
+		log.Error("Failed connection to Session Store: ", err.Error())
+		return err
+	}
+	log.Info("Connected to Session Store")
+
 	log.Info("Platform Controller initialized")
 	return nil
 }
@@ -319,6 +350,12 @@ func pcCreateScenario(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Debug("Scenario added with rev: ", rev)
 
+	// Store scenario version
+	_, err = addScenarioVersion(scenarioName, validScenario, getVersionAuthor(r), "")
+	if err != nil {
+		log.Error("Failed to add scenario version: ", err.Error())
+	}
+
 	// OK
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
@@ -469,11 +506,212 @@ func pcSetScenario(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Debug("Scenario updated with rev: ", rev)
 
+	// Store scenario version
+	_, err = addScenarioVersion(scenarioName, validScenario, getVersionAuthor(r), "")
+	if err != nil {
+		log.Error("Failed to add scenario version: ", err.Error())
+	}
+
 	// OK
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
 }
 
+// Retrieve all versions of a scenario from scenario version store
+// GET /scenarios/{name}/versions
+func pcGetScenarioVersionList(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcGetScenarioVersionList")
+
+	// Get scenario name from request parameters
+	vars := mux.Vars(r)
+	scenarioName := vars["name"]
+	log.Debug("Scenario name: ", scenarioName)
+
+	// Retrieve scenario versions from DB
+	versionList, err := getScenarioVersionList(scenarioName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(versionList)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// Retrieve scenario version from scenario version store
+// GET /scenarios/{name}/versions/{version}
+func pcGetScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcGetScenarioVersion")
+
+	// Get scenario name & version from request parameters
+	vars := mux.Vars(r)
+	scenarioName := vars["name"]
+	log.Debug("Scenario name: ", scenarioName)
+	version, err := strconv.Atoi(vars["version"])
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, "Invalid scenario version "+vars["version"], http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve scenario version from DB
+	scenarioVersion, err := getScenarioVersion(scenarioName, version)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(scenarioVersion)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// Restore scenario version in scenario store
+// POST /scenarios/{name}/versions/{version}/restore
+func pcRestoreScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcRestoreScenarioVersion")
+
+	// Get scenario name & version from request parameters
+	vars := mux.Vars(r)
+	scenarioName := vars["name"]
+	log.Debug("Scenario name: ", scenarioName)
+	version, err := strconv.Atoi(vars["version"])
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, "Invalid scenario version "+vars["version"], http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve scenario version from DB
+	scenarioVersion, err := getScenarioVersion(scenarioName, version)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Validate scenario; older versions may require an upgrade
+	b, err := json.Marshal(scenarioVersion.Scenario)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	validScenario, _, err := mod.ValidateScenario(b, scenarioName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Update scenario in DB; re-create it if it was deleted
+	var rev string
+	if doc, _ := pfmCtrl.scenarioStore.GetDoc(true, scenarioName); doc == nil {
+		rev, err = pfmCtrl.scenarioStore.AddDoc(scenarioName, validScenario)
+	} else {
+		rev, err = pfmCtrl.scenarioStore.UpdateDoc(scenarioName, validScenario)
+	}
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	log.Debug("Scenario restored with rev: ", rev)
+
+	// Store restored scenario as a new version
+	_, err = addScenarioVersion(scenarioName, validScenario, getVersionAuthor(r), "Restored from version "+strconv.Itoa(version))
+	if err != nil {
+		log.Error("Failed to add scenario version: ", err.Error())
+	}
+
+	// OK
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// Get structural differences between two scenario versions
+// GET /scenarios/{name}/diff
+func pcGetScenarioDiff(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcGetScenarioDiff")
+
+	// Get scenario name from request parameters
+	vars := mux.Vars(r)
+	scenarioName := vars["name"]
+	log.Debug("Scenario name: ", scenarioName)
+
+	// Get versions to compare from query parameters; compare to latest version if not provided
+	query := r.URL.Query()
+	fromVersion, err := strconv.Atoi(query.Get("from"))
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, "Invalid from version "+query.Get("from"), http.StatusBadRequest)
+		return
+	}
+	var toVersion int
+	if query.Get("to") != "" {
+		toVersion, err = strconv.Atoi(query.Get("to"))
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, "Invalid to version "+query.Get("to"), http.StatusBadRequest)
+			return
+		}
+	} else {
+		versions, err := getScenarioVersionNumbers(scenarioName)
+		if err != nil || len(versions) == 0 {
+			http.Error(w, "No versions found for scenario: "+scenarioName, http.StatusNotFound)
+			return
+		}
+		toVersion = versions[len(versions)-1]
+	}
+
+	// Retrieve scenario versions from DB
+	from, err := getScenarioVersion(scenarioName, fromVersion)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	to, err := getScenarioVersion(scenarioName, toVersion)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	diff := diffScenarios(from.Scenario, to.Scenario)
+	diff.ScenarioName = scenarioName
+	diff.FromVersion = int32(fromVersion)
+	diff.ToVersion = int32(toVersion)
+
+	jsonResponse, err := json.Marshal(diff)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
 // Create new Sandbox
 // POST /sandboxes
 func pcCreateSandbox(w http.ResponseWriter, r *http.Request) {
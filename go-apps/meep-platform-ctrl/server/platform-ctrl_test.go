@@ -18,6 +18,7 @@ package server
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -26,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	"github.com/gorilla/mux"
@@ -66,6 +68,9 @@ func TestPlatformCtrl(t *testing.T) {
 	fmt.Println("Test SetScenario")
 	testSetScenario(t)
 
+	fmt.Println("Test ScenarioVersions")
+	testScenarioVersions(t)
+
 	fmt.Println("Test DeleteScenario")
 	testDeleteScenario(t)
 }
@@ -189,6 +194,101 @@ func testSetScenario(t *testing.T) {
 	}
 }
 
+func testScenarioVersions(t *testing.T) {
+	vars := make(map[string]string)
+	query := make(map[string]string)
+
+	// get version list
+	vars["name"] = scenario1Name
+	err := sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusOK, pcGetScenarioVersionList)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	// get version
+	vars["version"] = "1"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusOK, pcGetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	// get diff with latest version
+	query["from"] = "1"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, query, http.StatusOK, pcGetScenarioDiff)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	// restore version
+	query["author"] = "tester"
+	err = sendRequest(http.MethodPost, "/scenarios", nil, vars, query, http.StatusOK, pcRestoreScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- bad request
+	vars["version"] = "this-should-fail"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusBadRequest, pcGetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- inexistent
+	vars["name"] = "this-should-fail"
+	vars["version"] = "1"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusNotFound, pcGetScenarioVersionList)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	err = sendRequest(http.MethodPost, "/scenarios", nil, vars, nil, http.StatusNotFound, pcRestoreScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+}
+
+func TestScenarioDiff(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	from := new(dataModel.Scenario)
+	to := new(dataModel.Scenario)
+	if json.Unmarshal([]byte(testScenario1), from) != nil || json.Unmarshal([]byte(testScenario1), to) != nil {
+		t.Fatalf("Failed to unmarshal scenario")
+	}
+
+	fmt.Println("Diff identical scenarios")
+	diff := diffScenarios(from, to)
+	if len(diff.AddedNodes) != 0 || len(diff.RemovedNodes) != 0 || len(diff.ModifiedNodes) != 0 || len(diff.NetCharChanges) != 0 {
+		t.Fatalf("Identical scenarios should have no differences")
+	}
+
+	fmt.Println("Diff modified scenarios")
+	zone1 := &to.Deployment.Domains[1].Zones[1]
+	zone2 := &to.Deployment.Domains[1].Zones[2]
+	zone2.NetworkLocations = zone2.NetworkLocations[:1]
+	poa1 := &zone1.NetworkLocations[1]
+	poa1.NetChar = &dataModel.NetworkCharacteristics{Latency: 10}
+	ue1 := &poa1.PhysicalLocations[1]
+	ue1.Processes = append(ue1.Processes, dataModel.Process{Id: "ue1-app", Name: "ue1-app", Type_: "UE-APP"})
+	zone1.NetworkLocations[2].TerminalLinkLatency = 20
+	to.Description = "updated"
+
+	diff = diffScenarios(from, to)
+	if len(diff.RemovedNodes) != 1 || diff.RemovedNodes[0].Name != "zone2-poa1" || diff.RemovedNodes[0].Parent != "zone2" {
+		t.Fatalf("Invalid removed nodes")
+	}
+	if len(diff.AddedNodes) != 1 || diff.AddedNodes[0].Name != "ue1-app" || diff.AddedNodes[0].Type_ != "UE-APP" || diff.AddedNodes[0].Parent != "ue1" {
+		t.Fatalf("Invalid added nodes")
+	}
+	if len(diff.ModifiedNodes) != 2 {
+		t.Fatalf("Invalid modified nodes")
+	}
+	if diff.ModifiedNodes[0].Name != scenario1Name || len(diff.ModifiedNodes[0].Changes) != 1 || diff.ModifiedNodes[0].Changes[0] != "description" {
+		t.Fatalf("Invalid modified scenario node")
+	}
+	if diff.ModifiedNodes[1].Name != "zone1-poa2" || len(diff.ModifiedNodes[1].Changes) != 1 || diff.ModifiedNodes[1].Changes[0] != "terminalLinkLatency" {
+		t.Fatalf("Invalid modified POA node")
+	}
+	if len(diff.NetCharChanges) != 1 || diff.NetCharChanges[0].Name != "zone1-poa1" || diff.NetCharChanges[0].From != nil || diff.NetCharChanges[0].To.Latency != 10 {
+		t.Fatalf("Invalid net char changes")
+	}
+}
+
 func sendRequest(method string, url string, body io.Reader, vars map[string]string, query map[string]string, code int, f http.HandlerFunc) error {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil || req == nil {
@@ -187,6 +187,13 @@ var routes = Routes{
 		GetScenario,
 	},
 
+	Route{
+		"GetScenarioDiff",
+		strings.ToUpper("Get"),
+		"/platform-ctrl/v1/scenarios/{name}/diff",
+		GetScenarioDiff,
+	},
+
 	Route{
 		"GetScenarioList",
 		strings.ToUpper("Get"),
@@ -194,6 +201,27 @@ var routes = Routes{
 		GetScenarioList,
 	},
 
+	Route{
+		"GetScenarioVersion",
+		strings.ToUpper("Get"),
+		"/platform-ctrl/v1/scenarios/{name}/versions/{version}",
+		GetScenarioVersion,
+	},
+
+	Route{
+		"GetScenarioVersionList",
+		strings.ToUpper("Get"),
+		"/platform-ctrl/v1/scenarios/{name}/versions",
+		GetScenarioVersionList,
+	},
+
+	Route{
+		"RestoreScenarioVersion",
+		strings.ToUpper("Post"),
+		"/platform-ctrl/v1/scenarios/{name}/versions/{version}/restore",
+		RestoreScenarioVersion,
+	},
+
 	Route{
 		"SetScenario",
 		strings.ToUpper("Put"),
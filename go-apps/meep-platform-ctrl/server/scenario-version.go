@@ -0,0 +1,313 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+)
+
+const scenarioVersionDBName = "scenario-versions"
+const scenarioVersionSeparator = ":"
+const maxScenarioVersions = 100
+
+// Scenario node attributes compared separately or through child nodes
+var diffIgnoredAttributes = []string{"netChar", "domains", "zones", "networkLocations", "physicalLocations", "processes"}
+
+type diffNode struct {
+	name       string
+	nodeType   string
+	parent     string
+	attributes map[string]interface{}
+	netChar    *dataModel.NetworkCharacteristics
+}
+
+type diffNodeList struct {
+	names []string
+	nodes map[string]*diffNode
+}
+
+func getScenarioVersionDocName(scenarioName string, version int) string {
+	return scenarioName + scenarioVersionSeparator + strconv.Itoa(version)
+}
+
+// getScenarioVersionNumbers - Get ordered list of version numbers stored for a scenario
+func getScenarioVersionNumbers(scenarioName string) ([]int, error) {
+	docNameList, err := pfmCtrl.versionStore.GetDocNameList()
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []int
+	prefix := scenarioName + scenarioVersionSeparator
+	for _, docName := range docNameList {
+		if !strings.HasPrefix(docName, prefix) {
+			continue
+		}
+		version, err := strconv.Atoi(strings.TrimPrefix(docName, prefix))
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+	return versions, nil
+}
+
+// getVersionAuthor - Get scenario version author from user session
+// Author query parameter is used only when the request has no user session
+func getVersionAuthor(r *http.Request) string {
+	if pfmCtrl.sessionStore != nil {
+		session, err := pfmCtrl.sessionStore.Get(r)
+		if err == nil {
+			return session.Username
+		}
+	}
+	return r.URL.Query().Get("author")
+}
+
+// addScenarioVersion - Store provided scenario as the next scenario version
+func addScenarioVersion(scenarioName string, scenario []byte, author string, description string) (int, error) {
+	pfmCtrl.versionMutex.Lock()
+	defer pfmCtrl.versionMutex.Unlock()
+
+	s := new(dataModel.Scenario)
+	err := json.Unmarshal(scenario, s)
+	if err != nil {
+		return 0, err
+	}
+
+	versions, err := getScenarioVersionNumbers(scenarioName)
+	if err != nil {
+		return 0, err
+	}
+	version := 1
+	if len(versions) > 0 {
+		version = versions[len(versions)-1] + 1
+	}
+
+	var scenarioVersion dataModel.ScenarioVersion
+	scenarioVersion.ScenarioName = scenarioName
+	scenarioVersion.Version = int32(version)
+	scenarioVersion.Author = author
+	scenarioVersion.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	scenarioVersion.Description = description
+	scenarioVersion.Scenario = s
+	doc, err := json.Marshal(scenarioVersion)
+	if err != nil {
+		return 0, err
+	}
+	_, err = pfmCtrl.versionStore.AddDoc(getScenarioVersionDocName(scenarioName, version), doc)
+	if err != nil {
+		return 0, err
+	}
+
+	// Remove oldest versions beyond retention limit
+	for len(versions) >= maxScenarioVersions {
+		err = pfmCtrl.versionStore.DeleteDoc(getScenarioVersionDocName(scenarioName, versions[0]))
+		if err != nil {
+			log.Error(err.Error())
+		}
+		versions = versions[1:]
+	}
+
+	log.Info("Scenario ", scenarioName, " version ", version, " added by [", author, "]")
+	return version, nil
+}
+
+// getScenarioVersion - Retrieve a stored scenario version
+func getScenarioVersion(scenarioName string, version int) (*dataModel.ScenarioVersion, error) {
+	doc, err := pfmCtrl.versionStore.GetDoc(false, getScenarioVersionDocName(scenarioName, version))
+	if err != nil {
+		return nil, err
+	}
+	scenarioVersion := new(dataModel.ScenarioVersion)
+	err = json.Unmarshal(doc, scenarioVersion)
+	if err != nil {
+		return nil, err
+	}
+	return scenarioVersion, nil
+}
+
+// getScenarioVersionList - Retrieve all stored versions of a scenario, without scenario content
+func getScenarioVersionList(scenarioName string) (*dataModel.ScenarioVersionList, error) {
+	versions, err := getScenarioVersionNumbers(scenarioName)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, errors.New("No versions found for scenario: " + scenarioName)
+	}
+
+	versionList := new(dataModel.ScenarioVersionList)
+	for _, version := range versions {
+		scenarioVersion, err := getScenarioVersion(scenarioName, version)
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		scenarioVersion.Scenario = nil
+		versionList.Versions = append(versionList.Versions, *scenarioVersion)
+	}
+	return versionList, nil
+}
+
+// diffScenarios - Get structural differences between two scenarios
+func diffScenarios(from *dataModel.Scenario, to *dataModel.Scenario) (diff dataModel.ScenarioDiff) {
+	fromNodes := getDiffNodes(from)
+	toNodes := getDiffNodes(to)
+
+	// Removed nodes, in original scenario order
+	for _, name := range fromNodes.names {
+		if _, found := toNodes.nodes[name]; !found {
+			node := fromNodes.nodes[name]
+			diff.RemovedNodes = append(diff.RemovedNodes, dataModel.ScenarioNodeDiff{Name: node.name, Type_: node.nodeType, Parent: node.parent})
+		}
+	}
+
+	// Added & modified nodes, in updated scenario order
+	for _, name := range toNodes.names {
+		toNode := toNodes.nodes[name]
+		fromNode, found := fromNodes.nodes[name]
+		if !found {
+			diff.AddedNodes = append(diff.AddedNodes, dataModel.ScenarioNodeDiff{Name: toNode.name, Type_: toNode.nodeType, Parent: toNode.parent})
+			continue
+		}
+
+		changes := diffAttributes(fromNode.attributes, toNode.attributes)
+		if fromNode.parent != toNode.parent {
+			changes = append(changes, "parent")
+		}
+		if len(changes) > 0 {
+			diff.ModifiedNodes = append(diff.ModifiedNodes, dataModel.ScenarioNodeDiff{Name: toNode.name, Type_: toNode.nodeType, Parent: toNode.parent, Changes: changes})
+		}
+		if !reflect.DeepEqual(fromNode.netChar, toNode.netChar) {
+			diff.NetCharChanges = append(diff.NetCharChanges, dataModel.ScenarioNetCharDiff{Name: toNode.name, Type_: toNode.nodeType, From: fromNode.netChar, To: toNode.netChar})
+		}
+	}
+	return diff
+}
+
+// diffAttributes - Get ordered list of attribute names with different values
+func diffAttributes(from map[string]interface{}, to map[string]interface{}) []string {
+	names := make(map[string]bool)
+	for name := range from {
+		names[name] = true
+	}
+	for name := range to {
+		names[name] = true
+	}
+
+	var changes []string
+	for name := range names {
+		if !reflect.DeepEqual(from[name], to[name]) {
+			changes = append(changes, name)
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// getDiffNodes - Flatten scenario into a list of comparable nodes
+func getDiffNodes(scenario *dataModel.Scenario) *diffNodeList {
+	list := new(diffNodeList)
+	list.nodes = make(map[string]*diffNode)
+	if scenario == nil {
+		return list
+	}
+
+	// Scenario-level attributes are compared with the deployment attributes
+	attributes := make(map[string]interface{})
+	var netChar *dataModel.NetworkCharacteristics
+	if scenario.Deployment != nil {
+		attributes = getDiffAttributes(scenario.Deployment)
+		netChar = scenario.Deployment.NetChar
+	}
+	attributes["description"] = scenario.Description
+	attributes["config"] = getDiffAttributes(scenario.Config)
+	list.add(scenario.Name, mod.NodeTypeScenario, "", attributes, netChar)
+	if scenario.Deployment == nil {
+		return list
+	}
+
+	for iDomain := range scenario.Deployment.Domains {
+		domain := &scenario.Deployment.Domains[iDomain]
+		list.add(domain.Name, domain.Type_, scenario.Name, getDiffAttributes(domain), domain.NetChar)
+
+		for iZone := range domain.Zones {
+			zone := &domain.Zones[iZone]
+			list.add(zone.Name, zone.Type_, domain.Name, getDiffAttributes(zone), zone.NetChar)
+
+			for iNL := range zone.NetworkLocations {
+				nl := &zone.NetworkLocations[iNL]
+				list.add(nl.Name, nl.Type_, zone.Name, getDiffAttributes(nl), nl.NetChar)
+
+				for iPL := range nl.PhysicalLocations {
+					pl := &nl.PhysicalLocations[iPL]
+					list.add(pl.Name, pl.Type_, nl.Name, getDiffAttributes(pl), pl.NetChar)
+
+					for iProc := range pl.Processes {
+						proc := &pl.Processes[iProc]
+						list.add(proc.Name, proc.Type_, pl.Name, getDiffAttributes(proc), proc.NetChar)
+					}
+				}
+			}
+		}
+	}
+	return list
+}
+
+func (list *diffNodeList) add(name string, nodeType string, parent string, attributes map[string]interface{}, netChar *dataModel.NetworkCharacteristics) {
+	if _, found := list.nodes[name]; !found {
+		list.names = append(list.names, name)
+	}
+	list.nodes[name] = &diffNode{
+		name:       name,
+		nodeType:   nodeType,
+		parent:     parent,
+		attributes: attributes,
+		netChar:    netChar,
+	}
+}
+
+// getDiffAttributes - Get generic node attributes, excluding child nodes & network characteristics
+func getDiffAttributes(node interface{}) map[string]interface{} {
+	attributes := make(map[string]interface{})
+	j, err := json.Marshal(node)
+	if err != nil {
+		log.Error(err.Error())
+		return attributes
+	}
+	_ = json.Unmarshal(j, &attributes)
+	if attributes == nil {
+		attributes = make(map[string]interface{})
+	}
+	for _, name := range diffIgnoredAttributes {
+		delete(attributes, name)
+	}
+	return attributes
+}
@@ -17,6 +17,7 @@
 package sbi
 
 import (
+	"sort"
 	"time"
 
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
@@ -24,6 +25,7 @@ import (
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
+	pss "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-pdu-session-store"
 	sam "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-swagger-api-mgr"
 )
 
@@ -36,6 +38,7 @@ type UeDataSbi struct {
 	CellId        string
 	NrCellId      string
 	ErabIdValid   bool
+	PduSessions   []PduSessionSbi
 	AppNames      []string
 	Latency       int32
 	ThroughputUL  int32
@@ -47,6 +50,16 @@ type UeDataSbi struct {
 	InRangeRsrqs  []int32
 }
 
+// PduSessionSbi - UE PDU session to a reachable data network; bit rates in Mbps
+type PduSessionSbi struct {
+	Dnn    string
+	FiveQi int32
+	GbrDl  float64
+	GbrUl  float64
+	MbrDl  float64
+	MbrUl  float64
+}
+
 type PoaInfoSbi struct {
 	Name         string
 	PoaType      string
@@ -76,7 +89,7 @@ type SbiCfg struct {
 	RedisAddr      string
 	Locality       []string
 	UeDataCb       func(UeDataSbi)
-	MeasInfoCb     func(string, string, []string, []int32, []int32)
+	MeasInfoCb     func(string, string, []string, []int32, []int32, []float32)
 	PoaInfoCb      func(PoaInfoSbi)
 	AppInfoCb      func(AppInfoSbi)
 	DomainDataCb   func(string, string, string, string)
@@ -95,9 +108,10 @@ type RnisSbi struct {
 	apiMgr               *sam.SwaggerApiMgr
 	activeModel          *mod.Model
 	gisCache             *gc.GisCache
+	pduSessionStore      *pss.PduSessionStore
 	refreshTicker        *time.Ticker
 	updateUeDataCB       func(UeDataSbi)
-	updateMeasInfoCB     func(string, string, []string, []int32, []int32)
+	updateMeasInfoCB     func(string, string, []string, []int32, []int32, []float32)
 	updatePoaInfoCB      func(PoaInfoSbi)
 	updateAppInfoCB      func(AppInfoSbi)
 	updateDomainDataCB   func(string, string, string, string)
@@ -175,6 +189,14 @@ func Init(cfg SbiCfg) (err error) {
 	}
 	log.Info("Connected to GIS Cache")
 
+	// Connect to PDU Session Store
+	sbi.pduSessionStore, err = pss.NewPduSessionStore(sbi.sandboxName, cfg.RedisAddr)
+	if err != nil {
+		log.Error("Failed connection to PDU Session Store: ", err.Error())
+		return err
+	}
+	log.Info("Connected to PDU Session Store")
+
 	// Initialize service
 	processActiveScenarioUpdate()
 
@@ -268,6 +290,9 @@ func msgHandler(msg *mq.Msg, userData interface{}) {
 	case mq.MsgScenarioTerminate:
 		log.Debug("RX MSG: ", mq.PrintMsg(msg))
 		processActiveScenarioTerminate()
+	case mq.MsgPduSessionCreated, mq.MsgPduSessionTerminated:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		processActiveScenarioUpdate()
 	default:
 		log.Trace("Ignoring unsupported message: ", mq.PrintMsg(msg))
 	}
@@ -325,6 +350,12 @@ func processActiveScenarioUpdate() {
 		}
 	}
 
+	// Get UE PDU sessions
+	pduSessions, err := sbi.pduSessionStore.GetAllPduSessions()
+	if err != nil {
+		log.Error("Failed to get PDU sessions: ", err.Error())
+	}
+
 	// Update UE info
 	ueNames := []string{}
 	ueNameList := sbi.activeModel.GetNodeNames("UE")
@@ -404,6 +435,7 @@ func processActiveScenarioUpdate() {
 							CellId:        cellId,
 							NrCellId:      nrcellId,
 							ErabIdValid:   erabIdValid,
+							PduSessions:   getUePduSessions(zone.Name, pduSessions[name]),
 							AppNames:      appNames,
 							Latency:       latency,
 							ThroughputUL:  throughputUL,
@@ -568,24 +600,25 @@ func refreshMeasurements() {
 
 		// Ignore disconnected UEs
 		if !isUeConnected(name) || !isInLocality(name) {
-			sbi.updateMeasInfoCB(name, "", nil, nil, nil)
+			sbi.updateMeasInfoCB(name, "", nil, nil, nil, nil)
 			continue
 		}
 
 		ueParent := sbi.activeModel.GetNodeParent(name)
 		if poa, ok := ueParent.(*dataModel.NetworkLocation); ok {
-			poaNames, rsrps, rsrqs := getMeas(name, "", uePoaMeasMap)
-			sbi.updateMeasInfoCB(name, poa.Name, poaNames, rsrps, rsrqs)
+			poaNames, rsrps, rsrqs, distances := getMeas(name, "", uePoaMeasMap)
+			sbi.updateMeasInfoCB(name, poa.Name, poaNames, rsrps, rsrqs, distances)
 		} else {
-			sbi.updateMeasInfoCB(name, "", nil, nil, nil)
+			sbi.updateMeasInfoCB(name, "", nil, nil, nil, nil)
 		}
 	}
 }
 
-func getMeas(ue string, poaName string, uePoaMeasMap map[string]*gc.UePoaMeasurement) ([]string, []int32, []int32) {
+func getMeas(ue string, poaName string, uePoaMeasMap map[string]*gc.UePoaMeasurement) ([]string, []int32, []int32, []float32) {
 	var poaNames []string
 	var rsrps []int32
 	var rsrqs []int32
+	var distances []float32
 
 	if ueMeas, ueFound := uePoaMeasMap[ue]; ueFound {
 		if poaName == "" {
@@ -593,16 +626,71 @@ func getMeas(ue string, poaName string, uePoaMeasMap map[string]*gc.UePoaMeasure
 				poaNames = append(poaNames, poaName)
 				rsrps = append(rsrps, int32(meas.Rsrp))
 				rsrqs = append(rsrqs, int32(meas.Rsrq))
+				distances = append(distances, meas.Distance)
 			}
 		} else {
 			if meas, poaFound := ueMeas.Measurements[poaName]; poaFound {
 				poaNames = append(poaNames, poaName)
 				rsrps = append(rsrps, int32(meas.Rsrp))
 				rsrqs = append(rsrqs, int32(meas.Rsrq))
+				distances = append(distances, meas.Distance)
+			}
+		}
+	}
+	return poaNames, rsrps, rsrqs, distances
+}
+
+// getUePduSessions - Get UE PDU sessions to reachable data networks, sorted by data network name
+func getUePduSessions(zoneName string, pduMap map[string]*dataModel.PduSessionInfo) []PduSessionSbi {
+	if len(pduMap) == 0 {
+		return nil
+	}
+
+	// Get data networks available to the UE; LADN must be in the UE zone
+	dnMap := make(map[string]bool)
+	plNameList := sbi.activeModel.GetNodeNames(mod.NodeTypeFog, mod.NodeTypeEdge, mod.NodeTypeCloud)
+	for _, plName := range plNameList {
+		pl, ok := sbi.activeModel.GetNode(plName).(*dataModel.PhysicalLocation)
+		if !ok || pl.DataNetwork == nil || pl.DataNetwork.Dnn == "" {
+			continue
+		}
+		if pl.DataNetwork.Ladn {
+			ctx := sbi.activeModel.GetNodeContext(plName)
+			if ctx == nil || ctx.Parents[mod.Zone] != zoneName {
+				continue
 			}
 		}
+		dnMap[pl.DataNetwork.Dnn] = true
+	}
+
+	// Get UE PDU sessions; first PDU session (by ID) to a data network is used
+	var pduIds []string
+	for pduId := range pduMap {
+		pduIds = append(pduIds, pduId)
+	}
+	sort.Strings(pduIds)
+
+	var pduSessions []PduSessionSbi
+	for _, pduId := range pduIds {
+		pdu := pduMap[pduId]
+		if !dnMap[pdu.Dnn] {
+			continue
+		}
+		pduSession := PduSessionSbi{Dnn: pdu.Dnn}
+		if pdu.Qos != nil {
+			pduSession.FiveQi = pdu.Qos.FiveQi
+			pduSession.GbrDl = pdu.Qos.GbrDl
+			pduSession.GbrUl = pdu.Qos.GbrUl
+			pduSession.MbrDl = pdu.Qos.MbrDl
+			pduSession.MbrUl = pdu.Qos.MbrUl
+		}
+		pduSessions = append(pduSessions, pduSession)
+		delete(dnMap, pdu.Dnn)
 	}
-	return poaNames, rsrps, rsrqs
+	sort.Slice(pduSessions, func(i, j int) bool {
+		return pduSessions[i].Dnn < pduSessions[j].Dnn
+	})
+	return pduSessions
 }
 
 func isUeConnected(name string) bool {
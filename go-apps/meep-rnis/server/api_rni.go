@@ -43,6 +43,10 @@ func RabInfoGET(w http.ResponseWriter, r *http.Request) {
 	rabInfoGet(w, r)
 }
 
+func S1BearerInfoGET(w http.ResponseWriter, r *http.Request) {
+	s1BearerInfoGet(w, r)
+}
+
 func SubscriptionLinkListSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
 	subscriptionLinkListSubscriptionsGet(w, r)
 }
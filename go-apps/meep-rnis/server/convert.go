@@ -177,6 +177,28 @@ func convertRabEstSubscriptionToJson(obj *RabEstSubscription) string {
 	return string(jsonInfo)
 }
 
+func convertJsonToRabModSubscription(jsonInfo string) *RabModSubscription {
+
+	var obj RabModSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertRabModSubscriptionToJson(obj *RabModSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
 func convertJsonToRabRelSubscription(jsonInfo string) *RabRelSubscription {
 
 	var obj RabRelSubscription
@@ -243,6 +265,72 @@ func convertNrMeasRepUeSubscriptionToJson(obj *NrMeasRepUeSubscription) string {
 	return string(jsonInfo)
 }
 
+func convertJsonToMeasTaSubscription(jsonInfo string) *MeasTaSubscription {
+
+	var obj MeasTaSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertMeasTaSubscriptionToJson(obj *MeasTaSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertJsonToCaReconfSubscription(jsonInfo string) *CaReconfSubscription {
+
+	var obj CaReconfSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertCaReconfSubscriptionToJson(obj *CaReconfSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertJsonToS1BearerSubscription(jsonInfo string) *S1BearerSubscription {
+
+	var obj S1BearerSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertS1BearerSubscriptionToJson(obj *S1BearerSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
 func convertProblemDetailstoJson(probdetails *ProblemDetails) string {
 	jsonInfo, err := json.Marshal(*probdetails)
 	if err != nil {
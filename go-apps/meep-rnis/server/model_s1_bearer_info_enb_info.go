@@ -26,7 +26,7 @@ package server
 // S1 bearer information on eNB side as defined below.
 type S1BearerInfoEnbInfo struct {
 	// eNB transport layer address of this S1 bearer.
-	IpAddress string `json:"ipAddress"`
+	IpAddress string `json:"ipAddress,omitempty"`
 	// eNB GTP-U TEID of this S1 bearer.
 	TunnelId string `json:"tunnelId"`
 }
@@ -23,9 +23,11 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,6 +49,7 @@ import (
 const moduleName = "meep-rnis"
 const rnisBasePath = "rni/v2/"
 const rnisKey = "rnis"
+const metricsKey = "metrics:"
 const serviceName = "RNI Service"
 const serviceCategory = "RNI"
 const defaultMepName = "global"
@@ -55,15 +58,15 @@ const defaultConsumedLocalOnly = true
 const appTerminationPath = "notifications/mec011/appTermination"
 
 const (
-	notifCellChange = "CellChangeNotification"
-	notifRabEst     = "RabEstNotification"
-	// notifRabMod      = "RabModNotification"
-	notifRabRel    = "RabRelNotification"
-	notifMeasRepUe = "MeasRepUeNotification"
-	// notifMeasTa      = "MeasTaNotification"
-	// notifCaReConf    = "CaReConfNotification"
-	notifExpiry = "ExpiryNotification"
-	// notifS1Bearer    = "S1BearerNotification"
+	notifCellChange  = "CellChangeNotification"
+	notifRabEst      = "RabEstNotification"
+	notifRabMod      = "RabModNotification"
+	notifRabRel      = "RabRelNotification"
+	notifMeasRepUe   = "MeasRepUeNotification"
+	notifMeasTa      = "MeasTaNotification"
+	notifCaReConf    = "CaReConfNotification"
+	notifExpiry      = "ExpiryNotification"
+	notifS1Bearer    = "S1BearerNotification"
 	notifNrMeasRepUe = "NrMeasRepUeNotification"
 )
 
@@ -75,31 +78,54 @@ var sbxCtrlUrl string = "http://meep-sandbox-ctrl"
 
 const cellChangeSubscriptionType = "cell_change"
 const rabEstSubscriptionType = "rab_est"
+const rabModSubscriptionType = "rab_mod"
 const rabRelSubscriptionType = "rab_rel"
 const measRepUeSubscriptionType = "meas_rep_ue"
 const nrMeasRepUeSubscriptionType = "nr_meas_rep_ue"
+const measTaSubscriptionType = "timing_advance_ue"
+const caReconfSubscriptionType = "ca_reconf"
+const s1BearerSubscriptionType = "s1_bearer"
 const poaType4G = "POA-4G"
 const poaType5G = "POA-5G"
 const plTypeUE = "UE"
 
 var ccSubscriptionMap = map[int]*CellChangeSubscription{}
 var reSubscriptionMap = map[int]*RabEstSubscription{}
+var rmSubscriptionMap = map[int]*RabModSubscription{}
 var rrSubscriptionMap = map[int]*RabRelSubscription{}
 var mrSubscriptionMap = map[int]*MeasRepUeSubscription{}
 var nrMrSubscriptionMap = map[int]*NrMeasRepUeSubscription{}
+var taSubscriptionMap = map[int]*MeasTaSubscription{}
+var caSubscriptionMap = map[int]*CaReconfSubscription{}
+var s1SubscriptionMap = map[int]*S1BearerSubscription{}
 var subscriptionExpiryMap = map[int][]int{}
 var currentStoreName = ""
 
 const CELL_CHANGE_SUBSCRIPTION = "CellChangeSubscription"
 const RAB_EST_SUBSCRIPTION = "RabEstSubscription"
+const RAB_MOD_SUBSCRIPTION = "RabModSubscription"
 const RAB_REL_SUBSCRIPTION = "RabRelSubscription"
 const MEAS_REP_UE_SUBSCRIPTION = "MeasRepUeSubscription"
 const NR_MEAS_REP_UE_SUBSCRIPTION = "NrMeasRepUeSubscription"
+const MEAS_TA_SUBSCRIPTION = "MeasTaSubscription"
+const CA_RECONF_SUBSCRIPTION = "CaReconfSubscription"
+const S1_BEARER_SUBSCRIPTION = "S1BearerSubscription"
 const CELL_CHANGE_NOTIFICATION = "CellChangeNotification"
 const RAB_EST_NOTIFICATION = "RabEstNotification"
+const RAB_MOD_NOTIFICATION = "RabModNotification"
 const RAB_REL_NOTIFICATION = "RabRelNotification"
 const MEAS_REP_UE_NOTIFICATION = "MeasRepUeNotification"
 const NR_MEAS_REP_UE_NOTIFICATION = "NrMeasRepUeNotification"
+const MEAS_TA_NOTIFICATION = "MeasTaNotification"
+const CA_RECONF_NOTIFICATION = "CaReConfNotification"
+const S1_BEARER_NOTIFICATION = "S1BearerNotification"
+
+// S1 bearer events
+const (
+	S1_BEARER_ESTABLISH = 1
+	S1_BEARER_MODIFY    = 2
+	S1_BEARER_RELEASE   = 3
+)
 
 var RNIS_DB = 0
 
@@ -114,6 +140,7 @@ var consumedLocalOnly bool = defaultConsumedLocalOnly
 var locality []string
 var basePath string
 var baseKey string
+var metricsBaseKey string
 var mutex sync.Mutex
 
 var expiryTicker *time.Ticker
@@ -128,6 +155,17 @@ const defaultSupportedQci = 80
 const defaultMeasRepUePeriodicTriggerInterval = 1
 const defaultNrMeasRepUePeriodicTriggerInterval = 1
 
+// S-GW GTP-U TEIDs are distinguished from eNB TEIDs using the most significant bit
+const s1SgwTeidFlag = 0x80000000
+
+// Timing advance is reported in steps of 16 Ts (~78.125m of UE-eNB distance), up to 1282 steps (~100km)
+const timingAdvanceStep = 78.125
+const maxTimingAdvance = 1282
+
+// Carrier aggregation uses up to 4 secondary cells from the serving PLMN with an RSRP of at least -100 dBm
+const maxSecondaryCells = 4
+const minSecondaryCellRsrp = 40
+
 type RabInfoData struct {
 	queryErabId        int32
 	queryQci           int32
@@ -136,26 +174,55 @@ type RabInfoData struct {
 	rabInfo            *RabInfo
 }
 
+type S1BearerInfoData struct {
+	queryErabIds       []int32
+	queryCellIds       []string
+	queryIpv4Addresses []string
+	queryGtpTeids      []string
+	s1BearerInfo       *S1BearerInfo
+}
+
 type L2MeasData struct {
 	queryAppInsId      string
 	queryCellIds       []string
 	queryIpv4Addresses []string
+	flowThroughput     map[string]map[string]float64
+	cellStats          map[string]*CellStats
 	l2Meas             *L2Meas
 }
 
 type UeData struct {
-	Name          string       `json:"name"`
-	ErabId        int32        `json:"erabId"`
-	Ecgi          *Ecgi        `json:"ecgi"`
-	Nrcgi         *Nrcgi       `json:"nrcgi"`
-	Qci           int32        `json:"qci"`
-	ParentPoaName string       `json:"parentPoaName"`
-	InRangePoas   []InRangePoa `json:"inRangePoas"`
-	AppNames      []string     `json:"appNames"`
-	Latency       int32        `json:"latency"`
-	ThroughputUL  int32        `json:"throughputUL"`
-	ThroughputDL  int32        `json:"throughputDL"`
-	PacketLoss    float64      `json:"packetLoss"`
+	Name           string       `json:"name"`
+	ErabId         int32        `json:"erabId"`
+	Ecgi           *Ecgi        `json:"ecgi"`
+	Nrcgi          *Nrcgi       `json:"nrcgi"`
+	Qci            int32        `json:"qci"`
+	ParentPoaName  string       `json:"parentPoaName"`
+	S1Bearers      []S1Bearer   `json:"s1Bearers"`
+	InRangePoas    []InRangePoa `json:"inRangePoas"`
+	TimingAdvance  int32        `json:"timingAdvance"`
+	SecondaryCells []Ecgi       `json:"secondaryCells"`
+	AppNames       []string     `json:"appNames"`
+	Latency        int32        `json:"latency"`
+	ThroughputUL   int32        `json:"throughputUL"`
+	ThroughputDL   int32        `json:"throughputDL"`
+	PacketLoss     float64      `json:"packetLoss"`
+}
+
+// S1Bearer - UE S1 bearer; default bearer has no data network name
+type S1Bearer struct {
+	ErabId int32   `json:"erabId"`
+	Dnn    string  `json:"dnn"`
+	Qos    ErabQos `json:"qos"`
+}
+
+// ErabQos - E-RAB QoS parameters; bit rates in kbps
+type ErabQos struct {
+	Qci   int32 `json:"qci"`
+	GbrDl int32 `json:"gbrDl"`
+	GbrUl int32 `json:"gbrUl"`
+	MbrDl int32 `json:"mbrDl"`
+	MbrUl int32 `json:"mbrUl"`
 }
 
 type InRangePoa struct {
@@ -172,6 +239,16 @@ type AppStats struct {
 	DlTrafficLoss int32  `json:"dlos"`
 }
 
+// CellStats - Traffic aggregated over the UEs served by a cell; traffic in bits/s
+type CellStats struct {
+	UlTraffic     int32
+	DlTraffic     int32
+	UlTrafficLoss int32
+	DlTrafficLoss int32
+	ActiveUeUl    int32
+	ActiveUeDl    int32
+}
+
 type PoaInfo struct {
 	Type         string  `json:"type"`
 	Ecgi         Ecgi    `json:"ecgi"`
@@ -217,11 +294,6 @@ var sbxCtrlClient *scc.APIClient
 
 var registrationTicker *time.Ticker
 
-func notImplemented(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	w.WriteHeader(http.StatusNotImplemented)
-}
-
 // Init - RNI Service initialization
 func Init() (err error) {
 
@@ -313,6 +385,9 @@ func Init() (err error) {
 	// Set base storage key
 	baseKey = dkm.GetKeyRoot(sandboxName) + rnisKey + ":mep:" + mepName + ":"
 
+	// Set per-flow throughput metrics key populated by the sidecars
+	metricsBaseKey = dkm.GetKeyRoot(sandboxName) + metricsKey
+
 	// Connect to Redis DB (RNIS_DB)
 	rc, err = redis.NewConnector(redisAddr, RNIS_DB)
 	if err != nil {
@@ -439,9 +514,13 @@ func reInit() {
 	keyName := baseKey + "subscriptions:" + "*"
 	_ = rc.ForEachJSONEntry(keyName, repopulateCcSubscriptionMap, nil)
 	_ = rc.ForEachJSONEntry(keyName, repopulateReSubscriptionMap, nil)
+	_ = rc.ForEachJSONEntry(keyName, repopulateRmSubscriptionMap, nil)
 	_ = rc.ForEachJSONEntry(keyName, repopulateRrSubscriptionMap, nil)
 	_ = rc.ForEachJSONEntry(keyName, repopulateMrSubscriptionMap, nil)
 	_ = rc.ForEachJSONEntry(keyName, repopulateNrMrSubscriptionMap, nil)
+	_ = rc.ForEachJSONEntry(keyName, repopulateTaSubscriptionMap, nil)
+	_ = rc.ForEachJSONEntry(keyName, repopulateCaSubscriptionMap, nil)
+	_ = rc.ForEachJSONEntry(keyName, repopulateS1SubscriptionMap, nil)
 }
 
 // Run - Start RNIS
@@ -738,6 +817,7 @@ func updateUeData(obj sbi.UeDataSbi) {
 	oldNrPlmnMnc := ""
 	oldNrPlmnMcc := ""
 	oldNrcellId := ""
+	var oldS1Bearers []S1Bearer
 
 	//get from DB
 	jsonUeData, _ := rc.JSONGetEntry(baseKey+"UE:"+obj.Name, ".")
@@ -758,12 +838,23 @@ func updateUeData(obj sbi.UeDataSbi) {
 				oldNrPlmnMcc = ueDataObj.Nrcgi.Plmn.Mcc
 				oldNrcellId = ueDataObj.Nrcgi.NrcellId
 			}
+			oldS1Bearers = ueDataObj.S1Bearers
 			// Keep previous measurements
 			ueData.InRangePoas = ueDataObj.InRangePoas
+			ueData.TimingAdvance = ueDataObj.TimingAdvance
+			ueData.SecondaryCells = ueDataObj.SecondaryCells
 		}
 	}
+	//default bearer maximum bit rates follow UE network characteristics
+	var defaultQos ErabQos
+	defaultQos.Qci = defaultSupportedQci
+	defaultQos.MbrDl = obj.ThroughputDL * 1000
+	defaultQos.MbrUl = obj.ThroughputUL * 1000
+
 	//updateDB if changes occur (4G section)
-	if newEcgi.Plmn.Mnc != oldPlmnMnc || newEcgi.Plmn.Mcc != oldPlmnMcc || newEcgi.CellId != oldCellId {
+	ecgiChanged := newEcgi.Plmn.Mnc != oldPlmnMnc || newEcgi.Plmn.Mcc != oldPlmnMcc || newEcgi.CellId != oldCellId
+	nrcgiChanged := newNrcgi.Plmn.Mnc != oldNrPlmnMnc || newNrcgi.Plmn.Mcc != oldNrPlmnMcc || newNrcgi.NrcellId != oldNrcellId
+	if ecgiChanged {
 
 		//allocating a new erabId if entering a 4G environment (using existence of an erabId)
 		if oldErabId == -1 { //if no erabId established (== -1), means not coming from a 4G environment
@@ -782,6 +873,7 @@ func updateUeData(obj sbi.UeDataSbi) {
 				ueData.ErabId = -1
 			}
 		}
+		ueData.S1Bearers = getS1Bearers(ueData.ErabId, defaultQos, obj.PduSessions, oldS1Bearers)
 
 		_ = rc.JSONSetEntry(baseKey+"UE:"+obj.Name, ".", convertUeDataToJson(&ueData))
 		assocId := new(AssociateId)
@@ -801,34 +893,275 @@ func updateUeData(obj sbi.UeDataSbi) {
 		//5G section
 		//keep erabId info that was there
 		ueData.ErabId = oldErabId
+		//S1 bearers follow PDU session & network characteristic changes
+		ueData.S1Bearers = getS1Bearers(ueData.ErabId, defaultQos, obj.PduSessions, oldS1Bearers)
 
-		if newNrcgi.Plmn.Mnc != oldNrPlmnMnc || newNrcgi.Plmn.Mcc != oldNrPlmnMcc || newNrcgi.NrcellId != oldNrcellId {
-			//update because nrcgi changed
+		if nrcgiChanged || !isEqualS1Bearers(ueData.S1Bearers, oldS1Bearers) {
+			//update because nrcgi or S1 bearers changed
 			_ = rc.JSONSetEntry(baseKey+"UE:"+obj.Name, ".", convertUeDataToJson(&ueData))
 		}
 	}
+
+	assocId := new(AssociateId)
+	assocId.Type_ = 1 //UE_IPV4_ADDRESS
+	assocId.Value = obj.Name
+
+	//NR measurement report when serving NR cell changes
+	if nrcgiChanged && oldNrcellId != "" && newNrcgi.NrcellId != "" {
+		_ = checkNrMrNotificationRegisteredSubscriptions(baseKey+"UE:"+obj.Name, convertUeDataToJson(&ueData), int32(TRIGGER_NR_EVENT_A3))
+	}
+
+	//S1 bearer establishment, modification & release
+	var estS1Bearers []S1Bearer
+	var modS1Bearers []S1Bearer
+	var relS1Bearers []S1Bearer
+	for _, s1Bearer := range ueData.S1Bearers {
+		oldS1Bearer := getS1Bearer(s1Bearer.ErabId, oldS1Bearers)
+		if oldS1Bearer != nil {
+			//bearer moved to a new eNB
+			if ecgiChanged {
+				modS1Bearers = append(modS1Bearers, s1Bearer)
+			}
+			//E-RAB QoS modification
+			if s1Bearer.Qos != oldS1Bearer.Qos {
+				checkRmNotificationRegisteredSubscriptions("", assocId, &newEcgi, s1Bearer)
+			}
+		} else {
+			estS1Bearers = append(estS1Bearers, s1Bearer)
+		}
+	}
+	for _, s1Bearer := range oldS1Bearers {
+		if getS1Bearer(s1Bearer.ErabId, ueData.S1Bearers) == nil {
+			relS1Bearers = append(relS1Bearers, s1Bearer)
+		}
+	}
+	if len(estS1Bearers) > 0 || len(modS1Bearers) > 0 || len(relS1Bearers) > 0 {
+		if len(relS1Bearers) > 0 {
+			oldEcgi := Ecgi{CellId: oldCellId, Plmn: &Plmn{Mnc: oldPlmnMnc, Mcc: oldPlmnMcc}}
+			checkS1NotificationRegisteredSubscriptions(assocId, &oldEcgi, nil, S1_BEARER_RELEASE, relS1Bearers)
+		}
+		if len(modS1Bearers) > 0 {
+			oldEcgi := Ecgi{CellId: oldCellId, Plmn: &Plmn{Mnc: oldPlmnMnc, Mcc: oldPlmnMcc}}
+			checkS1NotificationRegisteredSubscriptions(assocId, &newEcgi, &oldEcgi, S1_BEARER_MODIFY, modS1Bearers)
+		}
+		if len(estS1Bearers) > 0 {
+			checkS1NotificationRegisteredSubscriptions(assocId, &newEcgi, nil, S1_BEARER_ESTABLISH, estS1Bearers)
+		}
+	}
+}
+
+// getS1Bearers - Get UE S1 bearers: default bearer & one dedicated bearer per PDU session data network
+func getS1Bearers(erabId int32, defaultQos ErabQos, pduSessions []sbi.PduSessionSbi, oldS1Bearers []S1Bearer) []S1Bearer {
+	//no S1 bearers if not connected to a POA-4G
+	if erabId == -1 {
+		return nil
+	}
+
+	s1Bearers := []S1Bearer{{ErabId: erabId, Qos: defaultQos}}
+	for _, pduSession := range pduSessions {
+		//keep erabId of existing dedicated bearers
+		s1Bearer := S1Bearer{ErabId: -1, Dnn: pduSession.Dnn, Qos: getPduSessionErabQos(pduSession)}
+		for _, oldS1Bearer := range oldS1Bearers {
+			if oldS1Bearer.Dnn == pduSession.Dnn {
+				s1Bearer.ErabId = oldS1Bearer.ErabId
+				break
+			}
+		}
+		if s1Bearer.ErabId == -1 {
+			s1Bearer.ErabId = int32(nextAvailableErabId)
+			nextAvailableErabId++
+		}
+		s1Bearers = append(s1Bearers, s1Bearer)
+	}
+	return s1Bearers
+}
+
+// getPduSessionErabQos - Get dedicated bearer QoS from PDU session QoS profile; 5QI used as QCI
+func getPduSessionErabQos(pduSession sbi.PduSessionSbi) ErabQos {
+	var qos ErabQos
+	qos.Qci = pduSession.FiveQi
+	if qos.Qci == 0 {
+		qos.Qci = defaultSupportedQci
+	}
+	qos.GbrDl = int32(pduSession.GbrDl * 1000)
+	qos.GbrUl = int32(pduSession.GbrUl * 1000)
+	qos.MbrDl = int32(pduSession.MbrDl * 1000)
+	qos.MbrUl = int32(pduSession.MbrUl * 1000)
+	return qos
+}
+
+func getS1Bearer(erabId int32, s1Bearers []S1Bearer) *S1Bearer {
+	for index := range s1Bearers {
+		if s1Bearers[index].ErabId == erabId {
+			return &s1Bearers[index]
+		}
+	}
+	return nil
+}
+
+func isEqualS1Bearers(s1Bearers []S1Bearer, otherS1Bearers []S1Bearer) bool {
+	if len(s1Bearers) != len(otherS1Bearers) {
+		return false
+	}
+	for index, s1Bearer := range s1Bearers {
+		if s1Bearer != otherS1Bearers[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// getS1BearerInfoDetailed - Get S1 bearer eNB & S-GW endpoints
+// GTP-U TEIDs are derived from the sandbox-wide unique erabId; transport layer addresses are not
+// provided by the scenario & are omitted
+func getS1BearerInfoDetailed(erabId int32) S1BearerInfoS1BearerInfoDetailed {
+	var s1BearerInfoDetailed S1BearerInfoS1BearerInfoDetailed
+	s1BearerInfoDetailed.ErabId = erabId
+	s1BearerInfoDetailed.EnbInfo = &S1BearerInfoEnbInfo{
+		TunnelId: fmt.Sprintf("%08x", uint32(erabId)),
+	}
+	s1BearerInfoDetailed.SGwInfo = &S1BearerInfoSGwInfo{
+		TunnelId: fmt.Sprintf("%08x", uint32(erabId)|s1SgwTeidFlag),
+	}
+	return s1BearerInfoDetailed
 }
 
-func updateMeasInfo(name string, parentPoaName string, inRangePoaNames []string, inRangeRsrps []int32, inRangeRsrqs []int32) {
+func updateMeasInfo(name string, parentPoaName string, inRangePoaNames []string, inRangeRsrps []int32, inRangeRsrqs []int32, inRangeDistances []float32) {
 
 	jsonUeData, _ := rc.JSONGetEntry(baseKey+"UE:"+name, ".")
 
 	if jsonUeData != "" {
 		ueDataObj := convertJsonToUeData(jsonUeData)
-		if ueDataObj != nil {
-			ueDataObj.ParentPoaName = parentPoaName
-			var inRangePoas []InRangePoa
-			for index := range inRangePoaNames {
-				var inRangePoa InRangePoa
-				inRangePoa.Name = inRangePoaNames[index]
-				inRangePoa.Rsrp = inRangeRsrps[index]
-				inRangePoa.Rsrq = inRangeRsrqs[index]
-				inRangePoas = append(inRangePoas, inRangePoa)
+		if ueDataObj == nil {
+			return
+		}
+		ueDataObj.ParentPoaName = parentPoaName
+		var inRangePoas []InRangePoa
+		for index := range inRangePoaNames {
+			var inRangePoa InRangePoa
+			inRangePoa.Name = inRangePoaNames[index]
+			inRangePoa.Rsrp = inRangeRsrps[index]
+			inRangePoa.Rsrq = inRangeRsrqs[index]
+			inRangePoas = append(inRangePoas, inRangePoa)
+		}
+		ueDataObj.InRangePoas = inRangePoas
+
+		//timing advance & carrier aggregation only apply to UEs served by a POA-4G
+		oldTimingAdvance := ueDataObj.TimingAdvance
+		oldSecondaryCells := ueDataObj.SecondaryCells
+		servedBy4G := ueDataObj.Ecgi != nil && ueDataObj.Ecgi.CellId != ""
+		var caMeasInfo []CaReconfNotificationCarrierAggregationMeasInfo
+		if servedBy4G {
+			for index, poaName := range inRangePoaNames {
+				if poaName == parentPoaName {
+					ueDataObj.TimingAdvance = getTimingAdvance(inRangeDistances[index])
+					break
+				}
 			}
-			ueDataObj.InRangePoas = inRangePoas
+			ueDataObj.SecondaryCells, caMeasInfo = getSecondaryCells(ueDataObj)
+		} else {
+			ueDataObj.TimingAdvance = 0
+			ueDataObj.SecondaryCells = nil
 		}
 		_ = rc.JSONSetEntry(baseKey+"UE:"+name, ".", convertUeDataToJson(ueDataObj))
+
+		if !servedBy4G {
+			return
+		}
+		assocId := new(AssociateId)
+		assocId.Type_ = 1 //UE_IPV4_ADDRESS
+		assocId.Value = name
+
+		if ueDataObj.TimingAdvance != oldTimingAdvance {
+			checkTaNotificationRegisteredSubscriptions(assocId, ueDataObj.Ecgi, ueDataObj.TimingAdvance)
+		}
+
+		var secondaryCellAdd []Ecgi
+		var secondaryCellRemove []Ecgi
+		for _, ecgi := range ueDataObj.SecondaryCells {
+			if !isEcgiPresent(ecgi, oldSecondaryCells) {
+				secondaryCellAdd = append(secondaryCellAdd, ecgi)
+			}
+		}
+		for _, ecgi := range oldSecondaryCells {
+			if !isEcgiPresent(ecgi, ueDataObj.SecondaryCells) {
+				secondaryCellRemove = append(secondaryCellRemove, ecgi)
+			}
+		}
+		if len(secondaryCellAdd) > 0 || len(secondaryCellRemove) > 0 {
+			checkCaNotificationRegisteredSubscriptions(assocId, ueDataObj.Ecgi, secondaryCellAdd, secondaryCellRemove, caMeasInfo)
+		}
+	}
+}
+
+// getTimingAdvance - Get timing advance from UE-eNB distance (meters)
+func getTimingAdvance(distance float32) int32 {
+	timingAdvance := int32(math.Round(float64(distance) / timingAdvanceStep))
+	if timingAdvance > maxTimingAdvance {
+		timingAdvance = maxTimingAdvance
+	}
+	return timingAdvance
+}
+
+// getSecondaryCells - Get carrier aggregation secondary cells from the strongest in-range POA-4G of the serving PLMN
+func getSecondaryCells(ueData *UeData) ([]Ecgi, []CaReconfNotificationCarrierAggregationMeasInfo) {
+	var servingPoa *InRangePoa
+	var candidatePoas []InRangePoa
+	for index, poa := range ueData.InRangePoas {
+		if poa.Name == ueData.ParentPoaName {
+			servingPoa = &ueData.InRangePoas[index]
+		} else if poa.Rsrp >= minSecondaryCellRsrp {
+			candidatePoas = append(candidatePoas, poa)
+		}
+	}
+	//no carrier aggregation without serving cell measurements
+	if servingPoa == nil {
+		return nil, nil
+	}
+	sort.Slice(candidatePoas, func(i, j int) bool {
+		if candidatePoas[i].Rsrp != candidatePoas[j].Rsrp {
+			return candidatePoas[i].Rsrp > candidatePoas[j].Rsrp
+		}
+		return candidatePoas[i].Name < candidatePoas[j].Name
+	})
+
+	var secondaryCells []Ecgi
+	var caMeasInfo []CaReconfNotificationCarrierAggregationMeasInfo
+	for _, poa := range candidatePoas {
+		if len(secondaryCells) >= maxSecondaryCells {
+			break
+		}
+		jsonInfo, _ := rc.JSONGetEntry(baseKey+"POA:"+poa.Name, ".")
+		if jsonInfo == "" {
+			continue
+		}
+		poaInfo := convertJsonToPoaInfo(jsonInfo)
+		if poaInfo == nil || poaInfo.Type != poaType4G || poaInfo.Ecgi.Plmn == nil ||
+			poaInfo.Ecgi.Plmn.Mcc != ueData.Ecgi.Plmn.Mcc || poaInfo.Ecgi.Plmn.Mnc != ueData.Ecgi.Plmn.Mnc {
+			continue
+		}
+		secondaryCells = append(secondaryCells, poaInfo.Ecgi)
+
+		var measInfo CaReconfNotificationCarrierAggregationMeasInfo
+		measInfo.CellIdSrv = ueData.Ecgi.CellId
+		measInfo.RsrpSrv = servingPoa.Rsrp
+		measInfo.RsrqSrv = servingPoa.Rsrq
+		measInfo.CellIdNei = poaInfo.Ecgi.CellId
+		measInfo.RsrpNei = poa.Rsrp
+		measInfo.RsrqNei = poa.Rsrq
+		caMeasInfo = append(caMeasInfo, measInfo)
+	}
+	return secondaryCells, caMeasInfo
+}
+
+func isEcgiPresent(ecgi Ecgi, ecgis []Ecgi) bool {
+	for _, otherEcgi := range ecgis {
+		if otherEcgi.CellId == ecgi.CellId && otherEcgi.Plmn != nil && ecgi.Plmn != nil &&
+			otherEcgi.Plmn.Mcc == ecgi.Plmn.Mcc && otherEcgi.Plmn.Mnc == ecgi.Plmn.Mnc {
+			return true
+		}
 	}
+	return false
 }
 
 func updatePoaInfo(obj sbi.PoaInfoSbi) {
@@ -940,8 +1273,16 @@ func checkForExpiredSubscriptions() {
 					cbRef = ccSubscriptionMap[subsId].CallbackReference
 				} else if reSubscriptionMap[subsId] != nil {
 					cbRef = reSubscriptionMap[subsId].CallbackReference
+				} else if rmSubscriptionMap[subsId] != nil {
+					cbRef = rmSubscriptionMap[subsId].CallbackReference
 				} else if rrSubscriptionMap[subsId] != nil {
 					cbRef = rrSubscriptionMap[subsId].CallbackReference
+				} else if taSubscriptionMap[subsId] != nil {
+					cbRef = taSubscriptionMap[subsId].CallbackReference
+				} else if caSubscriptionMap[subsId] != nil {
+					cbRef = caSubscriptionMap[subsId].CallbackReference
+				} else if s1SubscriptionMap[subsId] != nil {
+					cbRef = s1SubscriptionMap[subsId].CallbackReference
 				} else {
 					continue
 				}
@@ -981,6 +1322,11 @@ func repopulateCcSubscriptionMap(key string, jsonInfo string, userData interface
 		return err
 	}
 
+	// Ignore other subscription types
+	if subscription.SubscriptionType != CELL_CHANGE_SUBSCRIPTION {
+		return nil
+	}
+
 	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
 	subsIdStr := selfUrl[len(selfUrl)-1]
 	subsId, _ := strconv.Atoi(subsIdStr)
@@ -1013,6 +1359,11 @@ func repopulateReSubscriptionMap(key string, jsonInfo string, userData interface
 		return err
 	}
 
+	// Ignore other subscription types
+	if subscription.SubscriptionType != RAB_EST_SUBSCRIPTION {
+		return nil
+	}
+
 	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
 	subsIdStr := selfUrl[len(selfUrl)-1]
 	subsId, _ := strconv.Atoi(subsIdStr)
@@ -1035,6 +1386,43 @@ func repopulateReSubscriptionMap(key string, jsonInfo string, userData interface
 	return nil
 }
 
+func repopulateRmSubscriptionMap(key string, jsonInfo string, userData interface{}) error {
+
+	var subscription RabModSubscription
+
+	// Format response
+	err := json.Unmarshal([]byte(jsonInfo), &subscription)
+	if err != nil {
+		return err
+	}
+
+	// Ignore other subscription types
+	if subscription.SubscriptionType != RAB_MOD_SUBSCRIPTION {
+		return nil
+	}
+
+	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+	subsId, _ := strconv.Atoi(subsIdStr)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	rmSubscriptionMap[subsId] = &subscription
+	if subscription.ExpiryDeadline != nil {
+		intList := subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)] = intList
+	}
+
+	//reinitialisation of next available Id for future subscription request
+	if subsId >= nextSubscriptionIdAvailable {
+		nextSubscriptionIdAvailable = subsId + 1
+	}
+
+	return nil
+}
+
 func repopulateRrSubscriptionMap(key string, jsonInfo string, userData interface{}) error {
 
 	var subscription RabRelSubscription
@@ -1045,6 +1433,11 @@ func repopulateRrSubscriptionMap(key string, jsonInfo string, userData interface
 		return err
 	}
 
+	// Ignore other subscription types
+	if subscription.SubscriptionType != RAB_REL_SUBSCRIPTION {
+		return nil
+	}
+
 	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
 	subsIdStr := selfUrl[len(selfUrl)-1]
 	subsId, _ := strconv.Atoi(subsIdStr)
@@ -1077,6 +1470,11 @@ func repopulateMrSubscriptionMap(key string, jsonInfo string, userData interface
 		return err
 	}
 
+	// Ignore other subscription types
+	if subscription.SubscriptionType != MEAS_REP_UE_SUBSCRIPTION {
+		return nil
+	}
+
 	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
 	subsIdStr := selfUrl[len(selfUrl)-1]
 	subsId, _ := strconv.Atoi(subsIdStr)
@@ -1109,6 +1507,11 @@ func repopulateNrMrSubscriptionMap(key string, jsonInfo string, userData interfa
 		return err
 	}
 
+	// Ignore other subscription types
+	if subscription.SubscriptionType != NR_MEAS_REP_UE_SUBSCRIPTION {
+		return nil
+	}
+
 	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
 	subsIdStr := selfUrl[len(selfUrl)-1]
 	subsId, _ := strconv.Atoi(subsIdStr)
@@ -1131,6 +1534,117 @@ func repopulateNrMrSubscriptionMap(key string, jsonInfo string, userData interfa
 	return nil
 }
 
+func repopulateTaSubscriptionMap(key string, jsonInfo string, userData interface{}) error {
+
+	var subscription MeasTaSubscription
+
+	// Format response
+	err := json.Unmarshal([]byte(jsonInfo), &subscription)
+	if err != nil {
+		return err
+	}
+
+	// Ignore other subscription types
+	if subscription.SubscriptionType != MEAS_TA_SUBSCRIPTION {
+		return nil
+	}
+
+	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+	subsId, _ := strconv.Atoi(subsIdStr)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	taSubscriptionMap[subsId] = &subscription
+	if subscription.ExpiryDeadline != nil {
+		intList := subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)] = intList
+	}
+
+	//reinitialisation of next available Id for future subscription request
+	if subsId >= nextSubscriptionIdAvailable {
+		nextSubscriptionIdAvailable = subsId + 1
+	}
+
+	return nil
+}
+
+func repopulateCaSubscriptionMap(key string, jsonInfo string, userData interface{}) error {
+
+	var subscription CaReconfSubscription
+
+	// Format response
+	err := json.Unmarshal([]byte(jsonInfo), &subscription)
+	if err != nil {
+		return err
+	}
+
+	// Ignore other subscription types
+	if subscription.SubscriptionType != CA_RECONF_SUBSCRIPTION {
+		return nil
+	}
+
+	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+	subsId, _ := strconv.Atoi(subsIdStr)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	caSubscriptionMap[subsId] = &subscription
+	if subscription.ExpiryDeadline != nil {
+		intList := subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)] = intList
+	}
+
+	//reinitialisation of next available Id for future subscription request
+	if subsId >= nextSubscriptionIdAvailable {
+		nextSubscriptionIdAvailable = subsId + 1
+	}
+
+	return nil
+}
+
+func repopulateS1SubscriptionMap(key string, jsonInfo string, userData interface{}) error {
+
+	var subscription S1BearerSubscription
+
+	// Format response
+	err := json.Unmarshal([]byte(jsonInfo), &subscription)
+	if err != nil {
+		return err
+	}
+
+	// Ignore other subscription types
+	if subscription.SubscriptionType != S1_BEARER_SUBSCRIPTION {
+		return nil
+	}
+
+	selfUrl := strings.Split(subscription.Links.Self.Href, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+	subsId, _ := strconv.Atoi(subsIdStr)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	s1SubscriptionMap[subsId] = &subscription
+	if subscription.ExpiryDeadline != nil {
+		intList := subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)] = intList
+	}
+
+	//reinitialisation of next available Id for future subscription request
+	if subsId >= nextSubscriptionIdAvailable {
+		nextSubscriptionIdAvailable = subsId + 1
+	}
+
+	return nil
+}
+
 func isMatchCcFilterCriteriaAppInsId(filterCriteria interface{}, appId string) bool {
 	filter := filterCriteria.(*CellChangeSubscriptionFilterCriteriaAssocHo)
 
@@ -1167,19 +1681,35 @@ func isMatchRabRelFilterCriteriaErabId(filterCriteria interface{}, erabId int32)
 	return (erabId == filter.ErabId)
 }
 
-func isMatchCcFilterCriteriaAssociateId(filterCriteria interface{}, assocId *AssociateId) bool {
-	filter := filterCriteria.(*CellChangeSubscriptionFilterCriteriaAssocHo)
+func isMatchRabModFilterCriteriaErabId(filterCriteria interface{}, erabId int32) bool {
+	filter := filterCriteria.(*RabModSubscriptionFilterCriteriaQci)
 
 	//if filter criteria is not set, it acts as a wildcard and accepts all
-	if filter.AssociateId == nil {
+	if filter.ErabId == 0 {
 		return true
 	}
-	//if filter accepts something specific but no assocId, then we fail right away
-	if assocId == nil {
-		return false
-	}
-	for _, filterAssocId := range filter.AssociateId {
-		if assocId.Type_ == filterAssocId.Type_ && assocId.Value == filterAssocId.Value {
+	return (erabId == filter.ErabId)
+}
+
+func isMatchRabModFilterCriteriaQci(filterCriteria interface{}, qci int32) bool {
+	filter := filterCriteria.(*RabModSubscriptionFilterCriteriaQci)
+
+	return (qci == filter.Qci)
+}
+
+func isMatchCcFilterCriteriaAssociateId(filterCriteria interface{}, assocId *AssociateId) bool {
+	filter := filterCriteria.(*CellChangeSubscriptionFilterCriteriaAssocHo)
+
+	//if filter criteria is not set, it acts as a wildcard and accepts all
+	if filter.AssociateId == nil {
+		return true
+	}
+	//if filter accepts something specific but no assocId, then we fail right away
+	if assocId == nil {
+		return false
+	}
+	for _, filterAssocId := range filter.AssociateId {
+		if assocId.Type_ == filterAssocId.Type_ && assocId.Value == filterAssocId.Value {
 			return true
 		}
 	}
@@ -1477,13 +2007,160 @@ func isMatchNrMrFilterCriteriaNrcgi(filterCriteria interface{}, newPlmn *Plmn, o
 	return false
 }
 
+func isMatchAssocFilterCriteriaAssociateId(filterCriteria interface{}, assocId *AssociateId) bool {
+	filter := filterCriteria.(*CaReconfSubscriptionFilterCriteriaAssoc)
+
+	//if filter criteria is not set, it acts as a wildcard and accepts all
+	if filter.AssociateId == nil {
+		return true
+	}
+	//if filter accepts something specific but no assocId, then we fail right away
+	if assocId == nil {
+		return false
+	}
+	for _, filterAssocId := range filter.AssociateId {
+		if assocId.Type_ == filterAssocId.Type_ && assocId.Value == filterAssocId.Value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMatchAssocFilterCriteriaEcgi(filterCriteria interface{}, newPlmn *Plmn, oldPlmn *Plmn, newCellId string, oldCellId string) bool {
+	filter := filterCriteria.(*CaReconfSubscriptionFilterCriteriaAssoc)
+
+	//if filter criteria is not set, it acts as a wildcard and accepts all
+	if filter.Ecgi == nil {
+		return true
+	}
+
+	var matchingPlmn bool
+	for _, ecgi := range filter.Ecgi {
+		matchingPlmn = false
+		if ecgi.Plmn == nil {
+			matchingPlmn = true
+		} else {
+			if newPlmn != nil {
+				if newPlmn.Mnc == ecgi.Plmn.Mnc && newPlmn.Mcc == ecgi.Plmn.Mcc {
+					matchingPlmn = true
+				}
+			}
+			if oldPlmn != nil {
+				if oldPlmn.Mnc == ecgi.Plmn.Mnc && oldPlmn.Mcc == ecgi.Plmn.Mcc {
+					matchingPlmn = true
+				}
+			}
+		}
+		if matchingPlmn {
+			if ecgi.CellId == "" {
+				return true
+			}
+			if newCellId == ecgi.CellId {
+				return true
+			}
+			if oldCellId == ecgi.CellId {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isMatchS1FilterCriteriaAssociateId(filterCriteria interface{}, assocId *AssociateId) bool {
+	filter := filterCriteria.(*S1BearerSubscriptionS1BearerSubscriptionCriteria)
+
+	//if filter criteria is not set, it acts as a wildcard and accepts all
+	if filter.AssociateId == nil {
+		return true
+	}
+	//if filter accepts something specific but no assocId, then we fail right away
+	if assocId == nil {
+		return false
+	}
+	for _, filterAssocId := range filter.AssociateId {
+		if assocId.Type_ == filterAssocId.Type_ && assocId.Value == filterAssocId.Value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMatchS1FilterCriteriaEcgi(filterCriteria interface{}, newPlmn *Plmn, oldPlmn *Plmn, newCellId string, oldCellId string) bool {
+	filter := filterCriteria.(*S1BearerSubscriptionS1BearerSubscriptionCriteria)
+
+	//if filter criteria is not set, it acts as a wildcard and accepts all
+	if filter.Ecgi == nil {
+		return true
+	}
+
+	var matchingPlmn bool
+	for _, ecgi := range filter.Ecgi {
+		matchingPlmn = false
+		if ecgi.Plmn == nil {
+			matchingPlmn = true
+		} else {
+			if newPlmn != nil {
+				if newPlmn.Mnc == ecgi.Plmn.Mnc && newPlmn.Mcc == ecgi.Plmn.Mcc {
+					matchingPlmn = true
+				}
+			}
+			if oldPlmn != nil {
+				if oldPlmn.Mnc == ecgi.Plmn.Mnc && oldPlmn.Mcc == ecgi.Plmn.Mcc {
+					matchingPlmn = true
+				}
+			}
+		}
+		if matchingPlmn {
+			if ecgi.CellId == "" {
+				return true
+			}
+			if newCellId == ecgi.CellId {
+				return true
+			}
+			if oldCellId == ecgi.CellId {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isMatchS1FilterCriteriaErabId(filterCriteria interface{}, erabId int32) bool {
+	filter := filterCriteria.(*S1BearerSubscriptionS1BearerSubscriptionCriteria)
+
+	//if filter criteria is not set, it acts as a wildcard and accepts all
+	if filter.ErabId == nil {
+		return true
+	}
+	for _, filterErabId := range filter.ErabId {
+		if erabId == filterErabId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMatchS1EventType(eventTypes []int32, event int32) bool {
+	for _, eventType := range eventTypes {
+		if eventType == event {
+			return true
+		}
+	}
+	return false
+}
+
 func isMatchFilterCriteriaAppInsId(subscriptionType string, filterCriteria interface{}, appId string) bool {
 	switch subscriptionType {
 	case cellChangeSubscriptionType:
 		return isMatchCcFilterCriteriaAppInsId(filterCriteria, appId)
 	case rabEstSubscriptionType:
 		return isMatchRabFilterCriteriaAppInsId(filterCriteria, appId)
-	case rabRelSubscriptionType:
+	case rabModSubscriptionType, rabRelSubscriptionType:
 		return isMatchRabRelFilterCriteriaAppInsId(filterCriteria, appId)
 	}
 	return true
@@ -1493,12 +2170,16 @@ func isMatchFilterCriteriaAssociateId(subscriptionType string, filterCriteria in
 	switch subscriptionType {
 	case cellChangeSubscriptionType:
 		return isMatchCcFilterCriteriaAssociateId(filterCriteria, assocId)
-	case rabEstSubscriptionType, rabRelSubscriptionType:
+	case rabEstSubscriptionType, rabModSubscriptionType, rabRelSubscriptionType:
 		return true //not part of filter anymore in v2
 	case measRepUeSubscriptionType:
 		return isMatchMrFilterCriteriaAssociateId(filterCriteria, assocId)
 	case nrMeasRepUeSubscriptionType:
 		return isMatchNrMrFilterCriteriaAssociateId(filterCriteria, assocId)
+	case measTaSubscriptionType, caReconfSubscriptionType:
+		return isMatchAssocFilterCriteriaAssociateId(filterCriteria, assocId)
+	case s1BearerSubscriptionType:
+		return isMatchS1FilterCriteriaAssociateId(filterCriteria, assocId)
 	}
 	return true
 }
@@ -1509,10 +2190,14 @@ func isMatchFilterCriteriaEcgi(subscriptionType string, filterCriteria interface
 		return isMatchCcFilterCriteriaEcgi(filterCriteria, newPlmn, oldPlmn, newCellId, oldCellId)
 	case rabEstSubscriptionType:
 		return isMatchRabFilterCriteriaEcgi(filterCriteria, newPlmn, oldPlmn, newCellId, oldCellId)
-	case rabRelSubscriptionType:
+	case rabModSubscriptionType, rabRelSubscriptionType:
 		return isMatchRabRelFilterCriteriaEcgi(filterCriteria, newPlmn, oldPlmn, newCellId, oldCellId)
 	case measRepUeSubscriptionType:
 		return isMatchMrFilterCriteriaEcgi(filterCriteria, newPlmn, oldPlmn, newCellId, oldCellId)
+	case measTaSubscriptionType, caReconfSubscriptionType:
+		return isMatchAssocFilterCriteriaEcgi(filterCriteria, newPlmn, oldPlmn, newCellId, oldCellId)
+	case s1BearerSubscriptionType:
+		return isMatchS1FilterCriteriaEcgi(filterCriteria, newPlmn, oldPlmn, newCellId, oldCellId)
 	}
 	return true
 }
@@ -1710,6 +2395,87 @@ func checkReNotificationRegisteredSubscriptions(appId string, assocId *Associate
 	}
 }
 
+func checkRmNotificationRegisteredSubscriptions(appId string, assocId *AssociateId, ecgi *Ecgi, s1Bearer S1Bearer) {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	//check all that applies
+	for subsId, sub := range rmSubscriptionMap {
+
+		if sub != nil {
+
+			//verifying every criteria of the filter
+			//no check for appId
+			match := isMatchFilterCriteriaEcgi(rabModSubscriptionType, sub.FilterCriteriaQci, ecgi.Plmn, nil, ecgi.CellId, "")
+
+			if match {
+				match = isMatchRabModFilterCriteriaErabId(sub.FilterCriteriaQci, s1Bearer.ErabId)
+			}
+
+			if match {
+				match = isMatchRabModFilterCriteriaQci(sub.FilterCriteriaQci, s1Bearer.Qos.Qci)
+			}
+
+			if match {
+				subsIdStr := strconv.Itoa(subsId)
+				jsonInfo, _ := rc.JSONGetEntry(baseKey+"subscriptions:"+subsIdStr, ".")
+				if jsonInfo == "" {
+					return
+				}
+
+				subscription := convertJsonToRabModSubscription(jsonInfo)
+				log.Info("Sending RNIS notification ", subscription.CallbackReference)
+
+				var notif RabModNotification
+				notif.NotificationType = RAB_MOD_NOTIFICATION
+
+				var notifEcgi Ecgi
+				var notifPlmn Plmn
+				notifPlmn.Mnc = ecgi.Plmn.Mnc
+				notifPlmn.Mcc = ecgi.Plmn.Mcc
+				notifEcgi.Plmn = &notifPlmn
+				notifEcgi.CellId = ecgi.CellId
+
+				var notifAssociateId AssociateId
+				notifAssociateId.Type_ = assocId.Type_
+				notifAssociateId.Value = assocId.Value
+
+				seconds := time.Now().Unix()
+				var timeStamp TimeStamp
+				timeStamp.Seconds = int32(seconds)
+
+				var erabQosInfo RabModNotificationErabQosParametersQosInformation
+				erabQosInfo.ErabGbrDl = s1Bearer.Qos.GbrDl
+				erabQosInfo.ErabGbrUl = s1Bearer.Qos.GbrUl
+				erabQosInfo.ErabMbrDl = s1Bearer.Qos.MbrDl
+				erabQosInfo.ErabMbrUl = s1Bearer.Qos.MbrUl
+				var erabQos RabModNotificationErabQosParameters
+				erabQos.Qci = s1Bearer.Qos.Qci
+				erabQos.QosInformation = &erabQosInfo
+
+				notif.TimeStamp = &timeStamp
+				notif.Ecgi = &notifEcgi
+				notif.ErabId = s1Bearer.ErabId
+				notif.ErabQosParameters = &erabQos
+				notif.AssociateId = append(notif.AssociateId, notifAssociateId)
+				notif.Links = &CaReconfNotificationLinks{
+					&LinkType{
+						Href: hostUrl.String() + basePath + "subscriptions/" + subsIdStr,
+					},
+				}
+
+				if subscription.CallbackReference != "" {
+					sendRmNotification(subscription.CallbackReference, notif)
+					log.Info("Rab_modification Notification" + "(" + subsIdStr + ")")
+				} else if subscription.WebsockNotifConfig.RequestWebsocketUri {
+					log.Error("WebSocket functionality is not implemented currently")
+					return
+				}
+			}
+		}
+	}
+}
+
 func checkRrNotificationRegisteredSubscriptions(appId string, assocId *AssociateId, newPlmn *Plmn, oldPlmn *Plmn, qci int32, newCellId string, oldCellId string, erabId int32) {
 
 	//checking filters only if we were connected to a POA-4G and now disconnecting from one
@@ -1794,69 +2560,302 @@ func checkRrNotificationRegisteredSubscriptions(appId string, assocId *Associate
 	}
 }
 
-func checkMrPeriodicTrigger(trigger int32) {
-
-	//only check if there is at least one subscription
-	if len(mrSubscriptionMap) >= 1 {
-		keyName := baseKey + "UE:*"
-		err := rc.ForEachJSONEntry(keyName, checkMrNotificationRegisteredSubscriptions, int32(trigger))
-		if err != nil {
-			log.Error(err.Error())
-			return
-		}
-	}
-}
-
-func checkMrNotificationRegisteredSubscriptions(key string, jsonInfo string, extraInfo interface{}) error {
-	trigger := extraInfo.(int32)
-	// Retrieve user info from DB
-	var ueData UeData
-	err := json.Unmarshal([]byte(jsonInfo), &ueData)
-	if err != nil {
-		return err
-	}
+func checkS1NotificationRegisteredSubscriptions(assocId *AssociateId, newEcgi *Ecgi, oldEcgi *Ecgi, event int32, s1Bearers []S1Bearer) {
 
-	if ueData.Ecgi == nil || ueData.Ecgi.CellId == "" {
-		//that ue is not on a 4G poa
-		return nil
+	var oldPlmn *Plmn
+	oldCellId := ""
+	if oldEcgi != nil {
+		oldPlmn = oldEcgi.Plmn
+		oldCellId = oldEcgi.CellId
 	}
 
 	mutex.Lock()
 	defer mutex.Unlock()
 	//check all that applies
-	for subsId, sub := range mrSubscriptionMap {
+	for subsId, sub := range s1SubscriptionMap {
+
 		if sub != nil {
-			//verifying every criteria of the filter
-			//no check for appId
-			//match := isMatchFilterCriteriaAppInsId(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, appId)
 
-			assocId := new(AssociateId)
-			assocId.Type_ = 1 //UE_IPV4_ADDRESS
-			subKeys := strings.Split(key, ":")
-			assocId.Value = subKeys[len(subKeys)-1]
+			//verifying every criteria of the filter
+			match := isMatchS1EventType(sub.EventType, event)
 
-			match := isMatchFilterCriteriaAssociateId(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, assocId)
+			if match {
+				match = isMatchFilterCriteriaAssociateId(s1BearerSubscriptionType, sub.S1BearerSubscriptionCriteria, assocId)
+			}
 
 			if match {
-				if ueData.Ecgi != nil {
-					match = isMatchFilterCriteriaEcgi(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, ueData.Ecgi.Plmn, nil, ueData.Ecgi.CellId, "")
-				} else {
-					match = false
-				}
+				match = isMatchFilterCriteriaEcgi(s1BearerSubscriptionType, sub.S1BearerSubscriptionCriteria, newEcgi.Plmn, oldPlmn, newEcgi.CellId, oldCellId)
 			}
 
+			//only notify about S1 bearers matching the erabId filter
+			var s1BearerInfo []S1BearerInfoS1BearerInfoDetailed
 			if match {
-				match = isMatchFilterCriteriaTrigger(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, trigger)
+				for _, s1Bearer := range s1Bearers {
+					if isMatchS1FilterCriteriaErabId(sub.S1BearerSubscriptionCriteria, s1Bearer.ErabId) {
+						s1BearerInfo = append(s1BearerInfo, getS1BearerInfoDetailed(s1Bearer.ErabId))
+					}
+				}
+				match = len(s1BearerInfo) > 0
 			}
 
 			if match {
 				subsIdStr := strconv.Itoa(subsId)
 				jsonInfo, _ := rc.JSONGetEntry(baseKey+"subscriptions:"+subsIdStr, ".")
 				if jsonInfo == "" {
-					return nil
+					return
 				}
 
-				subscription := convertJsonToMeasRepUeSubscription(jsonInfo)
+				subscription := convertJsonToS1BearerSubscription(jsonInfo)
+				log.Info("Sending RNIS notification ", subscription.CallbackReference)
+
+				var notif S1BearerNotification
+				notif.NotificationType = S1_BEARER_NOTIFICATION
+				notif.S1Event = event
+
+				var notifEcgi Ecgi
+				var notifPlmn Plmn
+				notifPlmn.Mnc = newEcgi.Plmn.Mnc
+				notifPlmn.Mcc = newEcgi.Plmn.Mcc
+				notifEcgi.Plmn = &notifPlmn
+				notifEcgi.CellId = newEcgi.CellId
+
+				var notifAssociateId AssociateId
+				notifAssociateId.Type_ = assocId.Type_
+				notifAssociateId.Value = assocId.Value
+
+				seconds := time.Now().Unix()
+				var timeStamp TimeStamp
+				timeStamp.Seconds = int32(seconds)
+
+				var s1UeInfo S1BearerNotificationS1UeInfo
+				s1UeInfo.AssociateId = append(s1UeInfo.AssociateId, notifAssociateId)
+				s1UeInfo.Ecgi = append(s1UeInfo.Ecgi, notifEcgi)
+				s1UeInfo.S1BearerInfo = s1BearerInfo
+
+				notif.TimeStamp = &timeStamp
+				notif.S1UeInfo = &s1UeInfo
+				notif.Links = &CaReconfNotificationLinks{
+					&LinkType{
+						Href: hostUrl.String() + basePath + "subscriptions/" + subsIdStr,
+					},
+				}
+
+				if subscription.CallbackReference != "" {
+					sendS1Notification(subscription.CallbackReference, notif)
+					log.Info("S1_bearer Notification" + "(" + subsIdStr + ")")
+				} else if subscription.WebsockNotifConfig.RequestWebsocketUri {
+					log.Error("WebSocket functionality is not implemented currently")
+					return
+				}
+			}
+		}
+	}
+}
+
+func checkTaNotificationRegisteredSubscriptions(assocId *AssociateId, ecgi *Ecgi, timingAdvance int32) {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	//check all that applies
+	for subsId, sub := range taSubscriptionMap {
+
+		if sub != nil {
+
+			//verifying every criteria of the filter
+			//no check for appId
+			match := isMatchFilterCriteriaAssociateId(measTaSubscriptionType, sub.FilterCriteriaAssoc, assocId)
+
+			if match {
+				match = isMatchFilterCriteriaEcgi(measTaSubscriptionType, sub.FilterCriteriaAssoc, ecgi.Plmn, nil, ecgi.CellId, "")
+			}
+
+			if match {
+				subsIdStr := strconv.Itoa(subsId)
+				jsonInfo, _ := rc.JSONGetEntry(baseKey+"subscriptions:"+subsIdStr, ".")
+				if jsonInfo == "" {
+					return
+				}
+
+				subscription := convertJsonToMeasTaSubscription(jsonInfo)
+				log.Info("Sending RNIS notification ", subscription.CallbackReference)
+
+				var notif MeasTaNotification
+				notif.NotificationType = MEAS_TA_NOTIFICATION
+
+				var notifEcgi Ecgi
+				var notifPlmn Plmn
+				notifPlmn.Mnc = ecgi.Plmn.Mnc
+				notifPlmn.Mcc = ecgi.Plmn.Mcc
+				notifEcgi.Plmn = &notifPlmn
+				notifEcgi.CellId = ecgi.CellId
+
+				var notifAssociateId AssociateId
+				notifAssociateId.Type_ = assocId.Type_
+				notifAssociateId.Value = assocId.Value
+
+				seconds := time.Now().Unix()
+				var timeStamp TimeStamp
+				timeStamp.Seconds = int32(seconds)
+
+				notif.TimeStamp = &timeStamp
+				notif.Ecgi = &notifEcgi
+				notif.TimingAdvance = timingAdvance
+				notif.AssociateId = append(notif.AssociateId, notifAssociateId)
+				notif.Links = &CaReconfNotificationLinks{
+					&LinkType{
+						Href: hostUrl.String() + basePath + "subscriptions/" + subsIdStr,
+					},
+				}
+
+				if subscription.CallbackReference != "" {
+					go sendTaNotification(subscription.CallbackReference, notif)
+					log.Info("Meas_Ta Notification" + "(" + subsIdStr + ")")
+				} else if subscription.WebsockNotifConfig.RequestWebsocketUri {
+					log.Error("WebSocket functionality is not implemented currently")
+					return
+				}
+			}
+		}
+	}
+}
+
+func checkCaNotificationRegisteredSubscriptions(assocId *AssociateId, ecgi *Ecgi, secondaryCellAdd []Ecgi, secondaryCellRemove []Ecgi, caMeasInfo []CaReconfNotificationCarrierAggregationMeasInfo) {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	//check all that applies
+	for subsId, sub := range caSubscriptionMap {
+
+		if sub != nil {
+
+			//verifying every criteria of the filter
+			//no check for appId
+			match := isMatchFilterCriteriaAssociateId(caReconfSubscriptionType, sub.FilterCriteriaAssoc, assocId)
+
+			if match {
+				match = isMatchFilterCriteriaEcgi(caReconfSubscriptionType, sub.FilterCriteriaAssoc, ecgi.Plmn, nil, ecgi.CellId, "")
+			}
+
+			if match {
+				subsIdStr := strconv.Itoa(subsId)
+				jsonInfo, _ := rc.JSONGetEntry(baseKey+"subscriptions:"+subsIdStr, ".")
+				if jsonInfo == "" {
+					return
+				}
+
+				subscription := convertJsonToCaReconfSubscription(jsonInfo)
+				log.Info("Sending RNIS notification ", subscription.CallbackReference)
+
+				var notif CaReconfNotification
+				notif.NotificationType = CA_RECONF_NOTIFICATION
+
+				var notifEcgi Ecgi
+				var notifPlmn Plmn
+				notifPlmn.Mnc = ecgi.Plmn.Mnc
+				notifPlmn.Mcc = ecgi.Plmn.Mcc
+				notifEcgi.Plmn = &notifPlmn
+				notifEcgi.CellId = ecgi.CellId
+
+				var notifAssociateId AssociateId
+				notifAssociateId.Type_ = assocId.Type_
+				notifAssociateId.Value = assocId.Value
+
+				seconds := time.Now().Unix()
+				var timeStamp TimeStamp
+				timeStamp.Seconds = int32(seconds)
+
+				for index := range secondaryCellAdd {
+					notif.SecondaryCellAdd = append(notif.SecondaryCellAdd, CaReconfNotificationSecondaryCellAdd{Ecgi: &secondaryCellAdd[index]})
+				}
+				for index := range secondaryCellRemove {
+					notif.SecondaryCellRemove = append(notif.SecondaryCellRemove, CaReconfNotificationSecondaryCellAdd{Ecgi: &secondaryCellRemove[index]})
+				}
+
+				notif.TimeStamp = &timeStamp
+				notif.Ecgi = &notifEcgi
+				notif.CarrierAggregationMeasInfo = caMeasInfo
+				notif.AssociateId = append(notif.AssociateId, notifAssociateId)
+				notif.Links = &CaReconfNotificationLinks{
+					&LinkType{
+						Href: hostUrl.String() + basePath + "subscriptions/" + subsIdStr,
+					},
+				}
+
+				if subscription.CallbackReference != "" {
+					go sendCaNotification(subscription.CallbackReference, notif)
+					log.Info("Ca_Reconf Notification" + "(" + subsIdStr + ")")
+				} else if subscription.WebsockNotifConfig.RequestWebsocketUri {
+					log.Error("WebSocket functionality is not implemented currently")
+					return
+				}
+			}
+		}
+	}
+}
+
+func checkMrPeriodicTrigger(trigger int32) {
+
+	//only check if there is at least one subscription
+	if len(mrSubscriptionMap) >= 1 {
+		keyName := baseKey + "UE:*"
+		err := rc.ForEachJSONEntry(keyName, checkMrNotificationRegisteredSubscriptions, int32(trigger))
+		if err != nil {
+			log.Error(err.Error())
+			return
+		}
+	}
+}
+
+func checkMrNotificationRegisteredSubscriptions(key string, jsonInfo string, extraInfo interface{}) error {
+	trigger := extraInfo.(int32)
+	// Retrieve user info from DB
+	var ueData UeData
+	err := json.Unmarshal([]byte(jsonInfo), &ueData)
+	if err != nil {
+		return err
+	}
+
+	if ueData.Ecgi == nil || ueData.Ecgi.CellId == "" {
+		//that ue is not on a 4G poa
+		return nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	//check all that applies
+	for subsId, sub := range mrSubscriptionMap {
+		if sub != nil {
+			//verifying every criteria of the filter
+			//no check for appId
+			//match := isMatchFilterCriteriaAppInsId(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, appId)
+
+			assocId := new(AssociateId)
+			assocId.Type_ = 1 //UE_IPV4_ADDRESS
+			subKeys := strings.Split(key, ":")
+			assocId.Value = subKeys[len(subKeys)-1]
+
+			match := isMatchFilterCriteriaAssociateId(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, assocId)
+
+			if match {
+				if ueData.Ecgi != nil {
+					match = isMatchFilterCriteriaEcgi(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, ueData.Ecgi.Plmn, nil, ueData.Ecgi.CellId, "")
+				} else {
+					match = false
+				}
+			}
+
+			if match {
+				match = isMatchFilterCriteriaTrigger(measRepUeSubscriptionType, sub.FilterCriteriaAssocTri, trigger)
+			}
+
+			if match {
+				subsIdStr := strconv.Itoa(subsId)
+				jsonInfo, _ := rc.JSONGetEntry(baseKey+"subscriptions:"+subsIdStr, ".")
+				if jsonInfo == "" {
+					return nil
+				}
+
+				subscription := convertJsonToMeasRepUeSubscription(jsonInfo)
 
 				var notif MeasRepUeNotification
 				notif.NotificationType = MEAS_REP_UE_NOTIFICATION
@@ -2133,6 +3132,25 @@ func sendReNotification(notifyUrl string, notification RabEstNotification) {
 	defer resp.Body.Close()
 }
 
+func sendRmNotification(notifyUrl string, notification RabModNotification) {
+	startTime := time.Now()
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	resp, err := http.Post(notifyUrl, "application/json", bytes.NewBuffer(jsonNotif))
+	duration := float64(time.Since(startTime).Microseconds()) / 1000.0
+	_ = httpLog.LogNotification(notifyUrl, "POST", "", "", string(jsonNotif), resp, startTime)
+	if err != nil {
+		log.Error(err)
+		met.ObserveNotification(sandboxName, serviceName, notifRabMod, notifyUrl, nil, duration)
+		return
+	}
+	met.ObserveNotification(sandboxName, serviceName, notifRabMod, notifyUrl, resp, duration)
+	defer resp.Body.Close()
+}
+
 func sendRrNotification(notifyUrl string, notification RabRelNotification) {
 	startTime := time.Now()
 	jsonNotif, err := json.Marshal(notification)
@@ -2190,6 +3208,63 @@ func sendNrMrNotification(notifyUrl string, notification NrMeasRepUeNotification
 	defer resp.Body.Close()
 }
 
+func sendTaNotification(notifyUrl string, notification MeasTaNotification) {
+	startTime := time.Now()
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	resp, err := http.Post(notifyUrl, "application/json", bytes.NewBuffer(jsonNotif))
+	duration := float64(time.Since(startTime).Microseconds()) / 1000.0
+	_ = httpLog.LogNotification(notifyUrl, "POST", "", "", string(jsonNotif), resp, startTime)
+	if err != nil {
+		log.Error(err)
+		met.ObserveNotification(sandboxName, serviceName, notifMeasTa, notifyUrl, nil, duration)
+		return
+	}
+	met.ObserveNotification(sandboxName, serviceName, notifMeasTa, notifyUrl, resp, duration)
+	defer resp.Body.Close()
+}
+
+func sendCaNotification(notifyUrl string, notification CaReconfNotification) {
+	startTime := time.Now()
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	resp, err := http.Post(notifyUrl, "application/json", bytes.NewBuffer(jsonNotif))
+	duration := float64(time.Since(startTime).Microseconds()) / 1000.0
+	_ = httpLog.LogNotification(notifyUrl, "POST", "", "", string(jsonNotif), resp, startTime)
+	if err != nil {
+		log.Error(err)
+		met.ObserveNotification(sandboxName, serviceName, notifCaReConf, notifyUrl, nil, duration)
+		return
+	}
+	met.ObserveNotification(sandboxName, serviceName, notifCaReConf, notifyUrl, resp, duration)
+	defer resp.Body.Close()
+}
+
+func sendS1Notification(notifyUrl string, notification S1BearerNotification) {
+	startTime := time.Now()
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	resp, err := http.Post(notifyUrl, "application/json", bytes.NewBuffer(jsonNotif))
+	duration := float64(time.Since(startTime).Microseconds()) / 1000.0
+	_ = httpLog.LogNotification(notifyUrl, "POST", "", "", string(jsonNotif), resp, startTime)
+	if err != nil {
+		log.Error(err)
+		met.ObserveNotification(sandboxName, serviceName, notifS1Bearer, notifyUrl, nil, duration)
+		return
+	}
+	met.ObserveNotification(sandboxName, serviceName, notifS1Bearer, notifyUrl, resp, duration)
+	defer resp.Body.Close()
+}
+
 func sendExpiryNotification(notifyUrl string, notification ExpiryNotification) {
 	startTime := time.Now()
 	jsonNotif, err := json.Marshal(notification)
@@ -2253,6 +3328,17 @@ func subscriptionsGet(w http.ResponseWriter, r *http.Request) {
 
 		jsonResponse, err = json.Marshal(subscription)
 
+	case RAB_MOD_SUBSCRIPTION:
+		var subscription RabModSubscription
+		err = json.Unmarshal([]byte(jsonRespDB), &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResponse, err = json.Marshal(subscription)
+
 	case RAB_REL_SUBSCRIPTION:
 		var subscription RabRelSubscription
 		err = json.Unmarshal([]byte(jsonRespDB), &subscription)
@@ -2286,6 +3372,39 @@ func subscriptionsGet(w http.ResponseWriter, r *http.Request) {
 
 		jsonResponse, err = json.Marshal(subscription)
 
+	case MEAS_TA_SUBSCRIPTION:
+		var subscription MeasTaSubscription
+		err = json.Unmarshal([]byte(jsonRespDB), &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResponse, err = json.Marshal(subscription)
+
+	case CA_RECONF_SUBSCRIPTION:
+		var subscription CaReconfSubscription
+		err = json.Unmarshal([]byte(jsonRespDB), &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResponse, err = json.Marshal(subscription)
+
+	case S1_BEARER_SUBSCRIPTION:
+		var subscription S1BearerSubscription
+		err = json.Unmarshal([]byte(jsonRespDB), &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResponse, err = json.Marshal(subscription)
+
 	default:
 		log.Error("Unknown subscription type")
 		w.WriteHeader(http.StatusBadRequest)
@@ -2408,6 +3527,43 @@ func subscriptionsPost(w http.ResponseWriter, r *http.Request) {
 
 		jsonResponse, err = json.Marshal(subscription)
 
+	case RAB_MOD_SUBSCRIPTION:
+		var subscription RabModSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		subscription.Links = link
+
+		if subscription.FilterCriteriaQci == nil {
+			log.Error("FilterCriteriaQci should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaQci should not be null for this subscription type", http.StatusBadRequest)
+			return
+		}
+
+		if subscription.FilterCriteriaQci.Qci == 0 {
+			log.Error("Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci")
+			errHandlerProblemDetails(w, "Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci", http.StatusBadRequest)
+			return
+		}
+
+		for _, ecgi := range subscription.FilterCriteriaQci.Ecgi {
+			if ecgi.Plmn == nil || ecgi.CellId == "" {
+				log.Error("For non null ecgi, plmn and cellId are mandatory")
+				errHandlerProblemDetails(w, "For non null ecgi,  plmn and cellId are mandatory", http.StatusBadRequest)
+				return
+			}
+		}
+
+		//registration
+		registerRm(&subscription, subsIdStr)
+		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertRabModSubscriptionToJson(&subscription))
+
+		jsonResponse, err = json.Marshal(subscription)
+
 	case RAB_REL_SUBSCRIPTION:
 		var subscription RabRelSubscription
 		err = json.Unmarshal(bodyBytes, &subscription)
@@ -2425,19 +3581,137 @@ func subscriptionsPost(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		if subscription.FilterCriteriaQci.Qci == 0 {
-			log.Error("Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci")
-			errHandlerProblemDetails(w, "Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci", http.StatusBadRequest)
-			return
-		}
+		if subscription.FilterCriteriaQci.Qci == 0 {
+			log.Error("Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci")
+			errHandlerProblemDetails(w, "Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci", http.StatusBadRequest)
+			return
+		}
+
+		if subscription.FilterCriteriaQci.ErabId == 0 {
+			log.Error("Missing or non valid value of 0 mandatory ErabId parameter in FilterCriteriaQci")
+			errHandlerProblemDetails(w, "Missing or non valid value of 0 for mandatory ErabId parameter in FilterCriteriaQci", http.StatusBadRequest)
+			return
+		}
+
+		for _, ecgi := range subscription.FilterCriteriaQci.Ecgi {
+			if ecgi.Plmn == nil || ecgi.CellId == "" {
+				log.Error("For non null ecgi, plmn and cellId are mandatory")
+				errHandlerProblemDetails(w, "For non null ecgi,  plmn and cellId are mandatory", http.StatusBadRequest)
+				return
+			}
+		}
+
+		//registration
+		registerRr(&subscription, subsIdStr)
+		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertRabRelSubscriptionToJson(&subscription))
+
+		jsonResponse, err = json.Marshal(subscription)
+
+	case MEAS_REP_UE_SUBSCRIPTION:
+		var subscription MeasRepUeSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		subscription.Links = link
+
+		if subscription.FilterCriteriaAssocTri == nil {
+			log.Error("FilterCriteriaAssocTri should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaAssocTri should not be null for this subscription type", http.StatusBadRequest)
+			return
+		}
+
+		for _, ecgi := range subscription.FilterCriteriaAssocTri.Ecgi {
+			if ecgi.Plmn == nil || ecgi.CellId == "" {
+				log.Error("For non null ecgi, plmn and cellId are mandatory")
+				errHandlerProblemDetails(w, "For non null ecgi,  plmn and cellId are mandatory", http.StatusBadRequest)
+				return
+			}
+		}
+
+		//although trigger is optional, lets force it to support the only trigger that we support if it is not there already
+		supportedTriggerAlreadyPresent := false
+		for _, currentTrigger := range subscription.FilterCriteriaAssocTri.Trigger {
+			if currentTrigger == TRIGGER_PERIODICAL_REPORT_STRONGEST_CELLS {
+				//already part of the list, no update needed
+				supportedTriggerAlreadyPresent = true
+			}
+		}
+
+		if !supportedTriggerAlreadyPresent {
+			subscription.FilterCriteriaAssocTri.Trigger = append(subscription.FilterCriteriaAssocTri.Trigger, TRIGGER_PERIODICAL_REPORT_STRONGEST_CELLS)
+		}
+
+		//registration
+		registerMr(&subscription, subsIdStr)
+		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertMeasRepUeSubscriptionToJson(&subscription))
+
+		jsonResponse, err = json.Marshal(subscription)
+
+	case NR_MEAS_REP_UE_SUBSCRIPTION:
+		var subscription NrMeasRepUeSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		subscription.Links = link
+
+		if subscription.FilterCriteriaNrMrs == nil {
+			log.Error("FilterCriteriaNrMrs should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaNrMrs should not be null for this subscription type", http.StatusBadRequest)
+			return
+		}
+
+		for _, nrcgi := range subscription.FilterCriteriaNrMrs.Nrcgi {
+			if nrcgi.Plmn == nil || nrcgi.NrcellId == "" {
+				log.Error("For non null nrcgi, plmn and cellId are mandatory")
+				errHandlerProblemDetails(w, "For non null nrcgi,  plmn and cellId are mandatory", http.StatusBadRequest)
+				return
+			}
+		}
+
+		//although trigger is optional, lets force it to support the periodic trigger if no supported trigger is there already
+		supportedTriggerAlreadyPresent := false
+		for _, currentTrigger := range subscription.FilterCriteriaNrMrs.TriggerNr {
+			if currentTrigger == TRIGGER_NR_NR_PERIODICAL || currentTrigger == TRIGGER_NR_EVENT_A3 {
+				//already part of the list, no update needed
+				supportedTriggerAlreadyPresent = true
+			}
+		}
+		if !supportedTriggerAlreadyPresent {
+			subscription.FilterCriteriaNrMrs.TriggerNr = append(subscription.FilterCriteriaNrMrs.TriggerNr, TRIGGER_NR_NR_PERIODICAL)
+		}
+
+		//registration
+		registerNrMr(&subscription, subsIdStr)
+		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertNrMeasRepUeSubscriptionToJson(&subscription))
+
+		jsonResponse, err = json.Marshal(subscription)
+
+	case MEAS_TA_SUBSCRIPTION:
+		var subscription MeasTaSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		subscription.Links = link
 
-		if subscription.FilterCriteriaQci.ErabId == 0 {
-			log.Error("Missing or non valid value of 0 mandatory ErabId parameter in FilterCriteriaQci")
-			errHandlerProblemDetails(w, "Missing or non valid value of 0 for mandatory ErabId parameter in FilterCriteriaQci", http.StatusBadRequest)
+		if subscription.FilterCriteriaAssoc == nil {
+			log.Error("FilterCriteriaAssoc should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaAssoc should not be null for this subscription type", http.StatusBadRequest)
 			return
 		}
 
-		for _, ecgi := range subscription.FilterCriteriaQci.Ecgi {
+		for _, ecgi := range subscription.FilterCriteriaAssoc.Ecgi {
 			if ecgi.Plmn == nil || ecgi.CellId == "" {
 				log.Error("For non null ecgi, plmn and cellId are mandatory")
 				errHandlerProblemDetails(w, "For non null ecgi,  plmn and cellId are mandatory", http.StatusBadRequest)
@@ -2446,13 +3720,13 @@ func subscriptionsPost(w http.ResponseWriter, r *http.Request) {
 		}
 
 		//registration
-		registerRr(&subscription, subsIdStr)
-		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertRabRelSubscriptionToJson(&subscription))
+		registerTa(&subscription, subsIdStr)
+		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertMeasTaSubscriptionToJson(&subscription))
 
 		jsonResponse, err = json.Marshal(subscription)
 
-	case MEAS_REP_UE_SUBSCRIPTION:
-		var subscription MeasRepUeSubscription
+	case CA_RECONF_SUBSCRIPTION:
+		var subscription CaReconfSubscription
 		err = json.Unmarshal(bodyBytes, &subscription)
 		if err != nil {
 			log.Error(err.Error())
@@ -2462,13 +3736,13 @@ func subscriptionsPost(w http.ResponseWriter, r *http.Request) {
 
 		subscription.Links = link
 
-		if subscription.FilterCriteriaAssocTri == nil {
-			log.Error("FilterCriteriaAssocTri should not be null for this subscription type")
-			errHandlerProblemDetails(w, "FilterCriteriaAssocTri should not be null for this subscription type", http.StatusBadRequest)
+		if subscription.FilterCriteriaAssoc == nil {
+			log.Error("FilterCriteriaAssoc should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaAssoc should not be null for this subscription type", http.StatusBadRequest)
 			return
 		}
 
-		for _, ecgi := range subscription.FilterCriteriaAssocTri.Ecgi {
+		for _, ecgi := range subscription.FilterCriteriaAssoc.Ecgi {
 			if ecgi.Plmn == nil || ecgi.CellId == "" {
 				log.Error("For non null ecgi, plmn and cellId are mandatory")
 				errHandlerProblemDetails(w, "For non null ecgi,  plmn and cellId are mandatory", http.StatusBadRequest)
@@ -2476,27 +3750,14 @@ func subscriptionsPost(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
-		//although trigger is optional, lets force it to support the only trigger that we support if it is not there already
-		supportedTriggerAlreadyPresent := false
-		for _, currentTrigger := range subscription.FilterCriteriaAssocTri.Trigger {
-			if currentTrigger == TRIGGER_PERIODICAL_REPORT_STRONGEST_CELLS {
-				//already part of the list, no update needed
-				supportedTriggerAlreadyPresent = true
-			}
-		}
-
-		if !supportedTriggerAlreadyPresent {
-			subscription.FilterCriteriaAssocTri.Trigger = append(subscription.FilterCriteriaAssocTri.Trigger, TRIGGER_PERIODICAL_REPORT_STRONGEST_CELLS)
-		}
-
 		//registration
-		registerMr(&subscription, subsIdStr)
-		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertMeasRepUeSubscriptionToJson(&subscription))
+		registerCa(&subscription, subsIdStr)
+		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertCaReconfSubscriptionToJson(&subscription))
 
 		jsonResponse, err = json.Marshal(subscription)
 
-	case NR_MEAS_REP_UE_SUBSCRIPTION:
-		var subscription NrMeasRepUeSubscription
+	case S1_BEARER_SUBSCRIPTION:
+		var subscription S1BearerSubscription
 		err = json.Unmarshal(bodyBytes, &subscription)
 		if err != nil {
 			log.Error(err.Error())
@@ -2506,35 +3767,29 @@ func subscriptionsPost(w http.ResponseWriter, r *http.Request) {
 
 		subscription.Links = link
 
-		if subscription.FilterCriteriaNrMrs == nil {
-			log.Error("FilterCriteriaNrMrs should not be null for this subscription type")
-			errHandlerProblemDetails(w, "FilterCriteriaNrMrs should not be null for this subscription type", http.StatusBadRequest)
+		if subscription.S1BearerSubscriptionCriteria == nil {
+			log.Error("S1BearerSubscriptionCriteria should not be null for this subscription type")
+			errHandlerProblemDetails(w, "S1BearerSubscriptionCriteria should not be null for this subscription type", http.StatusBadRequest)
 			return
 		}
 
-		for _, nrcgi := range subscription.FilterCriteriaNrMrs.Nrcgi {
-			if nrcgi.Plmn == nil || nrcgi.NrcellId == "" {
-				log.Error("For non null nrcgi, plmn and cellId are mandatory")
-				errHandlerProblemDetails(w, "For non null nrcgi,  plmn and cellId are mandatory", http.StatusBadRequest)
-				return
-			}
+		if !isValidS1EventType(subscription.EventType) {
+			log.Error("Missing or non valid value for mandatory EventType parameter")
+			errHandlerProblemDetails(w, "Missing or non valid value for mandatory EventType parameter", http.StatusBadRequest)
+			return
 		}
 
-		//although trigger is optional, lets force it to support the only trigger that we support if it is not there already
-		supportedTriggerAlreadyPresent := false
-		for _, currentTrigger := range subscription.FilterCriteriaNrMrs.TriggerNr {
-			if currentTrigger == TRIGGER_NR_NR_PERIODICAL {
-				//already part of the list, no update needed
-				supportedTriggerAlreadyPresent = true
+		for _, ecgi := range subscription.S1BearerSubscriptionCriteria.Ecgi {
+			if ecgi.Plmn == nil || ecgi.CellId == "" {
+				log.Error("For non null ecgi, plmn and cellId are mandatory")
+				errHandlerProblemDetails(w, "For non null ecgi,  plmn and cellId are mandatory", http.StatusBadRequest)
+				return
 			}
 		}
-		if !supportedTriggerAlreadyPresent {
-			subscription.FilterCriteriaNrMrs.TriggerNr = append(subscription.FilterCriteriaNrMrs.TriggerNr, TRIGGER_NR_NR_PERIODICAL)
-		}
 
 		//registration
-		registerNrMr(&subscription, subsIdStr)
-		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertNrMeasRepUeSubscriptionToJson(&subscription))
+		registerS1(&subscription, subsIdStr)
+		_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertS1BearerSubscriptionToJson(&subscription))
 
 		jsonResponse, err = json.Marshal(subscription)
 
@@ -2647,6 +3902,34 @@ func subscriptionsPut(w http.ResponseWriter, r *http.Request) {
 			alreadyRegistered = true
 			jsonResponse, err = json.Marshal(subscription)
 		}
+	case RAB_MOD_SUBSCRIPTION:
+		var subscription RabModSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if subscription.FilterCriteriaQci == nil {
+			log.Error("FilterCriteriaQci should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaQci should not be null for this subscription type", http.StatusBadRequest)
+			return
+		}
+
+		if subscription.FilterCriteriaQci.Qci == 0 {
+			log.Error("Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci")
+			errHandlerProblemDetails(w, "Missing or non valid value for mandatory Qci parameter in FilterCriteriaQci", http.StatusBadRequest)
+			return
+		}
+
+		//registration
+		if isSubscriptionIdRegisteredRm(subsIdStr) {
+			registerRm(&subscription, subsIdStr)
+			_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertRabModSubscriptionToJson(&subscription))
+			alreadyRegistered = true
+			jsonResponse, err = json.Marshal(subscription)
+		}
 	case RAB_REL_SUBSCRIPTION:
 		var subscription RabRelSubscription
 		err = json.Unmarshal(bodyBytes, &subscription)
@@ -2713,6 +3996,78 @@ func subscriptionsPut(w http.ResponseWriter, r *http.Request) {
 			alreadyRegistered = true
 			jsonResponse, err = json.Marshal(subscription)
 		}
+	case MEAS_TA_SUBSCRIPTION:
+		var subscription MeasTaSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if subscription.FilterCriteriaAssoc == nil {
+			log.Error("FilterCriteriaAssoc should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaAssoc should not be null for this subscription type", http.StatusBadRequest)
+			return
+		}
+
+		//registration
+		if isSubscriptionIdRegisteredTa(subsIdStr) {
+			registerTa(&subscription, subsIdStr)
+			_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertMeasTaSubscriptionToJson(&subscription))
+			alreadyRegistered = true
+			jsonResponse, err = json.Marshal(subscription)
+		}
+	case CA_RECONF_SUBSCRIPTION:
+		var subscription CaReconfSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if subscription.FilterCriteriaAssoc == nil {
+			log.Error("FilterCriteriaAssoc should not be null for this subscription type")
+			errHandlerProblemDetails(w, "FilterCriteriaAssoc should not be null for this subscription type", http.StatusBadRequest)
+			return
+		}
+
+		//registration
+		if isSubscriptionIdRegisteredCa(subsIdStr) {
+			registerCa(&subscription, subsIdStr)
+			_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertCaReconfSubscriptionToJson(&subscription))
+			alreadyRegistered = true
+			jsonResponse, err = json.Marshal(subscription)
+		}
+	case S1_BEARER_SUBSCRIPTION:
+		var subscription S1BearerSubscription
+		err = json.Unmarshal(bodyBytes, &subscription)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if subscription.S1BearerSubscriptionCriteria == nil {
+			log.Error("S1BearerSubscriptionCriteria should not be null for this subscription type")
+			errHandlerProblemDetails(w, "S1BearerSubscriptionCriteria should not be null for this subscription type", http.StatusBadRequest)
+			return
+		}
+
+		if !isValidS1EventType(subscription.EventType) {
+			log.Error("Missing or non valid value for mandatory EventType parameter")
+			errHandlerProblemDetails(w, "Missing or non valid value for mandatory EventType parameter", http.StatusBadRequest)
+			return
+		}
+
+		//registration
+		if isSubscriptionIdRegisteredS1(subsIdStr) {
+			registerS1(&subscription, subsIdStr)
+			_ = rc.JSONSetEntry(baseKey+"subscriptions:"+subsIdStr, ".", convertS1BearerSubscriptionToJson(&subscription))
+			alreadyRegistered = true
+			jsonResponse, err = json.Marshal(subscription)
+		}
 	default:
 		w.WriteHeader(http.StatusBadRequest)
 		return
@@ -2779,6 +4134,20 @@ func isSubscriptionIdRegisteredRe(subsIdStr string) bool {
 	return returnVal
 }
 
+func isSubscriptionIdRegisteredRm(subsIdStr string) bool {
+	var returnVal bool
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if rmSubscriptionMap[subsId] != nil {
+		returnVal = true
+	} else {
+		returnVal = false
+	}
+	return returnVal
+}
+
 func isSubscriptionIdRegisteredRr(subsIdStr string) bool {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	var returnVal bool
@@ -2821,6 +4190,60 @@ func isSubscriptionIdRegisteredNrMr(subsIdStr string) bool {
 	return returnVal
 }
 
+func isSubscriptionIdRegisteredTa(subsIdStr string) bool {
+	var returnVal bool
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if taSubscriptionMap[subsId] != nil {
+		returnVal = true
+	} else {
+		returnVal = false
+	}
+	return returnVal
+}
+
+func isSubscriptionIdRegisteredCa(subsIdStr string) bool {
+	var returnVal bool
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if caSubscriptionMap[subsId] != nil {
+		returnVal = true
+	} else {
+		returnVal = false
+	}
+	return returnVal
+}
+
+func isSubscriptionIdRegisteredS1(subsIdStr string) bool {
+	var returnVal bool
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if s1SubscriptionMap[subsId] != nil {
+		returnVal = true
+	} else {
+		returnVal = false
+	}
+	return returnVal
+}
+
+func isValidS1EventType(eventTypes []int32) bool {
+	if len(eventTypes) == 0 {
+		return false
+	}
+	for _, eventType := range eventTypes {
+		if eventType != S1_BEARER_ESTABLISH && eventType != S1_BEARER_MODIFY && eventType != S1_BEARER_RELEASE {
+			return false
+		}
+	}
+	return true
+}
+
 func registerCc(cellChangeSubscription *CellChangeSubscription, subsIdStr string) {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	mutex.Lock()
@@ -2851,6 +4274,21 @@ func registerRe(rabEstSubscription *RabEstSubscription, subsIdStr string) {
 	log.Info("New registration: ", subsId, " type: ", rabEstSubscriptionType)
 }
 
+func registerRm(rabModSubscription *RabModSubscription, subsIdStr string) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	rmSubscriptionMap[subsId] = rabModSubscription
+	if rabModSubscription.ExpiryDeadline != nil {
+		//get current list of subscription meant to expire at this time
+		intList := subscriptionExpiryMap[int(rabModSubscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(rabModSubscription.ExpiryDeadline.Seconds)] = intList
+	}
+	log.Info("New registration: ", subsId, " type: ", rabModSubscriptionType)
+}
+
 func registerRr(rabRelSubscription *RabRelSubscription, subsIdStr string) {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	mutex.Lock()
@@ -2896,58 +4334,147 @@ func registerNrMr(nrMeasRepUeSubscription *NrMeasRepUeSubscription, subsIdStr st
 	log.Info("New registration: ", subsId, " type: ", nrMeasRepUeSubscriptionType)
 }
 
-func deregisterCc(subsIdStr string, mutexTaken bool) {
+func registerTa(measTaSubscription *MeasTaSubscription, subsIdStr string) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	taSubscriptionMap[subsId] = measTaSubscription
+	if measTaSubscription.ExpiryDeadline != nil {
+		//get current list of subscription meant to expire at this time
+		intList := subscriptionExpiryMap[int(measTaSubscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(measTaSubscription.ExpiryDeadline.Seconds)] = intList
+	}
+	log.Info("New registration: ", subsId, " type: ", measTaSubscriptionType)
+}
+
+func registerCa(caReconfSubscription *CaReconfSubscription, subsIdStr string) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	caSubscriptionMap[subsId] = caReconfSubscription
+	if caReconfSubscription.ExpiryDeadline != nil {
+		//get current list of subscription meant to expire at this time
+		intList := subscriptionExpiryMap[int(caReconfSubscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(caReconfSubscription.ExpiryDeadline.Seconds)] = intList
+	}
+	log.Info("New registration: ", subsId, " type: ", caReconfSubscriptionType)
+}
+
+func registerS1(s1BearerSubscription *S1BearerSubscription, subsIdStr string) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	s1SubscriptionMap[subsId] = s1BearerSubscription
+	if s1BearerSubscription.ExpiryDeadline != nil {
+		//get current list of subscription meant to expire at this time
+		intList := subscriptionExpiryMap[int(s1BearerSubscription.ExpiryDeadline.Seconds)]
+		intList = append(intList, subsId)
+		subscriptionExpiryMap[int(s1BearerSubscription.ExpiryDeadline.Seconds)] = intList
+	}
+	log.Info("New registration: ", subsId, " type: ", s1BearerSubscriptionType)
+}
+
+func deregisterCc(subsIdStr string, mutexTaken bool) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	if !mutexTaken {
+		mutex.Lock()
+		defer mutex.Unlock()
+	}
+	ccSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", cellChangeSubscriptionType)
+}
+
+func deregisterRe(subsIdStr string, mutexTaken bool) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	if !mutexTaken {
+		mutex.Lock()
+		defer mutex.Unlock()
+	}
+
+	reSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", rabEstSubscriptionType)
+}
+
+func deregisterRm(subsIdStr string, mutexTaken bool) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	if !mutexTaken {
+		mutex.Lock()
+		defer mutex.Unlock()
+	}
+
+	rmSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", rabModSubscriptionType)
+}
+
+func deregisterRr(subsIdStr string, mutexTaken bool) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	if !mutexTaken {
+		mutex.Lock()
+		defer mutex.Unlock()
+	}
+
+	rrSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", rabRelSubscriptionType)
+}
+
+func deregisterMr(subsIdStr string, mutexTaken bool) {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	if !mutexTaken {
 		mutex.Lock()
 		defer mutex.Unlock()
 	}
-	ccSubscriptionMap[subsId] = nil
-	log.Info("Deregistration: ", subsId, " type: ", cellChangeSubscriptionType)
+
+	mrSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", measRepUeSubscriptionType)
 }
 
-func deregisterRe(subsIdStr string, mutexTaken bool) {
+func deregisterNrMr(subsIdStr string, mutexTaken bool) {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	if !mutexTaken {
 		mutex.Lock()
 		defer mutex.Unlock()
 	}
 
-	reSubscriptionMap[subsId] = nil
-	log.Info("Deregistration: ", subsId, " type: ", rabEstSubscriptionType)
+	nrMrSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", nrMeasRepUeSubscriptionType)
 }
 
-func deregisterRr(subsIdStr string, mutexTaken bool) {
+func deregisterTa(subsIdStr string, mutexTaken bool) {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	if !mutexTaken {
 		mutex.Lock()
 		defer mutex.Unlock()
 	}
 
-	rrSubscriptionMap[subsId] = nil
-	log.Info("Deregistration: ", subsId, " type: ", rabRelSubscriptionType)
+	taSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", measTaSubscriptionType)
 }
 
-func deregisterMr(subsIdStr string, mutexTaken bool) {
+func deregisterCa(subsIdStr string, mutexTaken bool) {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	if !mutexTaken {
 		mutex.Lock()
 		defer mutex.Unlock()
 	}
 
-	mrSubscriptionMap[subsId] = nil
-	log.Info("Deregistration: ", subsId, " type: ", measRepUeSubscriptionType)
+	caSubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", caReconfSubscriptionType)
 }
 
-func deregisterNrMr(subsIdStr string, mutexTaken bool) {
+func deregisterS1(subsIdStr string, mutexTaken bool) {
 	subsId, _ := strconv.Atoi(subsIdStr)
 	if !mutexTaken {
 		mutex.Lock()
 		defer mutex.Unlock()
 	}
 
-	nrMrSubscriptionMap[subsId] = nil
-	log.Info("Deregistration: ", subsId, " type: ", nrMeasRepUeSubscriptionType)
+	s1SubscriptionMap[subsId] = nil
+	log.Info("Deregistration: ", subsId, " type: ", s1BearerSubscriptionType)
 }
 
 func delSubscription(keyPrefix string, subsId string, mutexTaken bool) error {
@@ -2955,9 +4482,13 @@ func delSubscription(keyPrefix string, subsId string, mutexTaken bool) error {
 	err := rc.JSONDelEntry(keyPrefix+":"+subsId, ".")
 	deregisterCc(subsId, mutexTaken)
 	deregisterRe(subsId, mutexTaken)
+	deregisterRm(subsId, mutexTaken)
 	deregisterRr(subsId, mutexTaken)
 	deregisterMr(subsId, mutexTaken)
 	deregisterNrMr(subsId, mutexTaken)
+	deregisterTa(subsId, mutexTaken)
+	deregisterCa(subsId, mutexTaken)
+	deregisterS1(subsId, mutexTaken)
 
 	return err
 }
@@ -3124,6 +4655,10 @@ func layer2MeasInfoGet(w http.ResponseWriter, r *http.Request) {
 
 	var l2Meas L2Meas
 	l2MeasData.l2Meas = &l2Meas
+	l2MeasData.cellStats = make(map[string]*CellStats)
+
+	//get traffic measured by the sidecars for each flow
+	l2MeasData.flowThroughput = getFlowThroughput()
 
 	//get from DB
 	//loop through each UE
@@ -3282,10 +4817,14 @@ func populateL2Meas(key string, jsonInfo string, l2MeasData interface{}) error {
 
 	latency := int32(0)
 	poaPacketLoss := int32(0)
+	poaThroughputDl := int32(0)
+	poaThroughputUl := int32(0)
 	if jsonPoaData != "" {
 		poaDataObj := convertJsonToPoaInfo(jsonPoaData)
 		if poaDataObj != nil {
 			latency = poaDataObj.Latency
+			poaThroughputDl = poaDataObj.ThroughputDL
+			poaThroughputUl = poaDataObj.ThroughputUL
 			ploss := poaDataObj.PacketLoss
 			//return between 10^-4 t 10^-6
 			ploss = ploss * 1000000 //10^-6
@@ -3297,30 +4836,58 @@ func populateL2Meas(key string, jsonInfo string, l2MeasData interface{}) error {
 		}
 	}
 
+	// Cell stats include the traffic of every UE app; UE stats only the queried app, or all if none provided
+	totalStats := AppStats{"", 0, 0, 0, 0}
 	ueStats := AppStats{data.queryAppInsId, 0, 0, 0, 0}
+	latencySum := int32(0)
+	latencyCount := int32(0)
 
-	//loop through each APP to get throuput
+	//loop through each APP to get throughput
 	for _, appName := range ueData.AppNames {
 
-		//we calculate stats for the queried app only or for all if none provided
-		if appName != data.queryAppInsId && data.queryAppInsId != "" {
-			continue
-		}
-
-		metricsArray, err := metricStore.GetCachedNetworkMetrics("*", appName)
+		// Network metrics measured between the UE app & its peers provide packet loss & latency
+		metricsMap := make(map[string]met.NetworkMetric)
+		metricsArray, err := metricStore.GetCachedNetworkMetrics(appName, "*")
 		if err != nil {
 			log.Error("Failed to get network metric:", err)
 		}
+		for _, metrics := range metricsArray {
+			metricsMap[metrics.Dst] = metrics
+		}
+
 		sumAppStats := AppStats{appName, 0, 0, 0, 0}
 
-		for _, metrics := range metricsArray {
+		// Flow throughput measured by the sidecars provides the traffic exchanged with each peer
+		for _, peer := range getFlowPeers(appName, data.flowThroughput) {
+			// Traffic between apps of the same UE does not go over the air interface
+			if isAppNamePresent(peer, ueData.AppNames) {
+				continue
+			}
 
-			appStats := calculateMetrics(metrics)
+			metrics := metricsMap[peer]
+			appStats := calculateMetrics(metrics, data.flowThroughput[appName][peer], data.flowThroughput[peer][appName])
 			sumAppStats.DlTraffic += appStats.DlTraffic
 			sumAppStats.DlTrafficLoss += appStats.DlTrafficLoss
 
 			sumAppStats.UlTraffic += appStats.UlTraffic
 			sumAppStats.UlTrafficLoss += appStats.UlTrafficLoss
+
+			//we report delay for the queried app only or for all if none provided
+			if metrics.Lat > 0 && (appName == data.queryAppInsId || data.queryAppInsId == "") {
+				latencySum += metrics.Lat
+				latencyCount++
+			}
+		}
+
+		totalStats.DlTraffic += sumAppStats.DlTraffic
+		totalStats.DlTrafficLoss += sumAppStats.DlTrafficLoss
+
+		totalStats.UlTraffic += sumAppStats.UlTraffic
+		totalStats.UlTrafficLoss += sumAppStats.UlTrafficLoss
+
+		//we calculate stats for the queried app only or for all if none provided
+		if appName != data.queryAppInsId && data.queryAppInsId != "" {
+			continue
 		}
 
 		ueStats.DlTraffic += sumAppStats.DlTraffic
@@ -3328,15 +4895,35 @@ func populateL2Meas(key string, jsonInfo string, l2MeasData interface{}) error {
 
 		ueStats.UlTraffic += sumAppStats.UlTraffic
 		ueStats.UlTrafficLoss += sumAppStats.UlTrafficLoss
+	}
 
+	//aggregate UE traffic per cell
+	cellStats := data.cellStats[ueData.Ecgi.CellId]
+	if cellStats == nil {
+		cellStats = new(CellStats)
+		data.cellStats[ueData.Ecgi.CellId] = cellStats
 	}
+	updateCellStats(cellStats, totalStats)
 
 	//update cellInfo counters
-	//need to do a qci mapping... since qci can only be 80 for now, using the one that correlates to that
-	data.l2Meas.CellInfo[cellIndex].NumberOfActiveUeDlNongbrCell++
-	data.l2Meas.CellInfo[cellIndex].NumberOfActiveUeUlNongbrCell++
-	data.l2Meas.CellInfo[cellIndex].DlNongbrPdrCell = poaPacketLoss
-	data.l2Meas.CellInfo[cellIndex].UlNongbrPdrCell = poaPacketLoss
+	//need to do a qci mapping... all traffic is reported as non-GBR since it cannot be attributed to a specific bearer
+	cellInfo := &data.l2Meas.CellInfo[cellIndex]
+	cellInfo.NumberOfActiveUeDlNongbrCell = cellStats.ActiveUeDl
+	cellInfo.NumberOfActiveUeUlNongbrCell = cellStats.ActiveUeUl
+	cellInfo.DlNongbrPrbUsageCell = getPrbUsage(cellStats.DlTraffic, poaThroughputDl)
+	cellInfo.UlNongbrPrbUsageCell = getPrbUsage(cellStats.UlTraffic, poaThroughputUl)
+	cellInfo.DlTotalPrbUsageCell = cellInfo.DlNongbrPrbUsageCell
+	cellInfo.UlTotalPrbUsageCell = cellInfo.UlNongbrPrbUsageCell
+
+	//packet discard rate is based on measured traffic if any, otherwise on the configured POA packet loss
+	cellInfo.DlNongbrPdrCell = poaPacketLoss
+	if cellStats.DlTraffic != 0 {
+		cellInfo.DlNongbrPdrCell = getPacketDiscardRate(cellStats.DlTraffic, cellStats.DlTrafficLoss)
+	}
+	cellInfo.UlNongbrPdrCell = poaPacketLoss
+	if cellStats.UlTraffic != 0 {
+		cellInfo.UlNongbrPdrCell = getPacketDiscardRate(cellStats.UlTraffic, cellStats.UlTrafficLoss)
+	}
 
 	//name of the element is used as the ipv4 address at the moment
 	partOfFilter = true
@@ -3385,76 +4972,149 @@ func populateL2Meas(key string, jsonInfo string, l2MeasData interface{}) error {
 	}
 
 	//update ueInfo delay
-	//delay is the latency between air interface (POA<->UE)
-	data.l2Meas.CellUEInfo[cellUeIndex].DlNongbrDelayUe = latency //latency from the air interface only (POA)
+	//delay is the average latency measured by the sidecars, or the latency of the air interface (POA<->UE) if not measured
+	if latencyCount != 0 {
+		latency = latencySum / latencyCount
+	}
+	data.l2Meas.CellUEInfo[cellUeIndex].DlNongbrDelayUe = latency
 	data.l2Meas.CellUEInfo[cellUeIndex].UlNongbrDelayUe = latency
 	data.l2Meas.CellUEInfo[cellUeIndex].DlNongbrDataVolumeUe = ueStats.DlTraffic / 1000 //kbits
 	data.l2Meas.CellUEInfo[cellUeIndex].UlNongbrDataVolumeUe = ueStats.UlTraffic / 1000 //kbits
 	data.l2Meas.CellUEInfo[cellUeIndex].DlNongbrThroughputUe = ueStats.DlTraffic / 1000 //kbits/s
 	data.l2Meas.CellUEInfo[cellUeIndex].UlNongbrThroughputUe = ueStats.UlTraffic / 1000 //kbits/s
+	data.l2Meas.CellUEInfo[cellUeIndex].DlNongbrPdrUe = getPacketDiscardRate(ueStats.DlTraffic, ueStats.DlTrafficLoss)
+	data.l2Meas.CellUEInfo[cellUeIndex].UlNongbrPdrUe = getPacketDiscardRate(ueStats.UlTraffic, ueStats.UlTrafficLoss)
 
-	plossFloat := float32(0.0)
-	ploss := int32(0)
-	if ueStats.DlTraffic != 0 {
-		plossFloat = float32((float32(ueStats.DlTrafficLoss) / float32(ueStats.DlTrafficLoss+ueStats.DlTraffic)))
-		ploss = int32(1000000 * plossFloat)
+	return nil
+}
 
-		if ploss > 100 {
-			ploss = 100
-		}
+// getFlowThroughput - Retrieve flow throughput in Mbps measured by the sidecars, indexed by receiver then sender
+func getFlowThroughput() map[string]map[string]float64 {
+	flowThroughput := make(map[string]map[string]float64)
+	keyName := metricsBaseKey + "*:throughput"
+	err := rc.ForEachEntry(keyName, populateFlowThroughput, flowThroughput)
+	if err != nil {
+		log.Error(err.Error())
 	}
-	data.l2Meas.CellUEInfo[cellUeIndex].DlNongbrPdrUe = ploss
+	return flowThroughput
+}
 
-	ploss = int32(0)
-	if ueStats.UlTraffic != 0 {
-		plossFloat = float32((float32(ueStats.UlTrafficLoss) / float32(ueStats.UlTrafficLoss+ueStats.UlTraffic)))
-		ploss = int32(1000000 * plossFloat)
+func populateFlowThroughput(key string, fields map[string]string, userData interface{}) error {
+	flowThroughput := userData.(map[string]map[string]float64)
 
-		if ploss > 100 {
-			ploss = 100
-		}
+	// Entry key ends with <receiver>:throughput; fields are the throughput received from each sender
+	subKeys := strings.Split(key, ":")
+	if len(subKeys) < 2 {
+		return nil
 	}
+	dst := subKeys[len(subKeys)-2]
 
-	data.l2Meas.CellUEInfo[cellUeIndex].UlNongbrPdrUe = ploss
-
+	for src, value := range fields {
+		// Ignore fields that are not throughput values (e.g. entry creation time)
+		tput, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+		if flowThroughput[dst] == nil {
+			flowThroughput[dst] = make(map[string]float64)
+		}
+		flowThroughput[dst][src] = tput
+	}
 	return nil
 }
 
-func calculateMetrics(metrics met.NetworkMetric) (appStats AppStats) {
+// getFlowPeers - Get sorted list of elements exchanging traffic with the provided element
+func getFlowPeers(name string, flowThroughput map[string]map[string]float64) []string {
+	var peers []string
+	for src := range flowThroughput[name] {
+		peers = append(peers, src)
+	}
+	for dst, srcMap := range flowThroughput {
+		if _, found := srcMap[name]; found && !isAppNamePresent(dst, peers) {
+			peers = append(peers, dst)
+		}
+	}
+	sort.Strings(peers)
+	return peers
+}
 
-	//downlink direction
-	tput := metrics.DlTput
-	appStats.DlTraffic += int32(1000000 * tput)
+func isAppNamePresent(name string, appNames []string) bool {
+	for _, appName := range appNames {
+		if appName == name {
+			return true
+		}
+	}
+	return false
+}
 
-	ploss := metrics.DlLoss
-	//traffic lost because of packet drop
-	//details
-	//a = float32(ploss/100)
-	//b = float32(1.0 - a)
-	//c = float32(1000000 * tput)
-	//d = float32(a*c/b)
-	//e = int32(d)
+// updateCellStats - Add UE traffic to the cell stats; UE is active in a direction if it has traffic in that direction
+func updateCellStats(cellStats *CellStats, ueStats AppStats) {
+	cellStats.DlTraffic += ueStats.DlTraffic
+	cellStats.DlTrafficLoss += ueStats.DlTrafficLoss
+	cellStats.UlTraffic += ueStats.UlTraffic
+	cellStats.UlTrafficLoss += ueStats.UlTrafficLoss
+	if ueStats.DlTraffic > 0 {
+		cellStats.ActiveUeDl++
+	}
+	if ueStats.UlTraffic > 0 {
+		cellStats.ActiveUeUl++
+	}
+}
 
-	appStats.DlTrafficLoss += int32(float32(float32(ploss/100) * float32(1000000*tput) / float32(1.0-float32(ploss/100))))
+// getPrbUsage - Get PRB usage in percentage from cell traffic in bits/s and cell capacity in Mbps
+func getPrbUsage(traffic int32, capacity int32) int32 {
+	if capacity <= 0 {
+		return 0
+	}
+	prbUsage := int32(100 * float64(traffic) / (1000000 * float64(capacity)))
+	if prbUsage > 100 {
+		prbUsage = 100
+	}
+	return prbUsage
+}
 
-	//uplink direction
-	tput = metrics.UlTput
-	appStats.UlTraffic += int32(1000000 * tput)
+// getPacketDiscardRate - Get packet discard rate from traffic & traffic lost in bits/s
+func getPacketDiscardRate(traffic int32, trafficLoss int32) int32 {
+	ploss := int32(0)
+	if traffic != 0 {
+		plossFloat := float32(trafficLoss) / float32(trafficLoss+traffic)
+		//return between 10^-4 t 10^-6
+		ploss = int32(1000000 * plossFloat)
+		if ploss > 100 {
+			ploss = 100
+		}
+	}
+	return ploss
+}
 
-	ploss = metrics.UlLoss
-	//traffic lost because of packet drop
-	//details
-	//a = float32(ploss/100)
-	//b = float32(1.0 - a)
-	//c = float32(1000000 * tput)
-	//d = float32(a*c/b)
-	//e = int32(d)
+// calculateMetrics - Get traffic in bits/s from measured flow throughput in Mbps and traffic lost from measured packet loss
+// Network metrics are reported from the UE app point of view: downlink is the traffic received by the UE app
+func calculateMetrics(metrics met.NetworkMetric, dlTput float64, ulTput float64) (appStats AppStats) {
+
+	//downlink direction
+	appStats.DlTraffic += int32(1000000 * dlTput)
+	appStats.DlTrafficLoss += getTrafficLoss(dlTput, metrics.DlLoss)
 
-	appStats.UlTrafficLoss += int32(float32(float32(ploss/100) * float32(1000000*tput) / float32(1.0-float32(ploss/100))))
+	//uplink direction
+	appStats.UlTraffic += int32(1000000 * ulTput)
+	appStats.UlTrafficLoss += getTrafficLoss(ulTput, metrics.UlLoss)
 
 	return appStats
 }
 
+// getTrafficLoss - Get traffic lost because of packet drop in bits/s from received throughput in Mbps and packet loss in percentage
+func getTrafficLoss(tput float64, ploss float64) int32 {
+	if tput <= 0 || ploss <= 0 || ploss >= 100 {
+		return 0
+	}
+	//details
+	//a = ploss/100
+	//b = 1.0 - a
+	//c = 1000000 * tput
+	//d = a*c/b
+	return int32((ploss / 100) * (1000000 * tput) / (1.0 - ploss/100))
+}
+
 func rabInfoGet(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
@@ -3656,6 +5316,182 @@ func populateRabInfo(key string, jsonInfo string, rabInfoData interface{}) error
 	return nil
 }
 
+func s1BearerInfoGet(w http.ResponseWriter, r *http.Request) {
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var s1BearerInfoData S1BearerInfoData
+
+	u, _ := url.Parse(r.URL.String())
+	log.Info("url: ", u.RequestURI())
+	q := u.Query()
+
+	validQueryParams := []string{"temp_ue_id", "ue_ipv4_address", "ue_ipv6_address", "nated_ip_address", "gtp_teid", "cell_id", "erab_id"}
+
+	//look for all query parameters to reject if any invalid ones
+	found := false
+	for queryParam := range q {
+		found = false
+		for _, validQueryParam := range validQueryParams {
+			if queryParam == validQueryParam {
+				found = true
+				break
+			}
+		}
+		if !found {
+			log.Error("Query param not valid: ", queryParam)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	for _, erabIdStr := range q["erab_id"] {
+		erabId, err := strconv.Atoi(erabIdStr)
+		if err != nil {
+			log.Error("Query param erab_id not valid: ", erabIdStr)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s1BearerInfoData.queryErabIds = append(s1BearerInfoData.queryErabIds, int32(erabId))
+	}
+	s1BearerInfoData.queryCellIds = q["cell_id"]
+	s1BearerInfoData.queryIpv4Addresses = q["ue_ipv4_address"]
+	s1BearerInfoData.queryGtpTeids = q["gtp_teid"]
+
+	seconds := time.Now().Unix()
+	var timeStamp TimeStamp
+	timeStamp.Seconds = int32(seconds)
+
+	var s1BearerInfo S1BearerInfo
+	s1BearerInfo.S1UeInfo = []S1BearerInfoS1UeInfo{}
+	s1BearerInfoData.s1BearerInfo = &s1BearerInfo
+
+	//get from DB
+	//loop through each UE
+	keyName := baseKey + "UE:*"
+	err := rc.ForEachJSONEntry(keyName, populateS1BearerInfo, &s1BearerInfoData)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s1BearerInfo.TimeStamp = &timeStamp
+
+	// Send response
+	jsonResponse, err := json.Marshal(s1BearerInfo)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func populateS1BearerInfo(key string, jsonInfo string, s1BearerInfoData interface{}) error {
+	// Get query params & userlist from user data
+	data := s1BearerInfoData.(*S1BearerInfoData)
+	if data == nil || data.s1BearerInfo == nil {
+		return errors.New("s1BearerInfo not found in s1BearerInfoData")
+	}
+
+	// Retrieve user info from DB
+	var ueData UeData
+	err := json.Unmarshal([]byte(jsonInfo), &ueData)
+	if err != nil {
+		return err
+	}
+
+	// Ignore entries with no S1 bearer
+	if len(ueData.S1Bearers) == 0 || ueData.Ecgi == nil {
+		return nil
+	}
+
+	partOfFilter := true
+	for _, cellId := range data.queryCellIds {
+		if cellId != "" {
+			partOfFilter = false
+			if cellId == ueData.Ecgi.CellId {
+				partOfFilter = true
+				break
+			}
+		}
+	}
+	if !partOfFilter {
+		return nil
+	}
+
+	//name of the element is used as the ipv4 address at the moment
+	partOfFilter = true
+	for _, address := range data.queryIpv4Addresses {
+		if address != "" {
+			partOfFilter = false
+			if address == ueData.Name {
+				partOfFilter = true
+				break
+			}
+		}
+	}
+	if !partOfFilter {
+		return nil
+	}
+
+	var ueInfo S1BearerInfoS1UeInfo
+
+	for _, s1Bearer := range ueData.S1Bearers {
+		// Filter using query params
+		if len(data.queryErabIds) > 0 {
+			partOfFilter = false
+			for _, erabId := range data.queryErabIds {
+				if erabId == s1Bearer.ErabId {
+					partOfFilter = true
+					break
+				}
+			}
+			if !partOfFilter {
+				continue
+			}
+		}
+
+		s1BearerInfoDetailed := getS1BearerInfoDetailed(s1Bearer.ErabId)
+		if len(data.queryGtpTeids) > 0 {
+			partOfFilter = false
+			for _, gtpTeid := range data.queryGtpTeids {
+				if gtpTeid == s1BearerInfoDetailed.EnbInfo.TunnelId || gtpTeid == s1BearerInfoDetailed.SGwInfo.TunnelId {
+					partOfFilter = true
+					break
+				}
+			}
+			if !partOfFilter {
+				continue
+			}
+		}
+		ueInfo.S1BearerInfoDetailed = append(ueInfo.S1BearerInfoDetailed, s1BearerInfoDetailed)
+	}
+	if len(ueInfo.S1BearerInfoDetailed) == 0 {
+		return nil
+	}
+
+	assocId := new(AssociateId)
+	assocId.Type_ = 1 //UE_IPV4_ADDRESS
+	subKeys := strings.Split(key, ":")
+	assocId.Value = subKeys[len(subKeys)-1]
+	ueInfo.AssociateId = append(ueInfo.AssociateId, *assocId)
+
+	newEcgi := new(Ecgi)
+	newPlmn := new(Plmn)
+	newPlmn.Mcc = ueData.Ecgi.Plmn.Mcc
+	newPlmn.Mnc = ueData.Ecgi.Plmn.Mnc
+	newEcgi.Plmn = newPlmn
+	newEcgi.CellId = ueData.Ecgi.CellId
+	ueInfo.Ecgi = append(ueInfo.Ecgi, *newEcgi)
+
+	data.s1BearerInfo.S1UeInfo = append(data.s1BearerInfo.S1UeInfo, ueInfo)
+
+	return nil
+}
+
 func createSubscriptionLinkList(subType string) *SubscriptionLinkList {
 
 	subscriptionLinkList := new(SubscriptionLinkList)
@@ -3696,6 +5532,18 @@ func createSubscriptionLinkList(subType string) *SubscriptionLinkList {
 		}
 	}
 
+	//loop through rab_mod map
+	if subType == "" || subType == "rab_mod" {
+		for _, rmSubscription := range rmSubscriptionMap {
+			if rmSubscription != nil {
+				var subscription SubscriptionLinkListLinksSubscription
+				subscription.Href = rmSubscription.Links.Self.Href
+				subscription.SubscriptionType = RAB_MOD_SUBSCRIPTION
+				subscriptionLinkList.Links.Subscription = append(subscriptionLinkList.Links.Subscription, subscription)
+			}
+		}
+	}
+
 	//loop through rab_rel map
 	if subType == "" || subType == "rab_rel" {
 		for _, rrSubscription := range rrSubscriptionMap {
@@ -3732,6 +5580,42 @@ func createSubscriptionLinkList(subType string) *SubscriptionLinkList {
 		}
 	}
 
+	//loop through timing_advance_ue map
+	if subType == "" || subType == "timing_advance_ue" {
+		for _, taSubscription := range taSubscriptionMap {
+			if taSubscription != nil {
+				var subscription SubscriptionLinkListLinksSubscription
+				subscription.Href = taSubscription.Links.Self.Href
+				subscription.SubscriptionType = MEAS_TA_SUBSCRIPTION
+				subscriptionLinkList.Links.Subscription = append(subscriptionLinkList.Links.Subscription, subscription)
+			}
+		}
+	}
+
+	//loop through ca_reconf map
+	if subType == "" || subType == "ca_reconf" {
+		for _, caSubscription := range caSubscriptionMap {
+			if caSubscription != nil {
+				var subscription SubscriptionLinkListLinksSubscription
+				subscription.Href = caSubscription.Links.Self.Href
+				subscription.SubscriptionType = CA_RECONF_SUBSCRIPTION
+				subscriptionLinkList.Links.Subscription = append(subscriptionLinkList.Links.Subscription, subscription)
+			}
+		}
+	}
+
+	//loop through s1_bearer map
+	if subType == "" || subType == "s1_bearer" {
+		for _, s1Subscription := range s1SubscriptionMap {
+			if s1Subscription != nil {
+				var subscription SubscriptionLinkListLinksSubscription
+				subscription.Href = s1Subscription.Links.Self.Href
+				subscription.SubscriptionType = S1_BEARER_SUBSCRIPTION
+				subscriptionLinkList.Links.Subscription = append(subscriptionLinkList.Links.Subscription, subscription)
+			}
+		}
+	}
+
 	//no other maps to go through
 
 	return subscriptionLinkList
@@ -3806,9 +5690,13 @@ func cleanUp() {
 
 	ccSubscriptionMap = map[int]*CellChangeSubscription{}
 	reSubscriptionMap = map[int]*RabEstSubscription{}
+	rmSubscriptionMap = map[int]*RabModSubscription{}
 	rrSubscriptionMap = map[int]*RabRelSubscription{}
 	mrSubscriptionMap = map[int]*MeasRepUeSubscription{}
 	nrMrSubscriptionMap = map[int]*NrMeasRepUeSubscription{}
+	taSubscriptionMap = map[int]*MeasTaSubscription{}
+	caSubscriptionMap = map[int]*CaReconfSubscription{}
+	s1SubscriptionMap = map[int]*S1BearerSubscription{}
 	subscriptionExpiryMap = map[int][]int{}
 
 	updateStoreName("")
@@ -44,6 +44,10 @@ func DeleteReplayFileList(w http.ResponseWriter, r *http.Request) {
 	ceDeleteReplayFileList(w, r)
 }
 
+func GetRecordingStatus(w http.ResponseWriter, r *http.Request) {
+	ceGetRecordingStatus(w, r)
+}
+
 func GetReplayFile(w http.ResponseWriter, r *http.Request) {
 	ceGetReplayFile(w, r)
 }
@@ -60,10 +64,38 @@ func LoopReplay(w http.ResponseWriter, r *http.Request) {
 	ceLoopReplay(w, r)
 }
 
+func PauseReplayFile(w http.ResponseWriter, r *http.Request) {
+	cePauseReplayFile(w, r)
+}
+
 func PlayReplayFile(w http.ResponseWriter, r *http.Request) {
 	cePlayReplayFile(w, r)
 }
 
+func ResumeReplayFile(w http.ResponseWriter, r *http.Request) {
+	ceResumeReplayFile(w, r)
+}
+
+func SeekReplayFile(w http.ResponseWriter, r *http.Request) {
+	ceSeekReplayFile(w, r)
+}
+
+func SetReplaySpeed(w http.ResponseWriter, r *http.Request) {
+	ceSetReplaySpeed(w, r)
+}
+
+func StartReplayRecording(w http.ResponseWriter, r *http.Request) {
+	ceStartReplayRecording(w, r)
+}
+
+func StepReplayFile(w http.ResponseWriter, r *http.Request) {
+	ceStepReplayFile(w, r)
+}
+
 func StopReplayFile(w http.ResponseWriter, r *http.Request) {
 	ceStopReplayFile(w, r)
 }
+
+func StopReplayRecording(w http.ResponseWriter, r *http.Request) {
+	ceStopReplayRecording(w, r)
+}
@@ -249,6 +249,13 @@ var routes = Routes{
 		DeleteReplayFileList,
 	},
 
+	Route{
+		"GetRecordingStatus",
+		strings.ToUpper("Get"),
+		"/sandbox-ctrl/v1/recordingstatus",
+		GetRecordingStatus,
+	},
+
 	Route{
 		"GetReplayFile",
 		strings.ToUpper("Get"),
@@ -277,6 +284,13 @@ var routes = Routes{
 		LoopReplay,
 	},
 
+	Route{
+		"PauseReplayFile",
+		strings.ToUpper("Post"),
+		"/sandbox-ctrl/v1/replay/{name}/pause",
+		PauseReplayFile,
+	},
+
 	Route{
 		"PlayReplayFile",
 		strings.ToUpper("Post"),
@@ -284,6 +298,41 @@ var routes = Routes{
 		PlayReplayFile,
 	},
 
+	Route{
+		"ResumeReplayFile",
+		strings.ToUpper("Post"),
+		"/sandbox-ctrl/v1/replay/{name}/resume",
+		ResumeReplayFile,
+	},
+
+	Route{
+		"SeekReplayFile",
+		strings.ToUpper("Post"),
+		"/sandbox-ctrl/v1/replay/{name}/seek",
+		SeekReplayFile,
+	},
+
+	Route{
+		"SetReplaySpeed",
+		strings.ToUpper("Post"),
+		"/sandbox-ctrl/v1/replay/{name}/speed",
+		SetReplaySpeed,
+	},
+
+	Route{
+		"StartReplayRecording",
+		strings.ToUpper("Post"),
+		"/sandbox-ctrl/v1/replay/{name}/record/start",
+		StartReplayRecording,
+	},
+
+	Route{
+		"StepReplayFile",
+		strings.ToUpper("Post"),
+		"/sandbox-ctrl/v1/replay/{name}/step",
+		StepReplayFile,
+	},
+
 	Route{
 		"StopReplayFile",
 		strings.ToUpper("Post"),
@@ -291,6 +340,13 @@ var routes = Routes{
 		StopReplayFile,
 	},
 
+	Route{
+		"StopReplayRecording",
+		strings.ToUpper("Post"),
+		"/sandbox-ctrl/v1/replay/{name}/record/stop",
+		StopReplayRecording,
+	},
+
 	Route{
 		"SendEvent",
 		strings.ToUpper("Post"),
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -59,6 +60,7 @@ type SandboxCtrl struct {
 	activeModel       *mod.Model
 	metricStore       *met.MetricStore
 	replayMgr         *replay.ReplayMgr
+	recorder          *replay.Recorder
 	pduSessionStore   *pss.PduSessionStore
 	sandboxStore      *ss.SandboxStore
 	gracefulRemoveMap map[string]chan bool
@@ -180,11 +182,12 @@ func Init() (err error) {
 	}
 
 	// Setup for replay manager
-	sbxCtrl.replayMgr, err = replay.NewReplayMgr("meep-sandbox-ctrl-replay")
+	sbxCtrl.replayMgr, err = replay.NewReplayMgr("meep-sandbox-ctrl-replay", sbxCtrl.sandboxName, redisDBAddr)
 	if err != nil {
 		log.Error("Failed to initialize replay manager. Error: ", err)
 		return err
 	}
+	sbxCtrl.recorder = replay.NewRecorder()
 
 	// Connect to PDU Session Store
 	sbxCtrl.pduSessionStore, err = pss.NewPduSessionStore(sbxCtrl.sandboxName, redisDBAddr)
@@ -816,6 +819,11 @@ func ceTerminateScenario(w http.ResponseWriter, r *http.Request) {
 		_ = sbxCtrl.replayMgr.ForceStop()
 	}
 
+	// Stop & store active replay recording
+	if replayFileName, recordedReplay, stopped := sbxCtrl.recorder.ForceStop(); stopped {
+		_ = storeReplay(recordedReplay, replayFileName)
+	}
+
 	// Renew APIs
 	_ = sbxCtrl.apiMgr.FlushMepApis()
 
@@ -906,6 +914,9 @@ func processEvent(eventType string, event *dataModel.Event) (int, error) {
 		return httpStatus, err
 	}
 
+	// Record successful event in replay file, if recording
+	sbxCtrl.recorder.Record(event)
+
 	// Log successful event in metric store
 	eventJSONStr, err := json.Marshal(event)
 	if err == nil {
@@ -1434,6 +1445,173 @@ func ceStopReplayFile(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 }
 
+func cePauseReplayFile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	replayFileName := vars["name"]
+
+	err := sbxCtrl.replayMgr.Pause(replayFileName)
+	sendReplayControlResponse(w, err)
+}
+
+func ceResumeReplayFile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	replayFileName := vars["name"]
+
+	err := sbxCtrl.replayMgr.Resume(replayFileName)
+	sendReplayControlResponse(w, err)
+}
+
+func ceSeekReplayFile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	replayFileName := vars["name"]
+
+	// Retrieve query parameters; exactly one of index or time must be provided
+	query := r.URL.Query()
+	indexStr := query.Get("index")
+	timeStr := query.Get("time")
+	if (indexStr == "") == (timeStr == "") {
+		err := errors.New("Either index or time query parameter must be provided")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var err error
+	if indexStr != "" {
+		var index int
+		index, err = strconv.Atoi(indexStr)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, "Invalid index: "+indexStr, http.StatusBadRequest)
+			return
+		}
+		err = sbxCtrl.replayMgr.SeekIndex(replayFileName, index)
+	} else {
+		var t int
+		t, err = strconv.Atoi(timeStr)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, "Invalid time: "+timeStr, http.StatusBadRequest)
+			return
+		}
+		err = sbxCtrl.replayMgr.SeekTime(replayFileName, t)
+	}
+	sendReplayControlResponse(w, err)
+}
+
+func ceSetReplaySpeed(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	replayFileName := vars["name"]
+
+	speedStr := r.URL.Query().Get("speed")
+	speed, err := strconv.ParseFloat(speedStr, 64)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, "Invalid speed: "+speedStr, http.StatusBadRequest)
+		return
+	}
+
+	err = sbxCtrl.replayMgr.SetSpeed(replayFileName, speed)
+	sendReplayControlResponse(w, err)
+}
+
+func ceStepReplayFile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	replayFileName := vars["name"]
+
+	err := sbxCtrl.replayMgr.Step(replayFileName)
+	sendReplayControlResponse(w, err)
+}
+
+func sendReplayControlResponse(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if err == nil {
+		w.WriteHeader(http.StatusOK)
+	} else if err == replay.ErrNotRunning {
+		http.Error(w, err.Error(), http.StatusNotFound)
+	} else {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func ceStartReplayRecording(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	replayFileName := vars["name"]
+	log.Debug("Replay name: ", replayFileName)
+
+	if sbxCtrl.activeModel == nil || !sbxCtrl.activeModel.Active {
+		http.Error(w, "No scenario is active", http.StatusNotFound)
+		return
+	}
+
+	// Retrieve replay description from request body, if any
+	var replayInfo dataModel.ReplayInfo
+	if r.Body != nil {
+		decoder := json.NewDecoder(r.Body)
+		err := decoder.Decode(&replayInfo)
+		if err != nil && err != io.EOF {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	err := sbxCtrl.recorder.Start(replayFileName, replayInfo.Description)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+func ceStopReplayRecording(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	replayFileName := vars["name"]
+	log.Debug("Replay name: ", replayFileName)
+
+	recordedReplay, err := sbxCtrl.recorder.Stop(replayFileName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Store recorded replay file
+	err = storeReplay(recordedReplay, replayFileName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	log.Debug("Replay file ", replayFileName, " recorded with ", len(recordedReplay.Events)-1, " events")
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+func ceGetRecordingStatus(w http.ResponseWriter, r *http.Request) {
+	// Get Recorder status
+	status, err := sbxCtrl.recorder.GetStatus()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(status)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
 func ceGetPduSessionList(w http.ResponseWriter, r *http.Request) {
 
 	// Retrieve query parameters
@@ -1460,8 +1638,7 @@ func ceGetPduSessionList(w http.ResponseWriter, r *http.Request) {
 					var pduSession dataModel.PduSession
 					pduSession.Ue = name
 					pduSession.Id = id
-					pduSession.Info = new(dataModel.PduSessionInfo)
-					pduSession.Info.Dnn = info.Dnn
+					pduSession.Info = info
 					pduSessionList.Sessions = append(pduSessionList.Sessions, pduSession)
 				}
 			}
@@ -1513,6 +1690,7 @@ func ceCreatePduSession(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), code)
 		return
 	}
+	recordPduSessionEvent(PduSessionAdd, ueName, pduSessionId, &pduSessionInfo)
 
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
@@ -1557,11 +1735,28 @@ func ceTerminatePduSession(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), code)
 		return
 	}
+	recordPduSessionEvent(PduSessionRemove, ueName, pduSessionId, nil)
 
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
 }
 
+// Record PDU session API request as a PDU session event
+func recordPduSessionEvent(action string, ueName string, pduSessionId string, pduSessionInfo *dataModel.PduSessionInfo) {
+	var event dataModel.Event
+	event.Name = "pdu-session"
+	event.Type_ = eventTypePduSession
+	event.EventPduSession = &dataModel.EventPduSession{
+		Action: action,
+		PduSession: &dataModel.PduSession{
+			Ue:   ueName,
+			Id:   pduSessionId,
+			Info: pduSessionInfo,
+		},
+	}
+	sbxCtrl.recorder.Record(&event)
+}
+
 // Delete PDU session
 func deletePduSession(ueName string, pduSessionId string) (code int, err error) {
 
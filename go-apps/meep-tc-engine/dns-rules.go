@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
+)
+
+const typeDns string = "dns"
+
+const fieldDnsAppId string = "dns-app-id"
+const fieldDnsRuleId string = "dns-rule-id"
+const fieldDnsDomainName string = "dns-domain-name"
+const fieldDnsIpAddressType string = "dns-ip-address-type"
+const fieldDnsIpAddress string = "dns-ip-address"
+const fieldDnsTtl string = "dns-ttl"
+
+const dnsStateActive = "ACTIVE"
+
+// DnsRule - MEC011 DNS rule, as stored by the App Enablement Service
+type DnsRule struct {
+	DnsRuleId     string `json:"dnsRuleId"`
+	DomainName    string `json:"domainName"`
+	IpAddressType string `json:"ipAddressType"`
+	IpAddress     string `json:"ipAddress"`
+	Ttl           int32  `json:"ttl,omitempty"`
+	State         string `json:"state"`
+}
+
+// DnsRuleInfo - DNS rule, owning App instance & App instance location
+type DnsRuleInfo struct {
+	AppId string
+	Rule  *DnsRule
+	Ctx   *mod.NodeContext
+}
+
+// refreshDnsRules - Fetch & apply latest App DNS rules
+func refreshDnsRules() {
+	log.Debug("refreshDnsRules")
+
+	// Retrieve DNS rules of all App instances from App Enablement store
+	var ruleInfoList []*DnsRuleInfo
+	keyName := dkm.GetKeyRoot(tce.sandboxName) + appEnablementKey + "*:app:*:dns:*"
+	err := tce.netCharStore.rc.ForEachJSONEntry(keyName, populateDnsRuleInfoList, &ruleInfoList)
+	if err != nil {
+		log.Error("Failed to retrieve DNS rules with err: ", err)
+		return
+	}
+
+	// Sort rules for consistent selection
+	sort.SliceStable(ruleInfoList, func(i, j int) bool {
+		if ruleInfoList[i].AppId != ruleInfoList[j].AppId {
+			return ruleInfoList[i].AppId < ruleInfoList[j].AppId
+		}
+		return ruleInfoList[i].Rule.DnsRuleId < ruleInfoList[j].Rule.DnsRuleId
+	})
+	applyDnsRules(ruleInfoList)
+
+	// Inform sidecars of DNS rules update
+	publishDnsRulesUpdate()
+}
+
+// publishDnsRulesUpdate - Inform sidecars of DNS rules update
+func publishDnsRulesUpdate() {
+	// Send TC DNS Rules update message to TC Sidecars for enforcement
+	msg := tce.mqLocal.CreateMsg(mq.MsgTcDnsRulesUpdate, moduleTcSidecar, tce.sandboxName)
+	log.Debug("TX MSG: ", mq.PrintMsg(msg))
+	err := tce.mqLocal.SendMsg(msg)
+	if err != nil {
+		log.Error("Failed to send message. Error: ", err.Error())
+	}
+}
+
+// applyDnsRules - Select & store pod-specific DNS records
+// When several App instances provide a rule for the same domain name, each pod resolves
+// the domain name using the rule of the closest App instance.
+func applyDnsRules(ruleInfoList []*DnsRuleInfo) {
+	keys := map[string]bool{}
+
+	// Retrieve App instance locations
+	var activeRuleInfoList []*DnsRuleInfo
+	for _, ruleInfo := range ruleInfoList {
+		if ruleInfo.Rule.State != dnsStateActive {
+			continue
+		}
+		if node := tce.activeModel.GetNodeById(ruleInfo.AppId); node != nil {
+			if proc, ok := node.(*dataModel.Process); ok {
+				ruleInfo.Ctx = tce.activeModel.GetNodeContext(proc.Name)
+			}
+		}
+		activeRuleInfoList = append(activeRuleInfoList, ruleInfo)
+	}
+
+	for _, podInfo := range podInfoMap {
+		podCtx := tce.activeModel.GetNodeContext(podInfo.Name)
+
+		// Select closest App instance rule for each domain name & IP address type
+		selectedRules := make(map[string]*DnsRuleInfo)
+		for _, ruleInfo := range activeRuleInfoList {
+			recordName := strings.ToLower(strings.TrimSuffix(ruleInfo.Rule.DomainName, ".")) + ":" + ruleInfo.Rule.IpAddressType
+			selectedRule, found := selectedRules[recordName]
+			if !found || getDnsRuleLocality(ruleInfo.Ctx, podCtx) > getDnsRuleLocality(selectedRule.Ctx, podCtx) {
+				selectedRules[recordName] = ruleInfo
+			}
+		}
+
+		for recordName, ruleInfo := range selectedRules {
+			// Populate record fields
+			fields := make(map[string]interface{})
+			fields[fieldDnsAppId] = ruleInfo.AppId
+			fields[fieldDnsRuleId] = ruleInfo.Rule.DnsRuleId
+			fields[fieldDnsDomainName] = ruleInfo.Rule.DomainName
+			fields[fieldDnsIpAddressType] = ruleInfo.Rule.IpAddressType
+			fields[fieldDnsIpAddress] = ruleInfo.Rule.IpAddress
+			fields[fieldDnsTtl] = ruleInfo.Rule.Ttl
+
+			// Make unique key
+			key := tce.netCharStore.baseKey + typeDns + ":" + podInfo.Name + ":" + recordName
+			keys[key] = true
+
+			// Set record information in DB
+			_ = tce.netCharStore.rc.SetEntry(key, fields)
+		}
+	}
+
+	// Remove stale DB entries
+	keyName := tce.netCharStore.baseKey + typeDns + ":*"
+	err := tce.netCharStore.rc.ForEachEntry(keyName, removeDnsEntryHandler, &keys)
+	if err != nil {
+		log.Error("Failed to remove old entries with err: ", err)
+		return
+	}
+}
+
+func removeDnsEntryHandler(key string, fields map[string]string, userData interface{}) error {
+	keys := userData.(*map[string]bool)
+
+	if _, found := (*keys)[key]; !found {
+		_ = tce.netCharStore.rc.DelEntry(key)
+	}
+	return nil
+}
+
+func populateDnsRuleInfoList(key string, jsonInfo string, userData interface{}) error {
+	ruleInfoList := userData.(*[]*DnsRuleInfo)
+
+	// Retrieve App instance ID from key
+	// Format: <root>app-enablement:<mep>:app:<appId>:dns:<ruleId>
+	keyParts := strings.Split(key[strings.LastIndex(key, ":app:")+len(":app:"):], ":")
+	if len(keyParts) < 1 || keyParts[0] == "" {
+		log.Error("Invalid DNS rule key: ", key)
+		return nil
+	}
+
+	var rule DnsRule
+	err := json.Unmarshal([]byte(jsonInfo), &rule)
+	if err != nil {
+		log.Error("Failed to parse DNS rule: ", key)
+		return nil
+	}
+	*ruleInfoList = append(*ruleInfoList, &DnsRuleInfo{AppId: keyParts[0], Rule: &rule})
+	return nil
+}
+
+// getDnsRuleLocality - Get App instance proximity to pod; higher value means closer
+func getDnsRuleLocality(appCtx *mod.NodeContext, podCtx *mod.NodeContext) int {
+	if appCtx == nil || podCtx == nil {
+		return 0
+	}
+	levels := []string{mod.PhyLoc, mod.NetLoc, mod.Zone, mod.Domain}
+	for i, level := range levels {
+		if appCtx.Parents[level] != "" && appCtx.Parents[level] == podCtx.Parents[level] {
+			return len(levels) - i
+		}
+	}
+	return 0
+}
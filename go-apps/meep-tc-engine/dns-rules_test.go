@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+)
+
+func TestGetDnsRuleLocality(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	podCtx := mod.NewNodeContext("dep", "domain1", "zone1", "poa1", "edge1")
+	tests := []struct {
+		name     string
+		appCtx   *mod.NodeContext
+		podCtx   *mod.NodeContext
+		expected int
+	}{
+		{"same physical location", mod.NewNodeContext("dep", "domain1", "zone1", "poa1", "edge1"), podCtx, 4},
+		{"same network location", mod.NewNodeContext("dep", "domain1", "zone1", "poa1", "edge2"), podCtx, 3},
+		{"same zone", mod.NewNodeContext("dep", "domain1", "zone1", "poa2", "edge2"), podCtx, 2},
+		{"same domain", mod.NewNodeContext("dep", "domain1", "zone2", "poa3", "edge3"), podCtx, 1},
+		{"other domain", mod.NewNodeContext("dep", "domain2", "zone3", "poa4", "edge4"), podCtx, 0},
+		{"unset location", mod.NewNodeContext("dep", "", "", "", ""), mod.NewNodeContext("dep", "", "", "", ""), 0},
+		{"unknown App location", nil, podCtx, 0},
+		{"unknown pod location", podCtx, nil, 0},
+	}
+	for _, test := range tests {
+		if locality := getDnsRuleLocality(test.appCtx, test.podCtx); locality != test.expected {
+			t.Fatalf("Invalid locality for %s: %d", test.name, locality)
+		}
+	}
+}
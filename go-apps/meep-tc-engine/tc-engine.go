@@ -74,6 +74,11 @@ type FilterInfo struct {
 	Distribution       string
 	PacketLoss         float64
 	DataRate           int
+	Duplication        float64
+	Corruption         float64
+	Reordering         float64
+	ReorderCorrelation float64
+	LossModel          ncm.LossModel
 }
 
 // PortInfo -
@@ -270,6 +275,16 @@ func msgHandler(msg *mq.Msg, userData interface{}) {
 	case mq.MsgMgLbRulesUpdate:
 		log.Debug("RX MSG: ", mq.PrintMsg(msg))
 		tce.routingEngine.RefreshLbRules()
+	case mq.MsgTrafficRulesUpdate:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		refreshTrafficRules()
+	case mq.MsgDnsRulesUpdate:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		refreshDnsRules()
+	case mq.MsgAppRemoveCnf:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		refreshTrafficRules()
+		refreshDnsRules()
 	default:
 		log.Trace("Ignoring unsupported message: ", mq.PrintMsg(msg))
 	}
@@ -299,6 +314,10 @@ func processScenarioActivate() {
 	// Refresh routing rules
 	tce.routingEngine.RefreshLbRules()
 
+	// Refresh App traffic & DNS rules
+	refreshTrafficRules()
+	refreshDnsRules()
+
 	// Start IP Manager periodic refresh
 	err = tce.ipManager.Start()
 	if err != nil {
@@ -341,6 +360,14 @@ func processScenarioUpdate(eventType string) {
 		// NOTE: This operation is long in sidecars and should be avoided unless necessary.
 		//       E.g. when ingress/egress rules or a group servicerules may have changed.
 		tce.routingEngine.RefreshLbRules()
+
+		// Refresh App traffic rules
+		refreshTrafficRules()
+	}
+
+	// Refresh App DNS rules if App instance or pod locations may have changed
+	if eventType == mod.EventMobility || eventType == mod.EventAddNode || eventType == mod.EventModifyNode || eventType == mod.EventRemoveNode {
+		refreshDnsRules()
 	}
 }
 
@@ -372,7 +399,7 @@ func stopScenario() {
 
 	tce.netCharStore.rc.DBFlush(tce.netCharStore.baseKey)
 
-	// Send message to clear TC LB & Net Rules
+	// Send message to clear TC LB, Net, Traffic & DNS Rules
 	msg := tce.mqLocal.CreateMsg(mq.MsgTcNetRulesUpdate, moduleTcSidecar, tce.sandboxName)
 	log.Debug("TX MSG: ", mq.PrintMsg(msg))
 	err := tce.mqLocal.SendMsg(msg)
@@ -385,6 +412,18 @@ func stopScenario() {
 	if err != nil {
 		log.Error("Failed to send message. Error: ", err.Error())
 	}
+	msg = tce.mqLocal.CreateMsg(mq.MsgTcTrafficRulesUpdate, moduleTcSidecar, tce.sandboxName)
+	log.Debug("TX MSG: ", mq.PrintMsg(msg))
+	err = tce.mqLocal.SendMsg(msg)
+	if err != nil {
+		log.Error("Failed to send message. Error: ", err.Error())
+	}
+	msg = tce.mqLocal.CreateMsg(mq.MsgTcDnsRulesUpdate, moduleTcSidecar, tce.sandboxName)
+	log.Debug("TX MSG: ", mq.PrintMsg(msg))
+	err = tce.mqLocal.SendMsg(msg)
+	if err != nil {
+		log.Error("Failed to send message. Error: ", err.Error())
+	}
 }
 
 // processScenario - Parse & process active scenario
@@ -524,6 +563,9 @@ func ipAddrUpdated() {
 
 	// Refresh routing rules
 	tce.routingEngine.RefreshLbRules()
+
+	// Refresh App traffic rules
+	refreshTrafficRules()
 }
 
 // Create & store new service & MG service information
@@ -589,7 +631,7 @@ func updateIpAddresses() {
 	}
 }
 
-func netCharUpdate(dstName string, srcName string, rate float64, latency float64, latencyVariation float64, distribution string, packetLoss float64) {
+func netCharUpdate(dstName string, srcName string, netChar ncm.NetChar) {
 	mutex.Lock()
 	defer mutex.Unlock()
 
@@ -606,11 +648,20 @@ func netCharUpdate(dstName string, srcName string, rate float64, latency float64
 	}
 
 	// Update filter info
-	filterInfo.Latency = int(latency)
-	filterInfo.LatencyVariation = int(latencyVariation)
-	filterInfo.PacketLoss = packetLoss
-	filterInfo.DataRate = int(THROUGHPUT_UNIT * rate)
-	filterInfo.Distribution = strings.ToLower(distribution)
+	filterInfo.Latency = int(netChar.Latency)
+	filterInfo.LatencyVariation = int(netChar.Jitter)
+	filterInfo.LatencyCorrelation = COMMON_CORRELATION
+	if netChar.LatencyCorrelation != 0 {
+		filterInfo.LatencyCorrelation = int(netChar.LatencyCorrelation)
+	}
+	filterInfo.PacketLoss = netChar.PacketLoss
+	filterInfo.DataRate = int(THROUGHPUT_UNIT * netChar.Throughput)
+	filterInfo.Distribution = strings.ToLower(netChar.Distribution)
+	filterInfo.Duplication = netChar.Duplication
+	filterInfo.Corruption = netChar.Corruption
+	filterInfo.Reordering = netChar.Reordering
+	filterInfo.ReorderCorrelation = netChar.ReorderCorrelation
+	filterInfo.LossModel = netChar.LossModel
 
 	// Apply shaping rule update
 	keyName, err := setShapingRule(filterInfo)
@@ -676,6 +727,11 @@ func refreshNcRules() {
 				filterInfo.Distribution = DEFAULT_DISTRIBUTION
 				filterInfo.PacketLoss = 0.0
 				filterInfo.DataRate = 0
+				filterInfo.Duplication = 0.0
+				filterInfo.Corruption = 0.0
+				filterInfo.Reordering = 0.0
+				filterInfo.ReorderCorrelation = 0.0
+				filterInfo.LossModel = ncm.LossModel{Model: ncm.LossModelRandom}
 				dstElem.FilterInfoMap[srcElem.Name] = filterInfo
 			}
 		}
@@ -750,6 +806,15 @@ func setShapingRule(filterInfo *FilterInfo) (keyName string, err error) {
 	m_shape["distribution"] = filterInfo.Distribution
 	m_shape["packetLoss"] = fmt.Sprintf("%f", filterInfo.PacketLoss)
 	m_shape["dataRate"] = strconv.FormatInt(int64(filterInfo.DataRate), 10)
+	m_shape["duplication"] = fmt.Sprintf("%f", filterInfo.Duplication)
+	m_shape["corruption"] = fmt.Sprintf("%f", filterInfo.Corruption)
+	m_shape["reordering"] = fmt.Sprintf("%f", filterInfo.Reordering)
+	m_shape["reorderCorrelation"] = fmt.Sprintf("%f", filterInfo.ReorderCorrelation)
+	m_shape["lossModel"] = filterInfo.LossModel.Model
+	m_shape["lossP"] = fmt.Sprintf("%f", filterInfo.LossModel.P)
+	m_shape["lossR"] = fmt.Sprintf("%f", filterInfo.LossModel.R)
+	m_shape["lossBad"] = fmt.Sprintf("%f", filterInfo.LossModel.LossBad)
+	m_shape["lossGood"] = fmt.Sprintf("%f", filterInfo.LossModel.LossGood)
 	m_shape["ifb_uniqueId"] = uniqueId
 
 	keyName = tce.netCharStore.baseKey + typeNet + ":" + filterInfo.PodName + ":shape:" + uniqueId
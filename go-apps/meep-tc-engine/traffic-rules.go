@@ -0,0 +1,381 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
+)
+
+const appEnablementKey string = "app-enablement:"
+const typeTr string = "tr"
+
+const fieldTrOrder string = "tr-order"
+const fieldTrAppId string = "tr-app-id"
+const fieldTrRuleId string = "tr-rule-id"
+const fieldTrAction string = "tr-action"
+const fieldTrSrcIp string = "tr-src-ip"
+const fieldTrDstIp string = "tr-dst-ip"
+const fieldTrProtocol string = "tr-protocol"
+const fieldTrSrcPort string = "tr-src-port"
+const fieldTrDstPort string = "tr-dst-port"
+const fieldTrDscp string = "tr-dscp"
+const fieldTrTargetIp string = "tr-target-ip"
+
+// Traffic rule actions
+const (
+	trActionDrop                  = "DROP"
+	trActionForwardDecapsulated   = "FORWARD_DECAPSULATED"
+	trActionForwardEncapsulated   = "FORWARD_ENCAPSULATED"
+	trActionPassthrough           = "PASSTHROUGH"
+	trActionDuplicateDecapsulated = "DUPLICATE_DECAPSULATED"
+	trActionDuplicateEncapsulated = "DUPLICATE_ENCAPSULATED"
+)
+
+const trStateActive = "ACTIVE"
+const trFilterTypeFlow = "FLOW"
+
+// TrafficRule - MEC011 traffic rule, as stored by the App Enablement Service
+type TrafficRule struct {
+	TrafficRuleId string                 `json:"trafficRuleId"`
+	FilterType    string                 `json:"filterType"`
+	Priority      int32                  `json:"priority"`
+	TrafficFilter []TrafficFilter        `json:"trafficFilter"`
+	Action        string                 `json:"action"`
+	DstInterface  []DestinationInterface `json:"dstInterface,omitempty"`
+	State         string                 `json:"state"`
+}
+
+// TrafficFilter - MEC011 traffic filter
+type TrafficFilter struct {
+	SrcAddress []string `json:"srcAddress,omitempty"`
+	DstAddress []string `json:"dstAddress,omitempty"`
+	SrcPort    []string `json:"srcPort,omitempty"`
+	DstPort    []string `json:"dstPort,omitempty"`
+	Protocol   []string `json:"protocol,omitempty"`
+	DSCP       int32    `json:"dSCP,omitempty"`
+}
+
+// DestinationInterface - MEC011 destination interface
+type DestinationInterface struct {
+	InterfaceType string `json:"interfaceType"`
+	DstIpAddress  string `json:"dstIpAddress,omitempty"`
+}
+
+// TrafficRuleInfo - Traffic rule & owning App instance
+type TrafficRuleInfo struct {
+	AppId string
+	Rule  *TrafficRule
+}
+
+// TrafficRuleEntry - Single data plane entry generated from a traffic rule filter
+type TrafficRuleEntry struct {
+	SrcIp    string
+	DstIp    string
+	Protocol string
+	SrcPort  string
+	DstPort  string
+	Dscp     string
+}
+
+// refreshTrafficRules - Fetch, translate & apply latest App traffic rules
+func refreshTrafficRules() {
+	log.Debug("refreshTrafficRules")
+
+	// Retrieve traffic rules of all App instances from App Enablement store
+	var ruleInfoList []*TrafficRuleInfo
+	keyName := dkm.GetKeyRoot(tce.sandboxName) + appEnablementKey + "*:app:*:traffic:*"
+	err := tce.netCharStore.rc.ForEachJSONEntry(keyName, populateTrafficRuleInfoList, &ruleInfoList)
+	if err != nil {
+		log.Error("Failed to retrieve traffic rules with err: ", err)
+		return
+	}
+
+	// Apply traffic rules in priority order
+	sort.SliceStable(ruleInfoList, func(i, j int) bool {
+		if ruleInfoList[i].Rule.Priority != ruleInfoList[j].Rule.Priority {
+			return ruleInfoList[i].Rule.Priority < ruleInfoList[j].Rule.Priority
+		}
+		if ruleInfoList[i].AppId != ruleInfoList[j].AppId {
+			return ruleInfoList[i].AppId < ruleInfoList[j].AppId
+		}
+		return ruleInfoList[i].Rule.TrafficRuleId < ruleInfoList[j].Rule.TrafficRuleId
+	})
+	applyTrafficRules(ruleInfoList)
+
+	// Inform sidecars of traffic rules update
+	publishTrafficRulesUpdate()
+}
+
+// publishTrafficRulesUpdate - Inform sidecars of traffic rules update
+func publishTrafficRulesUpdate() {
+	// Send TC Traffic Rules update message to TC Sidecars for enforcement
+	msg := tce.mqLocal.CreateMsg(mq.MsgTcTrafficRulesUpdate, moduleTcSidecar, tce.sandboxName)
+	log.Debug("TX MSG: ", mq.PrintMsg(msg))
+	err := tce.mqLocal.SendMsg(msg)
+	if err != nil {
+		log.Error("Failed to send message. Error: ", err.Error())
+	}
+}
+
+// applyTrafficRules - Generate & store pod-specific traffic rule entries
+func applyTrafficRules(ruleInfoList []*TrafficRuleInfo) {
+	keys := map[string]bool{}
+	order := 0
+
+	for _, ruleInfo := range ruleInfoList {
+		rule := ruleInfo.Rule
+
+		// Ignore inactive rules
+		if rule.State != trStateActive {
+			continue
+		}
+
+		// Resolve target IP addresses
+		var targetIps []string
+		for _, dstInterface := range rule.DstInterface {
+			targetIp := resolveTrafficRuleAddress(dstInterface.DstIpAddress)
+			if targetIp == "" || strings.ContainsAny(targetIp, "/-") {
+				log.Warn("Failed to resolve destination interface IP address: ", dstInterface.DstIpAddress)
+				continue
+			}
+			targetIps = append(targetIps, targetIp)
+		}
+		if rule.Action != trActionDrop && rule.Action != trActionPassthrough && len(targetIps) == 0 {
+			log.Warn("Ignoring traffic rule with no resolved destination interface: ", rule.TrafficRuleId)
+			continue
+		}
+
+		// Generate data plane entries from traffic filters
+		entries := getTrafficRuleEntries(rule)
+
+		// Rules are not enforced on the pod of the App instance that owns them
+		ownerPodName := ""
+		if node := tce.activeModel.GetNodeById(ruleInfo.AppId); node != nil {
+			if proc, ok := node.(*dataModel.Process); ok {
+				ownerPodName = proc.Name
+			}
+		}
+
+		for index, entry := range entries {
+			order++
+
+			// Populate entry fields
+			fields := make(map[string]interface{})
+			fields[fieldTrOrder] = order
+			fields[fieldTrAppId] = ruleInfo.AppId
+			fields[fieldTrRuleId] = rule.TrafficRuleId
+			fields[fieldTrAction] = rule.Action
+			fields[fieldTrSrcIp] = entry.SrcIp
+			fields[fieldTrDstIp] = entry.DstIp
+			fields[fieldTrProtocol] = entry.Protocol
+			fields[fieldTrSrcPort] = entry.SrcPort
+			fields[fieldTrDstPort] = entry.DstPort
+			fields[fieldTrDscp] = entry.Dscp
+			fields[fieldTrTargetIp] = strings.Join(targetIps, ",")
+
+			for _, podInfo := range podInfoMap {
+				if podInfo.Name == ownerPodName {
+					continue
+				}
+
+				// Make unique key
+				key := tce.netCharStore.baseKey + typeTr + ":" + podInfo.Name + ":" +
+					ruleInfo.AppId + ":" + rule.TrafficRuleId + ":" + strconv.Itoa(index)
+				keys[key] = true
+
+				// Set rule information in DB
+				_ = tce.netCharStore.rc.SetEntry(key, fields)
+			}
+		}
+	}
+
+	// Remove stale DB entries
+	keyName := tce.netCharStore.baseKey + typeTr + ":*"
+	err := tce.netCharStore.rc.ForEachEntry(keyName, removeTrEntryHandler, &keys)
+	if err != nil {
+		log.Error("Failed to remove old entries with err: ", err)
+		return
+	}
+}
+
+func removeTrEntryHandler(key string, fields map[string]string, userData interface{}) error {
+	keys := userData.(*map[string]bool)
+
+	if _, found := (*keys)[key]; !found {
+		_ = tce.netCharStore.rc.DelEntry(key)
+	}
+	return nil
+}
+
+func populateTrafficRuleInfoList(key string, jsonInfo string, userData interface{}) error {
+	ruleInfoList := userData.(*[]*TrafficRuleInfo)
+
+	// Retrieve App instance ID from key
+	// Format: <root>app-enablement:<mep>:app:<appId>:traffic:<ruleId>
+	keyParts := strings.Split(key[strings.LastIndex(key, ":app:")+len(":app:"):], ":")
+	if len(keyParts) < 1 || keyParts[0] == "" {
+		log.Error("Invalid traffic rule key: ", key)
+		return nil
+	}
+
+	var rule TrafficRule
+	err := json.Unmarshal([]byte(jsonInfo), &rule)
+	if err != nil {
+		log.Error("Failed to parse traffic rule: ", key)
+		return nil
+	}
+	*ruleInfoList = append(*ruleInfoList, &TrafficRuleInfo{AppId: keyParts[0], Rule: &rule})
+	return nil
+}
+
+// getTrafficRuleEntries - Expand traffic rule filters into single-valued data plane entries
+func getTrafficRuleEntries(rule *TrafficRule) []*TrafficRuleEntry {
+	var entries []*TrafficRuleEntry
+
+	for _, filter := range rule.TrafficFilter {
+		// Resolve addresses; filters with unresolved addresses are ignored
+		srcIps, ok := resolveTrafficRuleAddresses(filter.SrcAddress)
+		if !ok {
+			continue
+		}
+		dstIps, ok := resolveTrafficRuleAddresses(filter.DstAddress)
+		if !ok {
+			continue
+		}
+
+		// Port matching requires a transport protocol
+		protocols := []string{""}
+		if len(filter.Protocol) != 0 {
+			protocols = nil
+			for _, protocol := range filter.Protocol {
+				protocols = append(protocols, strings.ToLower(protocol))
+			}
+		} else if len(filter.SrcPort) != 0 || len(filter.DstPort) != 0 {
+			protocols = []string{"tcp", "udp"}
+		}
+		srcPorts := getTrafficRulePorts(filter.SrcPort)
+		dstPorts := getTrafficRulePorts(filter.DstPort)
+		dscp := ""
+		if filter.DSCP != 0 {
+			dscp = strconv.Itoa(int(filter.DSCP))
+		}
+
+		for _, srcIp := range srcIps {
+			for _, dstIp := range dstIps {
+				for _, protocol := range protocols {
+					for _, srcPort := range srcPorts {
+						for _, dstPort := range dstPorts {
+							entry := &TrafficRuleEntry{
+								SrcIp:    srcIp,
+								DstIp:    dstIp,
+								Protocol: protocol,
+								SrcPort:  srcPort,
+								DstPort:  dstPort,
+								Dscp:     dscp,
+							}
+							entries = append(entries, entry)
+
+							// FLOW filters also match the reverse direction of the flow. DNAT rules
+							// already handle reverse packets through connection tracking.
+							if rule.FilterType == trFilterTypeFlow && rule.Action != trActionForwardDecapsulated {
+								reverseEntry := &TrafficRuleEntry{
+									SrcIp:    dstIp,
+									DstIp:    srcIp,
+									Protocol: protocol,
+									SrcPort:  dstPort,
+									DstPort:  srcPort,
+									Dscp:     dscp,
+								}
+								entries = append(entries, reverseEntry)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return entries
+}
+
+// getTrafficRulePorts - Convert MEC011 port ranges to iptables format
+func getTrafficRulePorts(ports []string) []string {
+	if len(ports) == 0 {
+		return []string{""}
+	}
+	var trPorts []string
+	for _, port := range ports {
+		trPorts = append(trPorts, strings.Replace(strings.TrimSpace(port), "-", ":", 1))
+	}
+	return trPorts
+}
+
+// resolveTrafficRuleAddresses - Resolve a list of traffic filter addresses
+// An empty list matches any address
+func resolveTrafficRuleAddresses(addresses []string) ([]string, bool) {
+	if len(addresses) == 0 {
+		return []string{""}, true
+	}
+	var ips []string
+	for _, address := range addresses {
+		ip := resolveTrafficRuleAddress(address)
+		if ip == "" {
+			log.Warn("Failed to resolve traffic filter address: ", address)
+			continue
+		}
+		ips = append(ips, ip)
+	}
+	return ips, len(ips) != 0
+}
+
+// resolveTrafficRuleAddress - Resolve an IPv4 address, prefix, range or scenario element name
+func resolveTrafficRuleAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if isIpv4Address(address) {
+		return address
+	}
+	if podIp := tce.ipManager.GetPodIp(address); podIp != IP_ADDR_NONE {
+		return podIp
+	}
+	if svcIp := tce.ipManager.GetSvcIp(address); svcIp != IP_ADDR_NONE {
+		return svcIp
+	}
+	return ""
+}
+
+// isIpv4Address - Check if address is an IPv4 address, prefix or range
+func isIpv4Address(address string) bool {
+	if strings.Contains(address, "/") {
+		ip, _, err := net.ParseCIDR(address)
+		return err == nil && ip.To4() != nil
+	}
+	for _, addr := range strings.SplitN(address, "-", 2) {
+		ip := net.ParseIP(addr)
+		if ip == nil || ip.To4() == nil {
+			return false
+		}
+	}
+	return true
+}
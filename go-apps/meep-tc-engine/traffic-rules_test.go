@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestIsIpv4Address(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	addresses := map[string]bool{
+		"10.0.0.1":              true,
+		"10.0.0.0/24":           true,
+		"10.0.0.1-10.0.0.9":     true,
+		"":                      false,
+		"10.0.0":                false,
+		"10.0.0.256":            false,
+		"10.0.0.0/33":           false,
+		"10.0.0.1-":             false,
+		"10.0.0.1-zone1-edge1":  false,
+		"2001:db8::1":           false,
+		"2001:db8::/32":         false,
+		"zone1-edge1-iperf":     false,
+		"10.0.0.1-10.0.0.2-3.4": false,
+	}
+	for address, expected := range addresses {
+		if isIpv4Address(address) != expected {
+			t.Fatalf("Invalid IPv4 address check for %s: expected %t", address, expected)
+		}
+	}
+}
+
+func TestGetTrafficRulePorts(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []struct {
+		ports    []string
+		expected []string
+	}{
+		{nil, []string{""}},
+		{[]string{}, []string{""}},
+		{[]string{"80"}, []string{"80"}},
+		{[]string{" 8080 "}, []string{"8080"}},
+		{[]string{"1000-2000"}, []string{"1000:2000"}},
+		{[]string{"80", "443", "5000-5010"}, []string{"80", "443", "5000:5010"}},
+	}
+	for _, test := range tests {
+		ports := getTrafficRulePorts(test.ports)
+		if !reflect.DeepEqual(ports, test.expected) {
+			t.Fatalf("Invalid ports for %v: %v", test.ports, ports)
+		}
+	}
+}
+
+func TestGetTrafficRuleEntries(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []struct {
+		name     string
+		rule     *TrafficRule
+		expected []*TrafficRuleEntry
+	}{
+		{
+			name: "packet filter",
+			rule: &TrafficRule{
+				FilterType: "PACKET",
+				Action:     trActionDrop,
+				TrafficFilter: []TrafficFilter{
+					{SrcAddress: []string{"10.0.0.1"}, DstAddress: []string{"10.0.0.2"}, Protocol: []string{"UDP"}, DstPort: []string{"53"}},
+				},
+			},
+			expected: []*TrafficRuleEntry{
+				{SrcIp: "10.0.0.1", DstIp: "10.0.0.2", Protocol: "udp", DstPort: "53"},
+			},
+		},
+		{
+			name: "flow filter reverse entries",
+			rule: &TrafficRule{
+				FilterType: trFilterTypeFlow,
+				Action:     trActionPassthrough,
+				TrafficFilter: []TrafficFilter{
+					{SrcAddress: []string{"10.0.0.1"}, DstAddress: []string{"10.0.0.2"}, Protocol: []string{"tcp"}, SrcPort: []string{"1000-2000"}, DstPort: []string{"80"}, DSCP: 46},
+				},
+			},
+			expected: []*TrafficRuleEntry{
+				{SrcIp: "10.0.0.1", DstIp: "10.0.0.2", Protocol: "tcp", SrcPort: "1000:2000", DstPort: "80", Dscp: "46"},
+				{SrcIp: "10.0.0.2", DstIp: "10.0.0.1", Protocol: "tcp", SrcPort: "80", DstPort: "1000:2000", Dscp: "46"},
+			},
+		},
+		{
+			name: "flow filter forward decapsulated",
+			rule: &TrafficRule{
+				FilterType: trFilterTypeFlow,
+				Action:     trActionForwardDecapsulated,
+				TrafficFilter: []TrafficFilter{
+					{DstAddress: []string{"10.0.0.2"}},
+				},
+			},
+			expected: []*TrafficRuleEntry{
+				{DstIp: "10.0.0.2"},
+			},
+		},
+		{
+			name: "flow filter duplicate",
+			rule: &TrafficRule{
+				FilterType: trFilterTypeFlow,
+				Action:     trActionDuplicateDecapsulated,
+				TrafficFilter: []TrafficFilter{
+					{DstAddress: []string{"10.0.0.2"}},
+				},
+			},
+			expected: []*TrafficRuleEntry{
+				{DstIp: "10.0.0.2"},
+				{SrcIp: "10.0.0.2"},
+			},
+		},
+		{
+			name: "port without protocol",
+			rule: &TrafficRule{
+				FilterType: "PACKET",
+				Action:     trActionDrop,
+				TrafficFilter: []TrafficFilter{
+					{DstPort: []string{"80", "8080"}},
+				},
+			},
+			expected: []*TrafficRuleEntry{
+				{Protocol: "tcp", DstPort: "80"},
+				{Protocol: "tcp", DstPort: "8080"},
+				{Protocol: "udp", DstPort: "80"},
+				{Protocol: "udp", DstPort: "8080"},
+			},
+		},
+		{
+			name: "address & protocol expansion",
+			rule: &TrafficRule{
+				FilterType: "PACKET",
+				Action:     trActionDrop,
+				TrafficFilter: []TrafficFilter{
+					{SrcAddress: []string{"10.0.0.0/24", "10.0.1.1-10.0.1.9"}, Protocol: []string{"TCP", "SCTP"}},
+					{DstAddress: []string{"10.0.2.1"}},
+				},
+			},
+			expected: []*TrafficRuleEntry{
+				{SrcIp: "10.0.0.0/24", Protocol: "tcp"},
+				{SrcIp: "10.0.0.0/24", Protocol: "sctp"},
+				{SrcIp: "10.0.1.1-10.0.1.9", Protocol: "tcp"},
+				{SrcIp: "10.0.1.1-10.0.1.9", Protocol: "sctp"},
+				{DstIp: "10.0.2.1"},
+			},
+		},
+		{
+			name: "no filter",
+			rule: &TrafficRule{
+				FilterType: "PACKET",
+				Action:     trActionDrop,
+			},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		entries := getTrafficRuleEntries(test.rule)
+		if !reflect.DeepEqual(entries, test.expected) {
+			t.Errorf("Invalid entries for %s:", test.name)
+			for _, entry := range entries {
+				t.Errorf("  %+v", *entry)
+			}
+			t.FailNow()
+		}
+	}
+}
@@ -0,0 +1,403 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const dnsPort = 53
+const dnsResponderAddr = "127.0.0.1"
+const dnsResponderPort = 15353
+const dnsResolvConf = "/etc/resolv.conf"
+const dnsMaxMsgLen = 4096
+const dnsForwardTimeout = 5 * time.Second
+const dnsTcpIdleTimeout = 10 * time.Second
+
+// Packets sent by the DNS responder are marked to bypass the DNS redirect rule
+const dnsForwardMark = 0x200000
+
+const dnsIpAddressTypeV4 = "IP_V4"
+const dnsIpAddressTypeV6 = "IP_V6"
+
+// DnsRecord - Pod-specific DNS record provided by an App instance DNS rule
+type DnsRecord struct {
+	ip  net.IP
+	ttl uint32
+}
+
+var dnsMutex sync.RWMutex
+var dnsRecords = map[string]map[dnsmessage.Type]*DnsRecord{}
+var dnsRedirectApplied = false
+var dnsUpstream = ""
+
+// refreshDnsRules - Refresh DNS records & DNS redirect rule to match DB state
+func refreshDnsRules() {
+	// Get pod-specific DNS record entries stored in DB
+	records := make(map[string]map[dnsmessage.Type]*DnsRecord)
+	keyName := baseKey + typeDns + ":" + PodName + ":*"
+	err := rc.ForEachEntry(keyName, refreshDnsRulesHandler, &records)
+	if err != nil {
+		log.Error("Failed to search and process pod-specific MEEP DNS rules. Error: ", err)
+		return
+	}
+
+	dnsMutex.Lock()
+	dnsRecords = records
+	dnsMutex.Unlock()
+
+	// Redirect pod DNS queries to DNS responder only when DNS records are present
+	redirect := len(records) > 0
+	if dnsRedirectApplied == redirect {
+		return
+	}
+
+	// Create or flush DNS chain
+	err = ipTbl.ClearChain("nat", dnsChain)
+	if err != nil {
+		log.Error("Failed to clear chain ", dnsChain, ". Error: ", err)
+		return
+	}
+	exists, err := ipTbl.Exists("nat", "OUTPUT", "-j", dnsChain)
+	if err != nil {
+		log.Error("Failed to check if rule exists. Error: ", err)
+		return
+	}
+	if !exists {
+		err = ipTbl.Insert("nat", "OUTPUT", 1, "-j", dnsChain)
+		if err != nil {
+			log.Error("Failed to set rule [-I OUTPUT 1 -j "+dnsChain+"]. Error: ", err)
+			return
+		}
+	}
+
+	// Apply DNS redirect rules for queries over UDP & TCP
+	if redirect {
+		for _, protocol := range []string{"udp", "tcp"} {
+			rule := []string{"-p", protocol, "--dport", strconv.Itoa(dnsPort),
+				"-m", "mark", "!", "--mark", strconv.Itoa(dnsForwardMark) + "/" + strconv.Itoa(dnsForwardMark),
+				"-j", "REDIRECT", "--to-ports", strconv.Itoa(dnsResponderPort)}
+			err = ipTbl.Append("nat", dnsChain, rule...)
+			if err != nil {
+				log.Error("Failed to set rule [-A ", dnsChain, " ", rule, "]. Error: ", err)
+				return
+			}
+		}
+	}
+	dnsRedirectApplied = redirect
+}
+
+func refreshDnsRulesHandler(key string, fields map[string]string, userData interface{}) error {
+	records := userData.(*map[string]map[dnsmessage.Type]*DnsRecord)
+
+	// Validate record address
+	ip := net.ParseIP(fields[fieldDnsIpAddress])
+	if ip == nil {
+		log.Error("Invalid DNS record IP address: ", fields[fieldDnsIpAddress])
+		return nil
+	}
+	var recordType dnsmessage.Type
+	switch fields[fieldDnsIpAddressType] {
+	case dnsIpAddressTypeV4:
+		recordType = dnsmessage.TypeA
+	case dnsIpAddressTypeV6:
+		recordType = dnsmessage.TypeAAAA
+	default:
+		log.Error("Invalid DNS record IP address type: ", fields[fieldDnsIpAddressType])
+		return nil
+	}
+	ttl, _ := strconv.ParseUint(fields[fieldDnsTtl], 10, 32)
+
+	// Store record using fully qualified domain name
+	name := getDnsRecordName(fields[fieldDnsDomainName])
+	if _, found := (*records)[name]; !found {
+		(*records)[name] = make(map[dnsmessage.Type]*DnsRecord)
+	}
+	(*records)[name][recordType] = &DnsRecord{ip: ip, ttl: uint32(ttl)}
+	return nil
+}
+
+// getDnsRecordName - Get lowercase fully qualified domain name
+func getDnsRecordName(domainName string) string {
+	name := strings.ToLower(domainName)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+// runDnsResponder - Answer redirected pod DNS queries using App instance DNS rules
+// Queries that do not match a DNS rule are forwarded to the pod nameserver.
+func runDnsResponder() {
+	dnsUpstream = getDnsUpstream()
+	log.Info("DNS upstream nameserver: ", dnsUpstream)
+
+	go runDnsTcpResponder()
+
+	conn, err := net.ListenPacket("udp4", net.JoinHostPort(dnsResponderAddr, strconv.Itoa(dnsResponderPort)))
+	if err != nil {
+		log.Error("Failed to start DNS responder. Error: ", err)
+		return
+	}
+	defer conn.Close()
+
+	buf := make([]byte, dnsMaxMsgLen)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			log.Error("Failed to read DNS query. Error: ", err)
+			continue
+		}
+		query := make([]byte, n)
+		copy(query, buf[:n])
+		go handleDnsQuery(conn, addr, query)
+	}
+}
+
+func handleDnsQuery(conn net.PacketConn, addr net.Addr, query []byte) {
+	// Answer query using DNS records, if any
+	response := getDnsResponse(query)
+	if response == nil {
+		// Forward query to upstream nameserver
+		var err error
+		response, err = forwardDnsQuery("udp", query)
+		if err != nil {
+			log.Error("Failed to forward DNS query. Error: ", err)
+			return
+		}
+	}
+
+	_, err := conn.WriteTo(response, addr)
+	if err != nil {
+		log.Error("Failed to send DNS response. Error: ", err)
+	}
+}
+
+// runDnsTcpResponder - Answer redirected pod DNS queries sent over TCP
+func runDnsTcpResponder() {
+	listener, err := net.Listen("tcp4", net.JoinHostPort(dnsResponderAddr, strconv.Itoa(dnsResponderPort)))
+	if err != nil {
+		log.Error("Failed to start DNS TCP responder. Error: ", err)
+		return
+	}
+	defer listener.Close()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Error("Failed to accept DNS TCP connection. Error: ", err)
+			continue
+		}
+		go handleDnsTcpConn(conn)
+	}
+}
+
+// handleDnsTcpConn - Answer DNS queries received on a TCP connection until it is closed or idle
+func handleDnsTcpConn(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		_ = conn.SetDeadline(time.Now().Add(dnsTcpIdleTimeout))
+		query, err := readDnsTcpMsg(conn)
+		if err != nil {
+			return
+		}
+
+		// Answer query using DNS records, if any
+		response := getDnsResponse(query)
+		if response == nil {
+			// Forward query to upstream nameserver
+			response, err = forwardDnsQuery("tcp", query)
+			if err != nil {
+				log.Error("Failed to forward DNS query. Error: ", err)
+				return
+			}
+		}
+
+		err = writeDnsTcpMsg(conn, response)
+		if err != nil {
+			log.Error("Failed to send DNS response. Error: ", err)
+			return
+		}
+	}
+}
+
+// readDnsTcpMsg - Read a DNS message prefixed with its 2-byte length (RFC 1035 section 4.2.2)
+func readDnsTcpMsg(r io.Reader) ([]byte, error) {
+	var length uint16
+	err := binary.Read(r, binary.BigEndian, &length)
+	if err != nil {
+		return nil, err
+	}
+	msg := make([]byte, length)
+	_, err = io.ReadFull(r, msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// writeDnsTcpMsg - Write a DNS message prefixed with its 2-byte length (RFC 1035 section 4.2.2)
+func writeDnsTcpMsg(w io.Writer, msg []byte) error {
+	buf := make([]byte, 2+len(msg))
+	binary.BigEndian.PutUint16(buf, uint16(len(msg)))
+	copy(buf[2:], msg)
+	_, err := w.Write(buf)
+	return err
+}
+
+// getDnsResponse - Build DNS response from DNS records; nil if query does not match a record
+func getDnsResponse(query []byte) []byte {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil
+	}
+	question, err := parser.Question()
+	if err != nil || question.Class != dnsmessage.ClassINET {
+		return nil
+	}
+
+	// Find DNS records for requested domain name
+	dnsMutex.RLock()
+	records, found := dnsRecords[strings.ToLower(question.Name.String())]
+	var record *DnsRecord
+	if found {
+		record = records[question.Type]
+	}
+	dnsMutex.RUnlock()
+	if !found {
+		return nil
+	}
+
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+		ID:                 header.ID,
+		Response:           true,
+		Authoritative:      true,
+		RecursionDesired:   header.RecursionDesired,
+		RecursionAvailable: true,
+		RCode:              dnsmessage.RCodeSuccess,
+	})
+	builder.EnableCompression()
+	err = builder.StartQuestions()
+	if err == nil {
+		err = builder.Question(question)
+	}
+	if err == nil {
+		err = builder.StartAnswers()
+	}
+
+	// Answer with empty record set if domain name has no record of the requested type
+	if err == nil && record != nil {
+		resource := dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: record.ttl}
+		switch question.Type {
+		case dnsmessage.TypeA:
+			var a [4]byte
+			copy(a[:], record.ip.To4())
+			err = builder.AResource(resource, dnsmessage.AResource{A: a})
+		case dnsmessage.TypeAAAA:
+			var aaaa [16]byte
+			copy(aaaa[:], record.ip.To16())
+			err = builder.AAAAResource(resource, dnsmessage.AAAAResource{AAAA: aaaa})
+		}
+	}
+	if err != nil {
+		log.Error("Failed to build DNS response. Error: ", err)
+		return nil
+	}
+	response, err := builder.Finish()
+	if err != nil {
+		log.Error("Failed to build DNS response. Error: ", err)
+		return nil
+	}
+	return response
+}
+
+// forwardDnsQuery - Send DNS query to upstream nameserver over the provided network & return response
+func forwardDnsQuery(network string, query []byte) ([]byte, error) {
+	// Mark forwarded queries so they are not redirected back to DNS responder
+	dialer := net.Dialer{
+		Timeout: dnsForwardTimeout,
+		Control: func(network string, address string, c syscall.RawConn) error {
+			var sockErr error
+			err := c.Control(func(fd uintptr) {
+				sockErr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_MARK, dnsForwardMark)
+			})
+			if err != nil {
+				return err
+			}
+			return sockErr
+		},
+	}
+	conn, err := dialer.Dial(network, net.JoinHostPort(dnsUpstream, strconv.Itoa(dnsPort)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(dnsForwardTimeout))
+	if network == "tcp" {
+		err = writeDnsTcpMsg(conn, query)
+		if err != nil {
+			return nil, err
+		}
+		return readDnsTcpMsg(conn)
+	}
+	_, err = conn.Write(query)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, dnsMaxMsgLen)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// getDnsUpstream - Get pod nameserver from resolver configuration
+func getDnsUpstream() string {
+	file, err := os.Open(dnsResolvConf)
+	if err != nil {
+		log.Error("Failed to open ", dnsResolvConf, ". Error: ", err)
+		return dnsResponderAddr
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return fields[1]
+		}
+	}
+	log.Error("No nameserver found in ", dnsResolvConf)
+	return dnsResponderAddr
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestGetDnsRecordName(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	names := map[string]string{
+		"app.example.com":  "app.example.com.",
+		"app.example.com.": "app.example.com.",
+		"App.Example.COM":  "app.example.com.",
+		"localhost":        "localhost.",
+	}
+	for domainName, expected := range names {
+		if name := getDnsRecordName(domainName); name != expected {
+			t.Fatalf("Invalid record name for %s: %s", domainName, name)
+		}
+	}
+}
+
+func TestGetDnsResponse(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	dnsMutex.Lock()
+	dnsRecords = map[string]map[dnsmessage.Type]*DnsRecord{
+		"app.example.com.": {
+			dnsmessage.TypeA:    {ip: net.ParseIP("10.0.0.1"), ttl: 30},
+			dnsmessage.TypeAAAA: {ip: net.ParseIP("2001:db8::1"), ttl: 60},
+		},
+		"v4.example.com.": {
+			dnsmessage.TypeA: {ip: net.ParseIP("10.0.0.2")},
+		},
+	}
+	dnsMutex.Unlock()
+	defer func() {
+		dnsMutex.Lock()
+		dnsRecords = map[string]map[dnsmessage.Type]*DnsRecord{}
+		dnsMutex.Unlock()
+	}()
+
+	tests := []struct {
+		name      string
+		qname     string
+		qtype     dnsmessage.Type
+		match     bool
+		answerIp  string
+		answerTtl uint32
+	}{
+		{"A record", "app.example.com.", dnsmessage.TypeA, true, "10.0.0.1", 30},
+		{"AAAA record", "app.example.com.", dnsmessage.TypeAAAA, true, "2001:db8::1", 60},
+		{"case insensitive name", "App.Example.Com.", dnsmessage.TypeA, true, "10.0.0.1", 30},
+		{"default TTL", "v4.example.com.", dnsmessage.TypeA, true, "10.0.0.2", 0},
+		{"empty answer", "v4.example.com.", dnsmessage.TypeAAAA, true, "", 0},
+		{"no match", "other.example.com.", dnsmessage.TypeA, false, "", 0},
+	}
+	for _, test := range tests {
+		query := buildDnsQuery(t, 0x1234, test.qname, test.qtype)
+		response := getDnsResponse(query)
+		if !test.match {
+			if response != nil {
+				t.Fatalf("Unexpected response for %s", test.name)
+			}
+			continue
+		}
+		if response == nil {
+			t.Fatalf("Missing response for %s", test.name)
+		}
+
+		var msg dnsmessage.Message
+		err := msg.Unpack(response)
+		if err != nil {
+			t.Fatalf("Invalid response for %s: %s", test.name, err.Error())
+		}
+		if msg.Header.ID != 0x1234 || !msg.Header.Response || !msg.Header.Authoritative || !msg.Header.RecursionDesired ||
+			msg.Header.RCode != dnsmessage.RCodeSuccess {
+			t.Fatalf("Invalid response header for %s: %+v", test.name, msg.Header)
+		}
+		if len(msg.Questions) != 1 || msg.Questions[0].Name.String() != test.qname || msg.Questions[0].Type != test.qtype {
+			t.Fatalf("Invalid response question for %s: %+v", test.name, msg.Questions)
+		}
+		if test.answerIp == "" {
+			if len(msg.Answers) != 0 {
+				t.Fatalf("Unexpected answers for %s: %+v", test.name, msg.Answers)
+			}
+			continue
+		}
+		if len(msg.Answers) != 1 || msg.Answers[0].Header.TTL != test.answerTtl {
+			t.Fatalf("Invalid answers for %s: %+v", test.name, msg.Answers)
+		}
+		var ip net.IP
+		switch body := msg.Answers[0].Body.(type) {
+		case *dnsmessage.AResource:
+			ip = net.IP(body.A[:])
+		case *dnsmessage.AAAAResource:
+			ip = net.IP(body.AAAA[:])
+		}
+		if !ip.Equal(net.ParseIP(test.answerIp)) {
+			t.Fatalf("Invalid answer IP for %s: %s", test.name, ip)
+		}
+	}
+
+	// Malformed queries
+	fmt.Println("Validate malformed queries")
+	query := buildDnsQuery(t, 1, "app.example.com.", dnsmessage.TypeA)
+	for _, malformed := range [][]byte{nil, {0x12}, query[:12], query[:len(query)-2]} {
+		if getDnsResponse(malformed) != nil {
+			t.Fatalf("Unexpected response for malformed query: %v", malformed)
+		}
+	}
+
+	// Non-Internet class
+	chaosQuery, err := (&dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: 1},
+		Questions: []dnsmessage.Question{{Name: dnsmessage.MustNewName("app.example.com."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassCHAOS}},
+	}).Pack()
+	if err != nil {
+		t.Fatalf("Failed to build query: %s", err.Error())
+	}
+	if getDnsResponse(chaosQuery) != nil {
+		t.Fatalf("Unexpected response for non-Internet class query")
+	}
+}
+
+func TestDnsTcpMsg(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	query := buildDnsQuery(t, 1, "app.example.com.", dnsmessage.TypeA)
+	var buf bytes.Buffer
+	err := writeDnsTcpMsg(&buf, query)
+	if err != nil {
+		t.Fatalf("Failed to write message: %s", err.Error())
+	}
+	if buf.Len() != len(query)+2 || int(buf.Bytes()[0])<<8|int(buf.Bytes()[1]) != len(query) {
+		t.Fatalf("Invalid message length prefix")
+	}
+	msg, err := readDnsTcpMsg(&buf)
+	if err != nil || !bytes.Equal(msg, query) {
+		t.Fatalf("Invalid message read")
+	}
+
+	// Truncated message
+	_ = writeDnsTcpMsg(&buf, query)
+	buf.Truncate(buf.Len() - 1)
+	_, err = readDnsTcpMsg(&buf)
+	if err == nil {
+		t.Fatalf("Truncated message should fail")
+	}
+}
+
+func buildDnsQuery(t *testing.T, id uint16, name string, qtype dnsmessage.Type) []byte {
+	query, err := (&dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: dnsmessage.MustNewName(name), Type: qtype, Class: dnsmessage.ClassINET}},
+	}).Pack()
+	if err != nil {
+		t.Fatalf("Failed to build query: %s", err.Error())
+	}
+	return query
+}
@@ -23,6 +23,8 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -76,6 +78,43 @@ const fieldLbSvcPort string = "lb-svc-port"
 const fieldLbPodName string = "lb-pod-name"
 const fieldLbPodIp string = "lb-pod-ip"
 
+const typeTr string = "tr"
+const trafficRulesChain string = meepPrefix + "TRAFFIC-RULES"
+
+const fieldTrOrder string = "tr-order"
+const fieldTrAppId string = "tr-app-id"
+const fieldTrRuleId string = "tr-rule-id"
+const fieldTrAction string = "tr-action"
+const fieldTrSrcIp string = "tr-src-ip"
+const fieldTrDstIp string = "tr-dst-ip"
+const fieldTrProtocol string = "tr-protocol"
+const fieldTrSrcPort string = "tr-src-port"
+const fieldTrDstPort string = "tr-dst-port"
+const fieldTrDscp string = "tr-dscp"
+const fieldTrTargetIp string = "tr-target-ip"
+
+const typeDns string = "dns"
+const dnsChain string = meepPrefix + "DNS"
+
+const fieldDnsDomainName string = "dns-domain-name"
+const fieldDnsIpAddressType string = "dns-ip-address-type"
+const fieldDnsIpAddress string = "dns-ip-address"
+const fieldDnsTtl string = "dns-ttl"
+
+// Traffic rule actions
+const (
+	trActionDrop                  = "DROP"
+	trActionForwardDecapsulated   = "FORWARD_DECAPSULATED"
+	trActionForwardEncapsulated   = "FORWARD_ENCAPSULATED"
+	trActionDuplicateDecapsulated = "DUPLICATE_DECAPSULATED"
+	trActionDuplicateEncapsulated = "DUPLICATE_ENCAPSULATED"
+)
+
+// Forwarded packet marks use the upper byte of the packet mark, leaving the DNS forward mark untouched
+const trMarkMask = 0xff000000
+const trMarkShift = 24
+const trMaxTargets = 255
+
 const DEFAULT_SIDECAR_DB = 0
 
 type DestElement struct {
@@ -95,6 +134,8 @@ type NetChar struct {
 	PacketLoss   string
 	Throughput   string
 	Distribution string
+	Correlation  string
+	Impairments  string
 }
 
 type Opts struct {
@@ -126,6 +167,8 @@ var netcharMap = map[string]*NetChar{}
 var latestLatencyResultsMap map[string]int32
 
 var flushRequired = false
+var appliedTrafficRules = ""
+var trafficRulesApplied = false
 
 var mqLocal *mq.MsgQueue
 var rc *redis.Connector
@@ -292,6 +335,13 @@ func runMeepSidecar() (err error) {
 	// Refresh LB IPtables rules to match DB state
 	refreshLbRules()
 
+	// Refresh traffic rule IPtables rules to match DB state
+	refreshTrafficRules()
+
+	// Start DNS responder & refresh DNS records to match DB state
+	go runDnsResponder()
+	refreshDnsRules()
+
 	// Register Message Queue handler
 	handler := mq.MsgHandler{Handler: msgHandler, UserData: nil}
 	_, err = mqLocal.RegisterHandler(handler)
@@ -317,6 +367,12 @@ func msgHandler(msg *mq.Msg, userData interface{}) {
 	case mq.MsgTcNetRulesUpdate:
 		log.Debug("RX MSG: ", mq.PrintMsg(msg))
 		refreshNcRules()
+	case mq.MsgTcTrafficRulesUpdate:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		refreshTrafficRules()
+	case mq.MsgTcDnsRulesUpdate:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		refreshDnsRules()
 	default:
 		log.Trace("Ignoring unsupported message: ", mq.PrintMsg(msg))
 	}
@@ -402,6 +458,10 @@ func refreshLbRules() {
 	}
 	delete(chainMap, egressSvcChain)
 
+	// Traffic rules & DNS chains are managed separately
+	delete(chainMap, trafficRulesChain)
+	delete(chainMap, dnsChain)
+
 	// Reapply top-level routing rules if not present
 	err = ipTbl.AppendUnique("nat", "OUTPUT", "-j", meSvcChain)
 	if err != nil {
@@ -578,6 +638,192 @@ func refreshLbRulesHandler(key string, fields map[string]string, userData interf
 	return nil
 }
 
+// refreshTrafficRules - Refresh App traffic rule chains to match DB state
+func refreshTrafficRules() {
+	currentTime := time.Now()
+
+	// Get pod-specific traffic rule entries stored in DB
+	var entries []map[string]string
+	keyName := baseKey + typeTr + ":" + PodName + ":*"
+	err := rc.ForEachEntry(keyName, refreshTrafficRulesHandler, &entries)
+	if err != nil {
+		log.Error("Failed to search and process pod-specific MEEP traffic rules. Error: ", err)
+		return
+	}
+
+	// Sort entries in enforcement order
+	sort.Slice(entries, func(i, j int) bool {
+		orderI, _ := strconv.Atoi(entries[i][fieldTrOrder])
+		orderJ, _ := strconv.Atoi(entries[j][fieldTrOrder])
+		return orderI < orderJ
+	})
+
+	// Generate table-specific rules
+	// NOTE: All traffic rule decisions are made in the mangle table in order to preserve rule
+	//       priority. Decapsulated forwarded packets are marked with a destination-specific mark
+	//       that is used in the nat table to redirect the packet to its destination interface.
+	//       Forwarded-as-is & duplicated packets are sent unmodified to their destination
+	//       interface using the TEE target.
+	tables := []string{"mangle", "nat"}
+	tableRules := make(map[string][][]string)
+	targetMarks := make(map[string]int)
+	var targetIps []string
+	for _, entry := range entries {
+		// Assign a mark to each destination interface
+		action := entry[fieldTrAction]
+		targetIp := strings.Split(entry[fieldTrTargetIp], ",")[0]
+		if action == trActionForwardDecapsulated {
+			if _, found := targetMarks[targetIp]; !found {
+				if len(targetMarks) == trMaxTargets {
+					log.Error("Ignoring traffic rule ", entry[fieldTrRuleId], ": too many destination interfaces")
+					continue
+				}
+				targetMarks[targetIp] = (len(targetMarks) + 1) << trMarkShift
+				targetIps = append(targetIps, targetIp)
+			}
+		}
+		tableRules["mangle"] = append(tableRules["mangle"], getTrafficRuleSpecs(entry, targetMarks[targetIp])...)
+	}
+	for _, targetIp := range targetIps {
+		rule := []string{"-m", "mark", "--mark", strconv.Itoa(targetMarks[targetIp]) + "/" + strconv.Itoa(trMarkMask),
+			"-j", "DNAT", "--to-destination", targetIp}
+		tableRules["nat"] = append(tableRules["nat"], rule)
+	}
+	trafficRules := ""
+	for _, table := range tables {
+		for _, rule := range tableRules[table] {
+			trafficRules += table + " " + strings.Join(rule, " ") + "\n"
+		}
+	}
+
+	// Ignore if rules are already applied
+	if trafficRulesApplied && trafficRules == appliedTrafficRules {
+		return
+	}
+	trafficRulesApplied = false
+
+	for _, table := range tables {
+		// Create or flush traffic rules chain
+		err = ipTbl.ClearChain(table, trafficRulesChain)
+		if err != nil {
+			log.Error("Failed to clear chain ", trafficRulesChain, " in table ", table, ". Error: ", err)
+			return
+		}
+
+		// Traffic rules take precedence over other MEEP rules
+		exists, err := ipTbl.Exists(table, "OUTPUT", "-j", trafficRulesChain)
+		if err != nil {
+			log.Error("Failed to check if rule exists. Error: ", err)
+			return
+		}
+		if !exists {
+			err = ipTbl.Insert(table, "OUTPUT", 1, "-j", trafficRulesChain)
+			if err != nil {
+				log.Error("Failed to set rule [-I OUTPUT 1 -j "+trafficRulesChain+"] in table ", table, ". Error: ", err)
+				return
+			}
+		}
+
+		// Apply traffic rules
+		for _, rule := range tableRules[table] {
+			err = ipTbl.Append(table, trafficRulesChain, rule...)
+			if err != nil {
+				log.Error("Failed to set rule [-A ", trafficRulesChain, " ", rule, "] in table ", table, ". Error: ", err)
+				return
+			}
+		}
+	}
+	appliedTrafficRules = trafficRules
+	trafficRulesApplied = true
+
+	// Flush tracked connections to make sure new traffic rules are hit
+	flushTrackedConnections()
+
+	elapsed := time.Since(currentTime)
+	log.Debug("refreshTrafficRules: execution time: ", elapsed)
+}
+
+func refreshTrafficRulesHandler(key string, fields map[string]string, userData interface{}) error {
+	entries := userData.(*[]map[string]string)
+
+	// Copy entry
+	entry := make(map[string]string, len(fields))
+	for k, v := range fields {
+		entry[k] = v
+	}
+	*entries = append(*entries, entry)
+	return nil
+}
+
+// getTrafficRuleSpecs - Get the mangle table iptables rules for a traffic rule entry
+func getTrafficRuleSpecs(entry map[string]string, targetMark int) [][]string {
+	var rules [][]string
+
+	// Build traffic filter match
+	var match []string
+	if entry[fieldTrProtocol] != "" {
+		match = append(match, "-p", entry[fieldTrProtocol])
+	}
+	if srcIp := entry[fieldTrSrcIp]; strings.Contains(srcIp, "-") {
+		match = append(match, "-m", "iprange", "--src-range", srcIp)
+	} else if srcIp != "" {
+		match = append(match, "-s", srcIp)
+	}
+	if dstIp := entry[fieldTrDstIp]; strings.Contains(dstIp, "-") {
+		match = append(match, "-m", "iprange", "--dst-range", dstIp)
+	} else if dstIp != "" {
+		match = append(match, "-d", dstIp)
+	}
+	if entry[fieldTrSrcPort] != "" {
+		match = append(match, "--sport", entry[fieldTrSrcPort])
+	}
+	if entry[fieldTrDstPort] != "" {
+		match = append(match, "--dport", entry[fieldTrDstPort])
+	}
+	if entry[fieldTrDscp] != "" {
+		match = append(match, "-m", "dscp", "--dscp", entry[fieldTrDscp])
+	}
+	match = append(match, "-m", "comment", "--comment", entry[fieldTrAppId]+":"+entry[fieldTrRuleId])
+
+	addRule := func(target ...string) {
+		rule := append(append([]string{}, match...), target...)
+		rules = append(rules, rule)
+	}
+
+	// Get destination interface IP addresses
+	var targetIps []string
+	for _, targetIp := range strings.Split(entry[fieldTrTargetIp], ",") {
+		if targetIp != "" {
+			targetIps = append(targetIps, targetIp)
+		}
+	}
+
+	switch entry[fieldTrAction] {
+	case trActionDrop:
+		addRule("-j", "DROP")
+	case trActionForwardDecapsulated:
+		// Mark packet for redirection to destination interface in nat table
+		addRule("-j", "MARK", "--set-xmark", strconv.Itoa(targetMark)+"/"+strconv.Itoa(trMarkMask))
+		addRule("-j", "RETURN")
+	case trActionForwardEncapsulated:
+		// Route unmodified packet to destination interface; original packet is not delivered
+		if len(targetIps) != 0 {
+			addRule("-j", "TEE", "--gateway", targetIps[0])
+		}
+		addRule("-j", "DROP")
+	case trActionDuplicateDecapsulated, trActionDuplicateEncapsulated:
+		// Send a copy of the packet to each destination interface; original packet is delivered
+		for _, targetIp := range targetIps {
+			addRule("-j", "TEE", "--gateway", targetIp)
+		}
+		addRule("-j", "RETURN")
+	default:
+		// Passthrough
+		addRule("-j", "RETURN")
+	}
+	return rules
+}
+
 // refreshDests - Refresh destinations to match valid DB entries
 func refreshDests() {
 	// Get list of destinations with valid IP addresses
@@ -977,6 +1223,13 @@ func cmdSetIfb(shape map[string]string) (bool, error) {
 	distribution := shape["distribution"]
 	loss := shape["packetLoss"]
 	dataRate := shape["dataRate"]
+	impairments := getNetemImpairments(shape)
+
+	// Gilbert-Elliott correlated loss or random loss
+	lossStr := " loss " + loss + "%"
+	if shape["lossModel"] == "GilbertElliott" {
+		lossStr = " loss gemodel " + shape["lossP"] + "% " + shape["lossR"] + "% " + shape["lossBad"] + "% " + shape["lossGood"] + "%"
+	}
 
 	//tc qdisc change dev $ifb$ifbnumber handle 1:0 root netem delay $delay$ms loss $loss$prcent
 	distributionStr := ""
@@ -998,8 +1251,9 @@ func cmdSetIfb(shape map[string]string) (bool, error) {
 		netcharMap[ifbNumber] = nc
 	}
 	//only apply if an update is needed
-	if nc.Latency != delay || nc.Jitter != delayVariation || nc.PacketLoss != loss || nc.Throughput != dataRate || (delayVariation != "0" && nc.Distribution != distribution) {
-		str := "tc qdisc change dev ifb" + ifbNumber + " handle 1:0 root netem delay " + delay + "ms " + delayVariation + "ms " + delayCorrelation + "% " + distributionStr + " loss " + loss + "%"
+	if nc.Latency != delay || nc.Jitter != delayVariation || nc.PacketLoss != lossStr || nc.Throughput != dataRate || (delayVariation != "0" && nc.Distribution != distribution) ||
+		nc.Correlation != delayCorrelation || nc.Impairments != impairments {
+		str := "tc qdisc change dev ifb" + ifbNumber + " handle 1:0 root netem delay " + delay + "ms " + delayVariation + "ms " + delayCorrelation + "% " + distributionStr + lossStr + impairments
 		if dataRate != "" && dataRate != "0" {
 			str = str + " rate " + dataRate + "bit"
 		}
@@ -1013,15 +1267,41 @@ func cmdSetIfb(shape map[string]string) (bool, error) {
 		//store the new values
 		nc.Latency = delay
 		nc.Jitter = delayVariation
-		nc.PacketLoss = loss
+		nc.PacketLoss = lossStr
 		nc.Throughput = dataRate
 		nc.Distribution = distribution
+		nc.Correlation = delayCorrelation
+		nc.Impairments = impairments
 		return true, nil
 	}
 
 	return false, nil
 }
 
+// getNetemImpairments - Build netem duplicate, corrupt & reorder options from shaping rule
+func getNetemImpairments(shape map[string]string) string {
+	str := ""
+	if isPositive(shape["duplication"]) {
+		str += " duplicate " + shape["duplication"] + "%"
+	}
+	if isPositive(shape["corruption"]) {
+		str += " corrupt " + shape["corruption"] + "%"
+	}
+	// netem reordering requires a delay to be applied
+	if isPositive(shape["reordering"]) && shape["delay"] != "0" {
+		str += " reorder " + shape["reordering"] + "%"
+		if isPositive(shape["reorderCorrelation"]) {
+			str += " " + shape["reorderCorrelation"] + "%"
+		}
+	}
+	return str
+}
+
+func isPositive(value string) bool {
+	f, err := strconv.ParseFloat(value, 64)
+	return err == nil && f > 0
+}
+
 func cmdDeleteIfb(ifbNumber string) error {
 	//"ip link delete ifb$ifbNumber"
 	str := "ip link delete ifb" + ifbNumber
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestGetTrafficRuleSpecs(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	comment := []string{"-m", "comment", "--comment", "app1:rule1"}
+	match := func(args ...string) []string {
+		return append(args, comment...)
+	}
+	rule := func(match []string, target ...string) []string {
+		return append(append([]string{}, match...), target...)
+	}
+	newEntry := func(action string, targetIp string) map[string]string {
+		return map[string]string{
+			fieldTrAppId:    "app1",
+			fieldTrRuleId:   "rule1",
+			fieldTrAction:   action,
+			fieldTrDstIp:    "10.0.0.2",
+			fieldTrTargetIp: targetIp,
+		}
+	}
+	dstMatch := match("-d", "10.0.0.2")
+
+	tests := []struct {
+		name       string
+		entry      map[string]string
+		targetMark int
+		expected   [][]string
+	}{
+		{
+			name: "full match",
+			entry: map[string]string{
+				fieldTrAppId:    "app1",
+				fieldTrRuleId:   "rule1",
+				fieldTrAction:   trActionDrop,
+				fieldTrProtocol: "tcp",
+				fieldTrSrcIp:    "10.0.0.1",
+				fieldTrDstIp:    "10.0.1.0/24",
+				fieldTrSrcPort:  "1000:2000",
+				fieldTrDstPort:  "80",
+				fieldTrDscp:     "46",
+			},
+			expected: [][]string{
+				rule(match("-p", "tcp", "-s", "10.0.0.1", "-d", "10.0.1.0/24", "--sport", "1000:2000", "--dport", "80",
+					"-m", "dscp", "--dscp", "46"), "-j", "DROP"),
+			},
+		},
+		{
+			name: "address ranges",
+			entry: map[string]string{
+				fieldTrAppId:  "app1",
+				fieldTrRuleId: "rule1",
+				fieldTrSrcIp:  "10.0.0.1-10.0.0.9",
+				fieldTrDstIp:  "10.0.1.1-10.0.1.9",
+			},
+			expected: [][]string{
+				rule(match("-m", "iprange", "--src-range", "10.0.0.1-10.0.0.9", "-m", "iprange", "--dst-range", "10.0.1.1-10.0.1.9"),
+					"-j", "RETURN"),
+			},
+		},
+		{
+			name:     "passthrough",
+			entry:    newEntry("PASSTHROUGH", ""),
+			expected: [][]string{rule(dstMatch, "-j", "RETURN")},
+		},
+		{
+			name:       "forward decapsulated",
+			entry:      newEntry(trActionForwardDecapsulated, "10.0.0.3"),
+			targetMark: 2 << trMarkShift,
+			expected: [][]string{
+				rule(dstMatch, "-j", "MARK", "--set-xmark", "33554432/4278190080"),
+				rule(dstMatch, "-j", "RETURN"),
+			},
+		},
+		{
+			name:  "forward encapsulated",
+			entry: newEntry(trActionForwardEncapsulated, "10.0.0.3"),
+			expected: [][]string{
+				rule(dstMatch, "-j", "TEE", "--gateway", "10.0.0.3"),
+				rule(dstMatch, "-j", "DROP"),
+			},
+		},
+		{
+			name:  "duplicate decapsulated",
+			entry: newEntry(trActionDuplicateDecapsulated, "10.0.0.3,10.0.0.4"),
+			expected: [][]string{
+				rule(dstMatch, "-j", "TEE", "--gateway", "10.0.0.3"),
+				rule(dstMatch, "-j", "TEE", "--gateway", "10.0.0.4"),
+				rule(dstMatch, "-j", "RETURN"),
+			},
+		},
+		{
+			name:  "duplicate encapsulated",
+			entry: newEntry(trActionDuplicateEncapsulated, "10.0.0.3"),
+			expected: [][]string{
+				rule(dstMatch, "-j", "TEE", "--gateway", "10.0.0.3"),
+				rule(dstMatch, "-j", "RETURN"),
+			},
+		},
+	}
+	for _, test := range tests {
+		rules := getTrafficRuleSpecs(test.entry, test.targetMark)
+		if !reflect.DeepEqual(rules, test.expected) {
+			t.Fatalf("Invalid rules for %s: %v", test.name, rules)
+		}
+	}
+}
@@ -69,6 +69,7 @@ func main() {
 
 		// Start VIS REST API Server
 		router := server.NewRouter()
+		server.SetRouter(router)
 		methods := handlers.AllowedMethods([]string{"OPTIONS", "DELETE", "GET", "HEAD", "POST", "PUT"})
 		header := handlers.AllowedHeaders([]string{"content-type"})
 		log.Fatal(http.ListenAndServe(":80", handlers.CORS(methods, header)(router)))
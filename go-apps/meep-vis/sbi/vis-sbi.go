@@ -17,12 +17,18 @@
 package sbi
 
 import (
+	"encoding/json"
+	"errors"
+	"math"
 	"os"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	gc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-gis-cache"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
@@ -39,16 +45,37 @@ const postgisUser = "postgres"
 const postgisPwd = "pwd"
 
 type SbiCfg struct {
-	ModuleName     string
-	SandboxName    string
-	MepName        string
-	RedisAddr      string
-	InfluxAddr     string
-	PostgisHost    string
-	PostgisPort    string
-	Locality       []string
-	ScenarioNameCb func(string)
-	CleanUpCb      func()
+	ModuleName      string
+	SandboxName     string
+	MepName         string
+	RedisAddr       string
+	InfluxAddr      string
+	PostgisHost     string
+	PostgisPort     string
+	Locality        []string
+	ScenarioNameCb  func(string)
+	CleanUpCb       func()
+	PoaInfoUpdateCb func()
+}
+
+type PoaInfoSbi struct {
+	Name       string
+	PoaType    string
+	Zone       string
+	Mnc        string
+	Mcc        string
+	CellId     string
+	Latitude   float32
+	Longitude  float32
+	Radius     float32
+	Neighbours []string
+}
+
+// TrafficLoadCellSbi - Traffic load grid cell; Area is a GeoJSON Polygon geometry
+type TrafficLoadCellSbi struct {
+	Name  string
+	Area  string
+	Loads []int32
 }
 
 type VisSbi struct {
@@ -62,9 +89,12 @@ type VisSbi struct {
 	handlerId                int
 	apiMgr                   *sam.SwaggerApiMgr
 	activeModel              *mod.Model
+	gisCache                 *gc.GisCache
+	poaInfoMap               map[string]*PoaInfoSbi
 	trafficMgr               *tm.TrafficMgr
 	updateScenarioNameCB     func(string)
 	cleanUpCB                func()
+	poaInfoUpdateCB          func()
 	mutex                    sync.Mutex
 	predictionModelSupported bool
 }
@@ -85,6 +115,8 @@ func Init(cfg SbiCfg) (predictionModelSupported bool, err error) {
 	sbi.scenarioName = ""
 	sbi.updateScenarioNameCB = cfg.ScenarioNameCb
 	sbi.cleanUpCB = cfg.CleanUpCb
+	sbi.poaInfoUpdateCB = cfg.PoaInfoUpdateCb
+	sbi.poaInfoMap = make(map[string]*PoaInfoSbi)
 	// redisAddr = cfg.RedisAddr
 	// influxAddr = cfg.InfluxAddr
 
@@ -129,6 +161,14 @@ func Init(cfg SbiCfg) (predictionModelSupported bool, err error) {
 		return false, err
 	}
 
+	// Connect to GIS cache
+	sbi.gisCache, err = gc.NewGisCache(sbi.sandboxName, cfg.RedisAddr)
+	if err != nil {
+		log.Error("Failed to GIS Cache: ", err.Error())
+		return false, err
+	}
+	log.Info("Connected to GIS Cache")
+
 	// Get prediction model support
 	predictionModelSupportedEnv := strings.TrimSpace(os.Getenv("MEEP_PREDICT_MODEL_SUPPORTED"))
 	if predictionModelSupportedEnv != "" {
@@ -141,23 +181,16 @@ func Init(cfg SbiCfg) (predictionModelSupported bool, err error) {
 
 	if sbi.predictionModelSupported {
 		// Connect to VIS Traffic Manager
+		// Grid map file is optional as traffic load model may be provided by the scenario
 		sbi.trafficMgr, err = tm.NewTrafficMgr(sbi.moduleName, sbi.sandboxName, postgisUser, postgisPwd, cfg.PostgisHost, cfg.PostgisPort)
-		if sbi.trafficMgr.GridFileExists {
-			if err != nil {
-				log.Error("Failed connection to VIS Traffic Manager: ", err)
-				return false, err
-			}
-			log.Info("Connected to VIS Traffic Manager")
-
-			// Delete any old tables
-			_ = sbi.trafficMgr.DeleteTables()
-
-		} else {
-			// In case grid map file does not exist
-			log.Error("Failed connection to VIS Traffic Manager as grid map file does not exist")
-			_ = sbi.trafficMgr.DeleteTrafficMgr()
-			sbi.predictionModelSupported = false
+		if err != nil {
+			log.Error("Failed connection to VIS Traffic Manager: ", err)
+			return false, err
 		}
+		log.Info("Connected to VIS Traffic Manager")
+
+		// Delete any old tables
+		_ = sbi.trafficMgr.DeleteTables()
 	}
 
 	// Initialize service
@@ -252,6 +285,11 @@ func processActiveScenarioTerminate() {
 	// Update scenario name
 	sbi.scenarioName = ""
 
+	// Flush POA info
+	sbi.mutex.Lock()
+	sbi.poaInfoMap = make(map[string]*PoaInfoSbi)
+	sbi.mutex.Unlock()
+
 	// Flush all Traffic Manager tables
 	if sbi.trafficMgr != nil {
 		_ = sbi.trafficMgr.DeleteAllPoaLoad()
@@ -268,6 +306,13 @@ func processActiveScenarioUpdate() {
 	log.Debug("processActiveScenarioUpdate")
 	sbi.activeModel.UpdateScenario()
 
+	// Update cellular POA info
+	// Notify asynchronously as POA info can only be read once the SBI lock is released
+	poaInfoUpdated := refreshPoaInfo()
+	if poaInfoUpdated && sbi.poaInfoUpdateCB != nil {
+		go sbi.poaInfoUpdateCB()
+	}
+
 	// Process new scenario
 	var scenarioName = sbi.activeModel.GetScenarioName()
 	if scenarioName != sbi.scenarioName {
@@ -292,6 +337,14 @@ func processActiveScenarioUpdate() {
 			}
 			log.Info("Populated VIS DB grid map table")
 
+			// Import scenario traffic load model
+			err = importTrafficLoadModel()
+			if err != nil {
+				log.Error("Failed to import traffic load model: ", err)
+				return
+			}
+			log.Info("Imported scenario traffic load model")
+
 			// Populate VIS DB Traffic Load Table
 			err = populatePoaTable()
 			if err != nil {
@@ -300,20 +353,183 @@ func processActiveScenarioUpdate() {
 			}
 			log.Info("Populated VIS DB traffic load table")
 		}
+	} else if sbi.predictionModelSupported && poaInfoUpdated {
+		// Refresh POA loads when POAs are added, removed or moved
+		err := populatePoaTable()
+		if err != nil {
+			log.Error("Failed to populate traffic load table: ", err)
+			return
+		}
+	}
+
+	// Update number of UEs connected to each POA
+	if sbi.predictionModelSupported {
+		updatePoaUeCounts()
 	}
 }
 
-func populatePoaTable() (err error) {
+// refreshPoaInfo - Update cellular POA info from active scenario; returns true if POA info changed
+func refreshPoaInfo() bool {
+	poaInfoMap := make(map[string]*PoaInfoSbi)
 	poaNameList := sbi.activeModel.GetNodeNames(mod.NodeTypePoa4G, mod.NodeTypePoa5G)
-	var gpsCoordinates [][]float32
 	for _, poaName := range poaNameList {
 		node := sbi.activeModel.GetNode(poaName)
-		if node != nil {
-			nl := node.(*dataModel.NetworkLocation)
-			location := nl.GeoData.Location.Coordinates
-			gpsCoordinates = append(gpsCoordinates, location)
+		if node == nil {
+			continue
+		}
+		nl := node.(*dataModel.NetworkLocation)
+		poaInfo := &PoaInfoSbi{
+			Name:    poaName,
+			PoaType: nl.Type_,
+		}
+
+		// Get PLMN & default cell ID from domain
+		poaParent := sbi.activeModel.GetNodeParent(poaName)
+		if zone, ok := poaParent.(*dataModel.Zone); ok {
+			poaInfo.Zone = zone.Name
+			zoneParent := sbi.activeModel.GetNodeParent(zone.Name)
+			if domain, ok := zoneParent.(*dataModel.Domain); ok {
+				if domain.CellularDomainConfig != nil {
+					poaInfo.Mnc = domain.CellularDomainConfig.Mnc
+					poaInfo.Mcc = domain.CellularDomainConfig.Mcc
+					poaInfo.CellId = domain.CellularDomainConfig.DefaultCellId
+				}
+			}
+		}
+		if nl.Poa4GConfig != nil && nl.Poa4GConfig.CellId != "" {
+			poaInfo.CellId = nl.Poa4GConfig.CellId
+		} else if nl.Poa5GConfig != nil && nl.Poa5GConfig.CellId != "" {
+			poaInfo.CellId = nl.Poa5GConfig.CellId
+		}
+
+		// Get POA location
+		if nl.GeoData != nil && nl.GeoData.Location != nil && len(nl.GeoData.Location.Coordinates) == 2 {
+			poaInfo.Longitude = nl.GeoData.Location.Coordinates[0]
+			poaInfo.Latitude = nl.GeoData.Location.Coordinates[1]
+			poaInfo.Radius = nl.GeoData.Radius
+		}
+		poaInfoMap[poaName] = poaInfo
+	}
+
+	// Neighbour cells are POAs with overlapping coverage areas, ordered by distance
+	for _, poaInfo := range poaInfoMap {
+		distances := make(map[string]float32)
+		for _, otherPoaInfo := range poaInfoMap {
+			if otherPoaInfo.Name == poaInfo.Name || poaInfo.Radius == 0 || otherPoaInfo.Radius == 0 {
+				continue
+			}
+			distance := getDistance(poaInfo.Latitude, poaInfo.Longitude, otherPoaInfo.Latitude, otherPoaInfo.Longitude)
+			if distance <= poaInfo.Radius+otherPoaInfo.Radius {
+				poaInfo.Neighbours = append(poaInfo.Neighbours, otherPoaInfo.Name)
+				distances[otherPoaInfo.Name] = distance
+			}
+		}
+		sort.Slice(poaInfo.Neighbours, func(i, j int) bool {
+			if distances[poaInfo.Neighbours[i]] == distances[poaInfo.Neighbours[j]] {
+				return poaInfo.Neighbours[i] < poaInfo.Neighbours[j]
+			}
+			return distances[poaInfo.Neighbours[i]] < distances[poaInfo.Neighbours[j]]
+		})
+	}
+
+	updated := !reflect.DeepEqual(poaInfoMap, sbi.poaInfoMap)
+	sbi.poaInfoMap = poaInfoMap
+	return updated
+}
+
+// getDistance - Great-circle distance in meters between two geo-coordinates
+func getDistance(lat1 float32, lon1 float32, lat2 float32, lon2 float32) float32 {
+	const earthRadius = 6371000.0
+	phi1 := float64(lat1) * math.Pi / 180
+	phi2 := float64(lat2) * math.Pi / 180
+	deltaPhi := phi2 - phi1
+	deltaLambda := float64(lon2-lon1) * math.Pi / 180
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+	return float32(earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a)))
+}
+
+// GetPoaInfoList - Get cellular POA information from active scenario
+func GetPoaInfoList() []PoaInfoSbi {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	poaInfoList := make([]PoaInfoSbi, 0, len(sbi.poaInfoMap))
+	for _, poaInfo := range sbi.poaInfoMap {
+		poaInfoList = append(poaInfoList, *poaInfo)
+	}
+	return poaInfoList
+}
+
+// GetPoaInfo - Get cellular POA information by POA name
+func GetPoaInfo(poaName string) (PoaInfoSbi, bool) {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	poaInfo, found := sbi.poaInfoMap[poaName]
+	if !found {
+		return PoaInfoSbi{}, false
+	}
+	return *poaInfo, true
+}
+
+// importTrafficLoadModel - Replace traffic load cells with the active scenario traffic load model
+func importTrafficLoadModel() (err error) {
+	var ueLoad float32 = tm.DefaultUeLoad
+	var cells []TrafficLoadCellSbi
+	deployment := sbi.activeModel.GetDeployment(&mod.NodeFilter{ExcludeChildren: true})
+	if deployment != nil && deployment.TrafficLoadModel != nil {
+		ueLoad = deployment.TrafficLoadModel.UeLoad
+		for _, cell := range deployment.TrafficLoadModel.Cells {
+			area, err := json.Marshal(cell.Area)
+			if err != nil {
+				log.Error(err.Error())
+				return err
+			}
+			cells = append(cells, TrafficLoadCellSbi{Name: cell.Name, Area: string(area), Loads: cell.Loads})
+		}
+	}
+	return setTrafficLoadCells(ueLoad, cells)
+}
+
+func setTrafficLoadCells(ueLoad float32, cells []TrafficLoadCellSbi) (err error) {
+	err = sbi.trafficMgr.SetUeLoad(ueLoad)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	err = sbi.trafficMgr.DeleteAllTrafficLoadCells()
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	for _, cell := range cells {
+		err = sbi.trafficMgr.SetTrafficLoadCell(&tm.TrafficLoadCell{Name: cell.Name, Area: cell.Area, Loads: cell.Loads})
+		if err != nil {
+			log.Error(err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+func populatePoaTable() (err error) {
+	// Only POAs with a location have a traffic load pattern
+	var poaNameList []string
+	var gpsCoordinates [][]float32
+	for _, poaName := range sbi.activeModel.GetNodeNames(mod.NodeTypePoa4G, mod.NodeTypePoa5G) {
+		node := sbi.activeModel.GetNode(poaName)
+		if node == nil {
+			continue
+		}
+		nl := node.(*dataModel.NetworkLocation)
+		if nl.GeoData != nil && nl.GeoData.Location != nil && len(nl.GeoData.Location.Coordinates) == 2 {
+			poaNameList = append(poaNameList, poaName)
+			gpsCoordinates = append(gpsCoordinates, nl.GeoData.Location.Coordinates)
 		}
 	}
+
+	// Reset POA loads
+	_ = sbi.trafficMgr.DeleteAllPoaLoad()
 	err = sbi.trafficMgr.PopulatePoaLoad(poaNameList, gpsCoordinates)
 	if err != nil {
 		log.Error(err.Error())
@@ -322,6 +538,137 @@ func populatePoaTable() (err error) {
 	return nil
 }
 
+// updatePoaUeCounts - Count geo-located UEs connected to each POA
+func updatePoaUeCounts() {
+	uePositionMap, err := sbi.gisCache.GetAllPositions(gc.TypeUe)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	poaUeCountMap := make(map[string]int32)
+	for poaName := range sbi.poaInfoMap {
+		node := sbi.activeModel.GetNode(poaName)
+		nl, ok := node.(*dataModel.NetworkLocation)
+		if !ok {
+			continue
+		}
+		for _, pl := range nl.PhysicalLocations {
+			if _, found := uePositionMap[pl.Name]; found && pl.Type_ == mod.NodeTypeUE && pl.Connected {
+				poaUeCountMap[poaName]++
+			}
+		}
+	}
+	sbi.trafficMgr.SetPoaUeCounts(poaUeCountMap)
+}
+
+// UpdatePoaUeCounts - Refresh number of UEs connected to each POA from GIS cache
+func UpdatePoaUeCounts() {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	if sbi.predictionModelSupported {
+		updatePoaUeCounts()
+	}
+}
+
+// GetTrafficLoadModel - Get traffic load model used for QoS prediction
+func GetTrafficLoadModel() (ueLoad float32, cells []TrafficLoadCellSbi, err error) {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	if !sbi.predictionModelSupported {
+		return 0, nil, errors.New("Prediction model not supported")
+	}
+	cellMap, err := sbi.trafficMgr.GetAllTrafficLoadCells()
+	if err != nil {
+		log.Error(err.Error())
+		return 0, nil, err
+	}
+	cells = make([]TrafficLoadCellSbi, 0, len(cellMap))
+	for _, cell := range cellMap {
+		cells = append(cells, TrafficLoadCellSbi{Name: cell.Name, Area: cell.Area, Loads: cell.Loads})
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		return cells[i].Name < cells[j].Name
+	})
+	return sbi.trafficMgr.GetUeLoad(), cells, nil
+}
+
+// SetTrafficLoadModel - Replace traffic load model used for QoS prediction
+func SetTrafficLoadModel(ueLoad float32, cells []TrafficLoadCellSbi) (err error) {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	if !sbi.predictionModelSupported {
+		return errors.New("Prediction model not supported")
+	}
+	err = setTrafficLoadCells(ueLoad, cells)
+	if err != nil {
+		return err
+	}
+	return populatePoaTable()
+}
+
+// SetTrafficLoadCell - Create or update traffic load model cell
+func SetTrafficLoadCell(cell TrafficLoadCellSbi) (err error) {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	if !sbi.predictionModelSupported {
+		return errors.New("Prediction model not supported")
+	}
+	err = sbi.trafficMgr.SetTrafficLoadCell(&tm.TrafficLoadCell{Name: cell.Name, Area: cell.Area, Loads: cell.Loads})
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	return populatePoaTable()
+}
+
+// GetTrafficLoadCell - Get traffic load model cell by name
+func GetTrafficLoadCell(cellName string) (cell TrafficLoadCellSbi, found bool, err error) {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	if !sbi.predictionModelSupported {
+		return cell, false, errors.New("Prediction model not supported")
+	}
+	cellMap, err := sbi.trafficMgr.GetAllTrafficLoadCells()
+	if err != nil {
+		log.Error(err.Error())
+		return cell, false, err
+	}
+	tmCell, found := cellMap[cellName]
+	if !found {
+		return cell, false, nil
+	}
+	return TrafficLoadCellSbi{Name: tmCell.Name, Area: tmCell.Area, Loads: tmCell.Loads}, true, nil
+}
+
+// DeleteTrafficLoadCell - Remove traffic load model cell; returns false if cell not found
+func DeleteTrafficLoadCell(cellName string) (found bool, err error) {
+	sbi.mutex.Lock()
+	defer sbi.mutex.Unlock()
+
+	if !sbi.predictionModelSupported {
+		return false, errors.New("Prediction model not supported")
+	}
+	cellMap, err := sbi.trafficMgr.GetAllTrafficLoadCells()
+	if err != nil {
+		log.Error(err.Error())
+		return false, err
+	}
+	if _, found = cellMap[cellName]; !found {
+		return false, nil
+	}
+	err = sbi.trafficMgr.DeleteTrafficLoadCell(cellName)
+	if err != nil {
+		log.Error(err.Error())
+		return true, err
+	}
+	return true, populatePoaTable()
+}
+
 func GetPredictedPowerValues(hour int32, inRsrp int32, inRsrq int32, poaName string) (outRsrp int32, outRsrq int32, err error) {
 	outRsrp, outRsrq, err = sbi.trafficMgr.PredictQosPerTrafficLoad(hour, inRsrp, inRsrq, poaName)
 	if err != nil {
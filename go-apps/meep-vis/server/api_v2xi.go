@@ -27,6 +27,18 @@ import (
 	"net/http"
 )
 
+func IndividualSubscriptionDELETE(w http.ResponseWriter, r *http.Request) {
+	subscriptionsDelete(w, r)
+}
+
+func IndividualSubscriptionGET(w http.ResponseWriter, r *http.Request) {
+	subscriptionsGet(w, r)
+}
+
+func IndividualSubscriptionPUT(w http.ResponseWriter, r *http.Request) {
+	subscriptionsPut(w, r)
+}
+
 func Mec011AppTerminationPOST(w http.ResponseWriter, r *http.Request) {
 	mec011AppTerminationPost(w, r)
 }
@@ -34,3 +46,47 @@ func Mec011AppTerminationPOST(w http.ResponseWriter, r *http.Request) {
 func PredictedQosPOST(w http.ResponseWriter, r *http.Request) {
 	predictedQosPost(w, r)
 }
+
+func ProvInfoGET(w http.ResponseWriter, r *http.Request) {
+	provInfoPc5Get(w, r)
+}
+
+func ProvInfoUuMbmsGET(w http.ResponseWriter, r *http.Request) {
+	provInfoUuMbmsGet(w, r)
+}
+
+func ProvInfoUuUnicastGET(w http.ResponseWriter, r *http.Request) {
+	provInfoUuUnicastGet(w, r)
+}
+
+func SubGET(w http.ResponseWriter, r *http.Request) {
+	subscriptionLinkListSubscriptionsGet(w, r)
+}
+
+func SubPOST(w http.ResponseWriter, r *http.Request) {
+	subscriptionsPost(w, r)
+}
+
+func TrafficLoadCellDELETE(w http.ResponseWriter, r *http.Request) {
+	trafficLoadCellDelete(w, r)
+}
+
+func TrafficLoadCellGET(w http.ResponseWriter, r *http.Request) {
+	trafficLoadCellGet(w, r)
+}
+
+func TrafficLoadCellPUT(w http.ResponseWriter, r *http.Request) {
+	trafficLoadCellPut(w, r)
+}
+
+func TrafficLoadModelGET(w http.ResponseWriter, r *http.Request) {
+	trafficLoadModelGet(w, r)
+}
+
+func TrafficLoadModelPUT(w http.ResponseWriter, r *http.Request) {
+	trafficLoadModelPut(w, r)
+}
+
+func V2xMessagePOST(w http.ResponseWriter, r *http.Request) {
+	v2xMessagePost(w, r)
+}
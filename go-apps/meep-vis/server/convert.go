@@ -39,3 +39,160 @@ func convertProblemDetailstoJson(probdetails *ProblemDetails) string {
 	}
 	return string(jsonInfo)
 }
+
+func convertV2xMsgSubscriptionToJson(v2xMsgSub *V2xMsgSubscription) string {
+	jsonInfo, err := json.Marshal(*v2xMsgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertJsonToV2xMsgSubscription(jsonInfo string) *V2xMsgSubscription {
+	var v2xMsgSub V2xMsgSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &v2xMsgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &v2xMsgSub
+}
+
+func convertV2xMsgNotificationToJson(v2xMsgNotif *V2xMsgNotification) string {
+	jsonInfo, err := json.Marshal(*v2xMsgNotif)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertUuUnicastProvisioningInfoToJson(provInfo *UuUnicastProvisioningInfo) string {
+	jsonInfo, err := json.Marshal(*provInfo)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertUuMbmsProvisioningInfoToJson(provInfo *UuMbmsProvisioningInfo) string {
+	jsonInfo, err := json.Marshal(*provInfo)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertPc5ProvisioningInfoToJson(provInfo *Pc5ProvisioningInfo) string {
+	jsonInfo, err := json.Marshal(*provInfo)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertProvChgUuUniSubscriptionToJson(provChgSub *ProvChgUuUniSubscription) string {
+	jsonInfo, err := json.Marshal(*provChgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertJsonToProvChgUuUniSubscription(jsonInfo string) *ProvChgUuUniSubscription {
+	var provChgSub ProvChgUuUniSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &provChgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &provChgSub
+}
+
+func convertProvChgUuUniNotificationToJson(provChgNotif *ProvChgUuUniNotification) string {
+	jsonInfo, err := json.Marshal(*provChgNotif)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertProvChgUuMbmsSubscriptionToJson(provChgSub *ProvChgUuMbmsSubscription) string {
+	jsonInfo, err := json.Marshal(*provChgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertJsonToProvChgUuMbmsSubscription(jsonInfo string) *ProvChgUuMbmsSubscription {
+	var provChgSub ProvChgUuMbmsSubscription
+	err := json.Unmarshal([]byte(jsonInfo), &provChgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &provChgSub
+}
+
+func convertProvChgUuMbmsNotificationToJson(provChgNotif *ProvChgUuMbmsNotification) string {
+	jsonInfo, err := json.Marshal(*provChgNotif)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertProvChgPc5SubscriptionToJson(provChgSub *ProvChgPc5Subscription) string {
+	jsonInfo, err := json.Marshal(*provChgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertJsonToProvChgPc5Subscription(jsonInfo string) *ProvChgPc5Subscription {
+	var provChgSub ProvChgPc5Subscription
+	err := json.Unmarshal([]byte(jsonInfo), &provChgSub)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &provChgSub
+}
+
+func convertProvChgPc5NotificationToJson(provChgNotif *ProvChgPc5Notification) string {
+	jsonInfo, err := json.Marshal(*provChgNotif)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertTestNotificationToJson(testNotif *TestNotification) string {
+	jsonInfo, err := json.Marshal(*testNotif)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
+
+func convertSubscriptionLinkListToJson(subLinkList *SubscriptionLinkList) string {
+	jsonInfo, err := json.Marshal(*subLinkList)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonInfo)
+}
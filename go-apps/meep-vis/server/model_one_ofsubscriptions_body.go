@@ -24,4 +24,6 @@
 package server
 
 type OneOfsubscriptionsBody struct {
+	/* Discriminator */
+	SubscriptionType string `json:"subscriptionType"`
 }
@@ -28,5 +28,5 @@ type Pc5NeighbourCellInfo struct {
 
 	Plmn *Plmn `json:"plmn"`
 
-	SiV2xConfig *SystemInformationBlockType21 `json:"siV2xConfig"`
+	SiV2xConfig *SystemInformationBlockType21 `json:"siV2xConfig,omitempty"`
 }
@@ -26,7 +26,7 @@ package server
 // The provisioning information per location as defined below.
 type Pc5ProvisioningInfoProInfoPc5 struct {
 	// For sidelink communication, the Destination Layer-2 ID is set to the ProSe Layer-2 Group ID or Prose UE ID, see ETSI TS 136 321 [i.12]. PLMN operators coordinate to make sure Destination Layer2 ID(s) for different V2X services are configured in a consistent manner.
-	DstLayer2Id string `json:"dstLayer2Id"`
+	DstLayer2Id string `json:"dstLayer2Id,omitempty"`
 
 	LocationInfo *LocationInfo `json:"locationInfo"`
 	// The information of the neighbour cells in a visiting PLMN that support V2X communication over PC5.
@@ -23,42 +23,9 @@
  */
 package server
 
-import (
-	"net/http"
-)
-
-func IndividualSubscriptionDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func IndividualSubscriptionGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func IndividualSubscriptionPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func ProvInfoGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func ProvInfoUuMbmsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func ProvInfoUuUnicastGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func SubGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func SubPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func V2xMessagePOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+type Polygon struct {
+	// Must be Polygon
+	Type_ string `json:"type"`
+	// For a Polygon, coordinates is an array of linear rings; the first ring is the exterior boundary and a position is an array of two decimal numbers (longitude and latitude precisely in that order)
+	Coordinates [][][]float32 `json:"coordinates"`
 }
@@ -30,7 +30,7 @@ type UuMbmsNeighbourCellInfo struct {
 	// Supported MBMS Service Area Identities in the cell.
 	MbmsServiceAreaIdentity []string `json:"mbmsServiceAreaIdentity"`
 	// Physical Cell Identifier.
-	Pci int32 `json:"pci"`
+	Pci *int32 `json:"pci,omitempty"`
 
 	Plmn *Plmn `json:"plmn"`
 
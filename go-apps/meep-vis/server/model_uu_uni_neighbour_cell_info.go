@@ -28,7 +28,7 @@ type UuUniNeighbourCellInfo struct {
 
 	FddInfo *FddInfo `json:"fddInfo"`
 	// Physical Cell Identifier.
-	Pci int32 `json:"pci"`
+	Pci *int32 `json:"pci,omitempty"`
 
 	Plmn *Plmn `json:"plmn"`
 
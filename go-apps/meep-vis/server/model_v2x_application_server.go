@@ -26,5 +26,5 @@ package server
 type V2xApplicationServer struct {
 	IpAddress string `json:"ipAddress"`
 
-	UdpPort string `json:"udpPort"`
+	UdpPort string `json:"udpPort,omitempty"`
 }
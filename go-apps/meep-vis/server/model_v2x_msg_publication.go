@@ -24,6 +24,7 @@
 package server
 
 type V2xMsgPublication struct {
+	LocationInfo *LocationInfo `json:"locationInfo,omitempty"`
 	// Published V2X message content. Its format is defined by the standardization organization indicated by the attribute stdOrganization.
 	MsgContent string `json:"msgContent"`
 	// The encode format of the V2X message, for example base64.
@@ -24,7 +24,7 @@
 package server
 
 type V2xServerUsd struct {
-	SdpInfo *V2xServerUsdSdpInfo `json:"sdpInfo"`
+	SdpInfo *V2xServerUsdSdpInfo `json:"sdpInfo,omitempty"`
 	// A list of service area identifier for the applicable MBMS broadcast area.
 	ServiceAreaIdentifier []string `json:"serviceAreaIdentifier"`
 
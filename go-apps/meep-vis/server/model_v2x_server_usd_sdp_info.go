@@ -25,7 +25,7 @@ package server
 
 // SDP with IP multicast address and port number used for V2X communication via MBMS.
 type V2xServerUsdSdpInfo struct {
-	IpMulticastAddress string `json:"ipMulticastAddress"`
+	IpMulticastAddress string `json:"ipMulticastAddress,omitempty"`
 
-	PortNumber string `json:"portNumber"`
+	PortNumber string `json:"portNumber,omitempty"`
 }
@@ -26,7 +26,7 @@ package server
 // Temporary Mobile Group Identity (TMGI), which is used within MBMS to uniquely identify Multicast and Broadcast bearer services.
 type V2xServerUsdTmgi struct {
 	// MBMS Service ID consisting of three octets.
-	MbmsServiceId string `json:"mbmsServiceId"`
+	MbmsServiceId string `json:"mbmsServiceId,omitempty"`
 	// The Mobile Country Code part of PLMN Identity.
 	Mcc string `json:"mcc"`
 	// The Mobile Network Code part of PLMN Identity.
@@ -163,4 +163,39 @@ var routes = Routes{
 		"/vis/v2/provide_predicted_qos",
 		PredictedQosPOST,
 	},
+
+	Route{
+		"TrafficLoadModelGET",
+		strings.ToUpper("Get"),
+		"/vis/v2/traffic_load_model",
+		TrafficLoadModelGET,
+	},
+
+	Route{
+		"TrafficLoadModelPUT",
+		strings.ToUpper("Put"),
+		"/vis/v2/traffic_load_model",
+		TrafficLoadModelPUT,
+	},
+
+	Route{
+		"TrafficLoadCellGET",
+		strings.ToUpper("Get"),
+		"/vis/v2/traffic_load_model/cells/{cellName}",
+		TrafficLoadCellGET,
+	},
+
+	Route{
+		"TrafficLoadCellPUT",
+		strings.ToUpper("Put"),
+		"/vis/v2/traffic_load_model/cells/{cellName}",
+		TrafficLoadCellPUT,
+	},
+
+	Route{
+		"TrafficLoadCellDELETE",
+		strings.ToUpper("Delete"),
+		"/vis/v2/traffic_load_model/cells/{cellName}",
+		TrafficLoadCellDELETE,
+	},
 }
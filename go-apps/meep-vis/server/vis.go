@@ -21,23 +21,30 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sbi "github.com/InterDigitalInc/AdvantEDGE/go-apps/meep-vis/sbi"
 	asc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-app-support-client"
 	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	gisClient "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-gis-engine-client"
 	httpLog "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-http-logger"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 	scc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
 	smc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-service-mgmt-client"
 	sm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-subscriptions"
+
+	"github.com/gorilla/mux"
 )
 
 const moduleName = "meep-vis"
@@ -52,6 +59,39 @@ const defaultConsumedLocalOnly = true
 const defaultPredictionModelSupported = false
 const appTerminationPath = "notifications/mec011/appTermination"
 
+const (
+	PROV_CHG_UU_UNI_SUBSCRIPTION  = "ProvChgUuUniSubscription"
+	PROV_CHG_UU_MBMS_SUBSCRIPTION = "ProvChgUuMbmsSubscription"
+	PROV_CHG_PC5_SUBSCRIPTION     = "ProvChgPc5Subscription"
+	V2X_MSG_SUBSCRIPTION          = "V2xMsgSubscription"
+)
+const (
+	PROV_CHG_UU_UNI_NOTIFICATION  = "ProvChgUuUniNotification"
+	PROV_CHG_UU_MBMS_NOTIFICATION = "ProvChgUuMbmsNotification"
+	PROV_CHG_PC5_NOTIFICATION     = "ProvChgPc5Notification"
+	V2X_MSG_NOTIFICATION          = "V2xMsgNotification"
+	TEST_NOTIFICATION             = "TestNotification"
+)
+
+const STD_ORGANIZATION_ETSI = "ETSI"
+
+// V2X message type names (ETSI TS 102 894-2)
+var msgTypeNames = map[MsgType]string{
+	DENM:              "denm",
+	CAM:               "cam",
+	POI:               "poi",
+	SPATEM:            "spatem",
+	MAPEM:             "mapem",
+	IVIM:              "ivim",
+	EV_RSR:            "ev-rsr",
+	TISTPGTRANSACTION: "tistpgtransaction",
+	SREM:              "srem",
+	SSEM:              "ssem",
+	EVCSN:             "evcsn",
+	SAEM:              "saem",
+	RTCMEM:            "rtcmem",
+}
+
 var redisAddr string = "meep-redis-master.default.svc.cluster.local:6379"
 var influxAddr string = "http://meep-influxdb.default.svc.cluster.local:8086"
 var sbxCtrlUrl string = "http://meep-sandbox-ctrl"
@@ -93,6 +133,14 @@ var sbxCtrlClient *scc.APIClient
 
 var registrationTicker *time.Ticker
 var subMgr *sm.SubscriptionMgr = nil
+var visRouter *mux.Router
+var mutex sync.Mutex
+
+var v2xAppServerIpAddress string
+var v2xAppServerUdpPort string
+
+// Provisioning information last notified to each provisioning information change subscription
+var provChgInfoMap = map[string]string{}
 
 func notImplemented(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
@@ -337,6 +385,21 @@ func Init() (err error) {
 	}
 	log.Info("MEEP_LOCALITY: ", locality)
 
+	// Get V2X application server address; defaults to VIS host with no UDP port
+	v2xAppServerIpAddress = hostUrl.Hostname()
+	v2xAppServerUdpPort = ""
+	v2xAppServerEnv := strings.TrimSpace(os.Getenv("MEEP_V2X_APP_SERVER"))
+	if v2xAppServerEnv != "" {
+		host, port, err := net.SplitHostPort(v2xAppServerEnv)
+		if err != nil {
+			v2xAppServerIpAddress = v2xAppServerEnv
+		} else {
+			v2xAppServerIpAddress = host
+			v2xAppServerUdpPort = port
+		}
+	}
+	log.Info("MEEP_V2X_APP_SERVER: ", v2xAppServerIpAddress, ":", v2xAppServerUdpPort)
+
 	// Set base path
 	if mepName == defaultMepName {
 		basePath = "/" + sandboxName + "/" + visBasePath
@@ -356,6 +419,26 @@ func Init() (err error) {
 	_ = rc.DBFlush(baseKey)
 	log.Info("Connected to Redis DB, V2XI service table")
 
+	// Create Subscription Manager
+	subMgrCfg := &sm.SubscriptionMgrCfg{
+		Module:         moduleName,
+		Sandbox:        sandboxName,
+		Mep:            mepName,
+		Service:        serviceName,
+		Basekey:        baseKey,
+		MetricsEnabled: true,
+		ExpiredSubCb:   ExpiredSubscriptionCb,
+		PeriodicSubCb:  nil,
+		TestNotifCb:    TestNotificationCb,
+		NewWsCb:        NewWebsocketCb,
+	}
+	subMgr, err = sm.NewSubscriptionMgr(subMgrCfg, redisAddr)
+	if err != nil {
+		log.Error("Failed to create Subscription Manager. Error: ", err)
+		return err
+	}
+	log.Info("Created Subscription Manager")
+
 	gisAppClientCfg := gisClient.NewConfiguration()
 	gisAppClientCfg.BasePath = gisAppClientUrl + "/gis/v1"
 
@@ -368,14 +451,15 @@ func Init() (err error) {
 
 	// Initialize SBI
 	sbiCfg := sbi.SbiCfg{
-		ModuleName:     moduleName,
-		SandboxName:    sandboxName,
-		RedisAddr:      redisAddr,
-		PostgisHost:    postgisHost,
-		PostgisPort:    postgisPort,
-		Locality:       locality,
-		ScenarioNameCb: updateStoreName,
-		CleanUpCb:      cleanUp,
+		ModuleName:      moduleName,
+		SandboxName:     sandboxName,
+		RedisAddr:       redisAddr,
+		PostgisHost:     postgisHost,
+		PostgisPort:     postgisPort,
+		Locality:        locality,
+		ScenarioNameCb:  updateStoreName,
+		CleanUpCb:       cleanUp,
+		PoaInfoUpdateCb: poaInfoUpdateCb,
 	}
 	if mepName != defaultMepName {
 		sbiCfg.MepName = mepName
@@ -439,6 +523,11 @@ func Stop() (err error) {
 	return sbi.Stop()
 }
 
+// SetRouter - Store router in server
+func SetRouter(router *mux.Router) {
+	visRouter = router
+}
+
 func startRegistrationTicker() {
 	// Make sure ticker is not running
 	if registrationTicker != nil {
@@ -534,6 +623,9 @@ func cleanUp() {
 
 	// Flush all service data
 	rc.DBFlush(baseKey)
+	mutex.Lock()
+	provChgInfoMap = map[string]string{}
+	mutex.Unlock()
 
 	// Reset metrics store name
 	updateStoreName("")
@@ -595,6 +687,11 @@ func predictedQosPost(w http.ResponseWriter, r *http.Request) {
 
 	responseData := requestData // Both request and response have same data model
 
+	// Refresh POA UE counts used to predict traffic load
+	if predictionModelSupported {
+		sbi.UpdatePoaUeCounts()
+	}
+
 	for i, route := range requestData.Routes {
 		if route.RouteInfo == nil {
 			log.Error("Mandatory routeInfo parameter not present in routes")
@@ -665,7 +762,11 @@ func predictedQosPost(w http.ResponseWriter, r *http.Request) {
 				rsrq := currGeoCoordinate.Rsrq
 				poaName := currGeoCoordinate.PoaName
 				estTimeHour := int32(time.Unix(int64(routeInfo.Time.Seconds), int64(routeInfo.Time.NanoSeconds)).Hour())
-				currGeoCoordinate.Rsrp, currGeoCoordinate.Rsrq, _ = sbi.GetPredictedPowerValues(estTimeHour, rsrp, rsrq, poaName)
+				predictedRsrp, predictedRsrq, err := sbi.GetPredictedPowerValues(estTimeHour, rsrp, rsrq, poaName)
+				if err == nil {
+					currGeoCoordinate.Rsrp = predictedRsrp
+					currGeoCoordinate.Rsrq = predictedRsrq
+				}
 			}
 			latCheck := routeInfo.Location.GeoArea.Latitude == currGeoCoordinate.Latitude
 			longCheck := routeInfo.Location.GeoArea.Longitude == currGeoCoordinate.Longitude
@@ -682,6 +783,1706 @@ func predictedQosPost(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, jsonResponse)
 }
 
+func trafficLoadModelGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	if !predictionModelSupported {
+		log.Error("Traffic load model is not supported for this scenario")
+		errHandlerProblemDetails(w, "Traffic load model is not supported for this scenario", http.StatusBadRequest)
+		return
+	}
+
+	ueLoad, cells, err := sbi.GetTrafficLoadModel()
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var model TrafficLoadModel
+	model.UeLoad = ueLoad
+	for _, cellSbi := range cells {
+		cell, err := convertTrafficLoadCellSbiToCell(&cellSbi)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		model.Cells = append(model.Cells, *cell)
+	}
+
+	jsonResponse, err := json.Marshal(model)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func trafficLoadModelPut(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var model TrafficLoadModel
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&model)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Make sure scenario is running
+	if currentStoreName == "" {
+		log.Error("Scenario not deployed")
+		errHandlerProblemDetails(w, "Scenario not deployed.", http.StatusBadRequest)
+		return
+	}
+	if !predictionModelSupported {
+		log.Error("Traffic load model is not supported for this scenario")
+		errHandlerProblemDetails(w, "Traffic load model is not supported for this scenario", http.StatusBadRequest)
+		return
+	}
+
+	// Validate traffic load model
+	var dmModel dataModel.TrafficLoadModel
+	dmModel.UeLoad = model.UeLoad
+	for i := range model.Cells {
+		dmModel.Cells = append(dmModel.Cells, convertTrafficLoadCellToDataModel(&model.Cells[i]))
+	}
+	err = mod.ValidateTrafficLoadModel(&dmModel)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Replace traffic load model
+	var cells []sbi.TrafficLoadCellSbi
+	for i := range model.Cells {
+		cellSbi, err := convertTrafficLoadCellToSbi(&model.Cells[i])
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		cells = append(cells, *cellSbi)
+	}
+	err = sbi.SetTrafficLoadModel(model.UeLoad, cells)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(model)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func trafficLoadCellGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	cellName := vars["cellName"]
+
+	if !predictionModelSupported {
+		log.Error("Traffic load model is not supported for this scenario")
+		errHandlerProblemDetails(w, "Traffic load model is not supported for this scenario", http.StatusBadRequest)
+		return
+	}
+
+	cellSbi, found, err := sbi.GetTrafficLoadCell(cellName)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		log.Error("Traffic load cell not found: ", cellName)
+		errHandlerProblemDetails(w, "Traffic load cell not found: "+cellName, http.StatusNotFound)
+		return
+	}
+
+	cell, err := convertTrafficLoadCellSbiToCell(&cellSbi)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	jsonResponse, err := json.Marshal(cell)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func trafficLoadCellPut(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	cellName := vars["cellName"]
+
+	var cell TrafficLoadCell
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&cell)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Make sure scenario is running
+	if currentStoreName == "" {
+		log.Error("Scenario not deployed")
+		errHandlerProblemDetails(w, "Scenario not deployed.", http.StatusBadRequest)
+		return
+	}
+	if !predictionModelSupported {
+		log.Error("Traffic load model is not supported for this scenario")
+		errHandlerProblemDetails(w, "Traffic load model is not supported for this scenario", http.StatusBadRequest)
+		return
+	}
+
+	// Validate traffic load cell
+	if cell.Name != cellName {
+		log.Error("Cell name mismatch: ", cell.Name, " != ", cellName)
+		errHandlerProblemDetails(w, "Body content not matching parameter", http.StatusBadRequest)
+		return
+	}
+	dmCell := convertTrafficLoadCellToDataModel(&cell)
+	err = mod.ValidateTrafficLoadCell(&dmCell)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Create or replace traffic load cell
+	cellSbi, err := convertTrafficLoadCellToSbi(&cell)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = sbi.SetTrafficLoadCell(*cellSbi)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(cell)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func trafficLoadCellDelete(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	cellName := vars["cellName"]
+
+	if !predictionModelSupported {
+		log.Error("Traffic load model is not supported for this scenario")
+		errHandlerProblemDetails(w, "Traffic load model is not supported for this scenario", http.StatusBadRequest)
+		return
+	}
+
+	found, err := sbi.DeleteTrafficLoadCell(cellName)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		log.Error("Traffic load cell not found: ", cellName)
+		errHandlerProblemDetails(w, "Traffic load cell not found: "+cellName, http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// convertTrafficLoadCellToDataModel - Convert traffic load cell to scenario data model for validation
+func convertTrafficLoadCellToDataModel(cell *TrafficLoadCell) dataModel.TrafficLoadCell {
+	dmCell := dataModel.TrafficLoadCell{
+		Name:  cell.Name,
+		Loads: cell.Loads,
+	}
+	if cell.Area != nil {
+		dmCell.Area = &dataModel.Polygon{
+			Type_:       cell.Area.Type_,
+			Coordinates: cell.Area.Coordinates,
+		}
+	}
+	return dmCell
+}
+
+// convertTrafficLoadCellToSbi - Convert traffic load cell to SBI format with a GeoJSON area
+func convertTrafficLoadCellToSbi(cell *TrafficLoadCell) (*sbi.TrafficLoadCellSbi, error) {
+	area, err := json.Marshal(cell.Area)
+	if err != nil {
+		return nil, err
+	}
+	return &sbi.TrafficLoadCellSbi{
+		Name:  cell.Name,
+		Area:  string(area),
+		Loads: cell.Loads,
+	}, nil
+}
+
+// convertTrafficLoadCellSbiToCell - Convert SBI traffic load cell to API format
+func convertTrafficLoadCellSbiToCell(cellSbi *sbi.TrafficLoadCellSbi) (*TrafficLoadCell, error) {
+	area := new(Polygon)
+	err := json.Unmarshal([]byte(cellSbi.Area), area)
+	if err != nil {
+		return nil, err
+	}
+	return &TrafficLoadCell{
+		Name:  cellSbi.Name,
+		Area:  area,
+		Loads: cellSbi.Loads,
+	}, nil
+}
+
+func provInfoUuUnicastGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Get requested locations & their serving POA
+	locations, poaNames, err := getQueryLocations(r)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Build provisioning information for locations served by a cellular POA
+	var provInfo UuUnicastProvisioningInfo
+	for i := range locations {
+		proInfo := getUuUnicastProvInfo(&locations[i], poaNames[i])
+		if proInfo != nil {
+			provInfo.ProInfoUuUnicast = append(provInfo.ProInfoUuUnicast, *proInfo)
+		}
+	}
+	if len(provInfo.ProInfoUuUnicast) == 0 {
+		log.Error("No Uu unicast provisioning information for requested locations")
+		errHandlerProblemDetails(w, "No Uu unicast provisioning information for requested locations", http.StatusNotFound)
+		return
+	}
+	provInfo.TimeStamp = getTimeStamp()
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertUuUnicastProvisioningInfoToJson(&provInfo))
+}
+
+func provInfoUuMbmsGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Get requested locations & their serving POA
+	locations, poaNames, err := getQueryLocations(r)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Build provisioning information for locations served by a cellular POA
+	var provInfo UuMbmsProvisioningInfo
+	for i := range locations {
+		proInfo := getUuMbmsProvInfo(&locations[i], poaNames[i])
+		if proInfo != nil {
+			provInfo.ProInfoUuMbms = append(provInfo.ProInfoUuMbms, *proInfo)
+		}
+	}
+	if len(provInfo.ProInfoUuMbms) == 0 {
+		log.Error("No Uu MBMS provisioning information for requested locations")
+		errHandlerProblemDetails(w, "No Uu MBMS provisioning information for requested locations", http.StatusNotFound)
+		return
+	}
+	provInfo.TimeStamp = getTimeStamp()
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertUuMbmsProvisioningInfoToJson(&provInfo))
+}
+
+func provInfoPc5Get(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Get requested locations & their serving POA
+	locations, poaNames, err := getQueryLocations(r)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Build provisioning information for locations served by a cellular POA
+	var provInfo Pc5ProvisioningInfo
+	for i := range locations {
+		proInfo := getPc5ProvInfo(&locations[i], poaNames[i])
+		if proInfo != nil {
+			provInfo.ProInfoPc5 = append(provInfo.ProInfoPc5, *proInfo)
+		}
+	}
+	if len(provInfo.ProInfoPc5) == 0 {
+		log.Error("No PC5 provisioning information for requested locations")
+		errHandlerProblemDetails(w, "No PC5 provisioning information for requested locations", http.StatusNotFound)
+		return
+	}
+	provInfo.TimeStamp = getTimeStamp()
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertPc5ProvisioningInfoToJson(&provInfo))
+}
+
+// getQueryLocations - Parse location_info query parameter & resolve the POA serving each location
+// Supported formats are "ecgi,<ecgi>[,<ecgi>...]" where each ECGI is the concatenation of MCC, MNC & cell ID,
+// and "latitude,<latitude>[,<latitude>...],longitude,<longitude>[,<longitude>...]"
+// Unknown ECGIs are ignored; POA name is empty for locations not served by a cellular POA
+func getQueryLocations(r *http.Request) (locations []LocationInfo, poaNames []string, err error) {
+	// Validate query params
+	u, _ := url.Parse(r.URL.String())
+	q := u.Query()
+	if !validateQueryParams(q, []string{"location_info"}) {
+		return nil, nil, errors.New("Invalid query parameters")
+	}
+	locationInfo := q.Get("location_info")
+	if locationInfo == "" {
+		return nil, nil, errors.New("Mandatory query parameter location_info is missing")
+	}
+	params := strings.Split(locationInfo, ",")
+
+	switch params[0] {
+	case "ecgi":
+		if len(params) < 2 {
+			return nil, nil, errors.New("Missing ECGI in location_info")
+		}
+		poaInfoList := sbi.GetPoaInfoList()
+		for _, ecgi := range params[1:] {
+			for _, poaInfo := range poaInfoList {
+				if poaInfo.Mcc+poaInfo.Mnc+poaInfo.CellId == ecgi {
+					locations = append(locations, LocationInfo{Ecgi: getPoaEcgi(&poaInfo)})
+					poaNames = append(poaNames, poaInfo.Name)
+					break
+				}
+			}
+		}
+
+	case "latitude":
+		// Split latitude & longitude lists
+		var latitudes []string
+		var longitudes []string
+		for i, param := range params[1:] {
+			if param == "longitude" {
+				latitudes = params[1 : i+1]
+				longitudes = params[i+2:]
+				break
+			}
+		}
+		if len(latitudes) == 0 || len(latitudes) != len(longitudes) {
+			return nil, nil, errors.New("Invalid latitude & longitude lists in location_info")
+		}
+		var geocoordinatesList gisClient.GeoCoordinateList
+		for i := range latitudes {
+			latitude, err := strconv.ParseFloat(latitudes[i], 32)
+			if err != nil || latitude < -90 || latitude > 90 {
+				return nil, nil, errors.New("Invalid latitude in location_info: " + latitudes[i])
+			}
+			longitude, err := strconv.ParseFloat(longitudes[i], 32)
+			if err != nil || longitude < -180 || longitude > 180 {
+				return nil, nil, errors.New("Invalid longitude in location_info: " + longitudes[i])
+			}
+			locations = append(locations, LocationInfo{
+				GeoArea: &LocationInfoGeoArea{
+					Latitude:  float32(latitude),
+					Longitude: float32(longitude),
+				},
+			})
+			geocoordinatesList.GeoCoordinates = append(geocoordinatesList.GeoCoordinates, gisClient.GeoCoordinate{
+				Latitude:  float32(latitude),
+				Longitude: float32(longitude),
+			})
+		}
+
+		// Get POA with best signal at each geo-coordinate
+		poaNames = make([]string, len(locations))
+		powerResp, _, err := gisAppClient.GeospatialDataApi.GetGeoDataPowerValues(context.TODO(), geocoordinatesList)
+		if err != nil {
+			log.Error("Failed to communicate with gis engine: ", err)
+		} else if len(powerResp.CoordinatesPower) == len(locations) {
+			for i, coordinatePower := range powerResp.CoordinatesPower {
+				poaNames[i] = coordinatePower.PoaName
+			}
+		}
+
+	default:
+		return nil, nil, errors.New("Invalid location_info: " + locationInfo)
+	}
+	return locations, poaNames, nil
+}
+
+func getUuUnicastProvInfo(location *LocationInfo, poaName string) *UuUnicastProvisioningInfoProInfoUuUnicast {
+	poaInfo, found := sbi.GetPoaInfo(poaName)
+	if !found {
+		return nil
+	}
+	proInfo := &UuUnicastProvisioningInfoProInfoUuUnicast{
+		LocationInfo: location,
+		V2xApplicationServer: &V2xApplicationServer{
+			IpAddress: v2xAppServerIpAddress,
+			UdpPort:   v2xAppServerUdpPort,
+		},
+	}
+	for _, neighbour := range poaInfo.Neighbours {
+		if neighbourInfo, found := sbi.GetPoaInfo(neighbour); found {
+			proInfo.NeighbourCellInfo = append(proInfo.NeighbourCellInfo, UuUniNeighbourCellInfo{
+				Ecgi: getPoaEcgi(&neighbourInfo),
+				Plmn: getPoaPlmn(&neighbourInfo),
+			})
+		}
+	}
+	return proInfo
+}
+
+func getUuMbmsProvInfo(location *LocationInfo, poaName string) *UuMbmsProvisioningInfoProInfoUuMbms {
+	poaInfo, found := sbi.GetPoaInfo(poaName)
+	if !found {
+		return nil
+	}
+
+	// MBMS service area is the zone of the serving POA
+	// Multicast address & MBMS service ID are not provided by the scenario
+	proInfo := &UuMbmsProvisioningInfoProInfoUuMbms{
+		LocationInfo: location,
+		V2xServerUsd: &V2xServerUsd{
+			ServiceAreaIdentifier: []string{poaInfo.Zone},
+			Tmgi: &V2xServerUsdTmgi{
+				Mcc: poaInfo.Mcc,
+				Mnc: poaInfo.Mnc,
+			},
+		},
+	}
+	if v2xAppServerUdpPort != "" {
+		proInfo.V2xServerUsd.SdpInfo = &V2xServerUsdSdpInfo{
+			PortNumber: v2xAppServerUdpPort,
+		}
+	}
+	for _, neighbour := range poaInfo.Neighbours {
+		if neighbourInfo, found := sbi.GetPoaInfo(neighbour); found {
+			proInfo.NeighbourCellInfo = append(proInfo.NeighbourCellInfo, UuMbmsNeighbourCellInfo{
+				Ecgi:                    getPoaEcgi(&neighbourInfo),
+				MbmsServiceAreaIdentity: []string{neighbourInfo.Zone},
+				Plmn:                    getPoaPlmn(&neighbourInfo),
+			})
+		}
+	}
+	return proInfo
+}
+
+func getPc5ProvInfo(location *LocationInfo, poaName string) *Pc5ProvisioningInfoProInfoPc5 {
+	poaInfo, found := sbi.GetPoaInfo(poaName)
+	if !found {
+		return nil
+	}
+	// Destination layer-2 ID is not provided by the scenario
+	proInfo := &Pc5ProvisioningInfoProInfoPc5{
+		LocationInfo: location,
+	}
+	for _, neighbour := range poaInfo.Neighbours {
+		if neighbourInfo, found := sbi.GetPoaInfo(neighbour); found {
+			proInfo.NeighbourCellInfo = append(proInfo.NeighbourCellInfo, Pc5NeighbourCellInfo{
+				Ecgi: getPoaEcgi(&neighbourInfo),
+				Plmn: getPoaPlmn(&neighbourInfo),
+			})
+		}
+	}
+	return proInfo
+}
+
+func getPoaEcgi(poaInfo *sbi.PoaInfoSbi) *Ecgi {
+	return &Ecgi{
+		CellId: &CellId{CellId: poaInfo.CellId},
+		Plmn:   getPoaPlmn(poaInfo),
+	}
+}
+
+func getPoaPlmn(poaInfo *sbi.PoaInfoSbi) *Plmn {
+	return &Plmn{Mcc: poaInfo.Mcc, Mnc: poaInfo.Mnc}
+}
+
+func getTimeStamp() *TimeStamp {
+	now := time.Now()
+	return &TimeStamp{
+		Seconds:     int32(now.Unix()),
+		NanoSeconds: int32(now.Nanosecond()),
+	}
+}
+
+func v2xMessagePost(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	var v2xMsgPublication V2xMsgPublication
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&v2xMsgPublication)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate message
+	err = validateV2xMsgPublication(&v2xMsgPublication)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Resolve POA serving the publisher location
+	poaNameCache := make(map[string]string)
+	msgPoaName := getLocationPoaName(v2xMsgPublication.LocationInfo, poaNameCache)
+
+	// Send notification to matching subscribers
+	subList, err := subMgr.GetFilteredSubscriptions(instanceId, V2X_MSG_SUBSCRIPTION)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	getPoaName := func(location *LocationInfo) string {
+		return getLocationPoaName(location, poaNameCache)
+	}
+	for _, sub := range subList {
+		sendV2xMsgNotification(sub, &v2xMsgPublication, msgPoaName, getPoaName)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func sendV2xMsgNotification(sub *sm.Subscription, msg *V2xMsgPublication, msgPoaName string, getPoaName func(*LocationInfo) string) {
+	// Make sure subscription is ready to send
+	if !subMgr.ReadyToSend(sub) {
+		return
+	}
+
+	// Get original subscription
+	subOrig := convertJsonToV2xMsgSubscription(sub.JsonSubOrig)
+	if subOrig == nil {
+		log.Error("Failed to get original V2X message subscription")
+		return
+	}
+
+	// Apply filter criteria
+	if !isV2xMsgFilterMatch(subOrig.FilterCriteria, msg, msgPoaName, getPoaName) {
+		return
+	}
+
+	// Prepare notification
+	notif := V2xMsgNotification{
+		Links: &V2xMsgNotificationLinks{
+			Subscription: &LinkType{
+				Href: sub.Cfg.Self,
+			},
+		},
+		LocationInfo:     msg.LocationInfo,
+		MsgContent:       msg.MsgContent,
+		MsgEncodeFormat:  msg.MsgEncodeFormat,
+		MsgType:          msg.MsgType,
+		NotificationType: V2X_MSG_NOTIFICATION,
+		StdOrganization:  msg.StdOrganization,
+		TimeStamp:        getTimeStamp(),
+	}
+
+	log.Info("Sending V2X message notification for sub: ", sub.Cfg.Id)
+	go func() {
+		_ = subMgr.SendNotification(sub, []byte(convertV2xMsgNotificationToJson(&notif)))
+	}()
+}
+
+// poaInfoUpdateCb - Notify provisioning information change subscribers when scenario POA information changes
+func poaInfoUpdateCb() {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if subMgr == nil {
+		return
+	}
+	subList, err := subMgr.GetFilteredSubscriptions(instanceId, "")
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	poaNameCache := make(map[string]string)
+	for _, sub := range subList {
+		switch sub.Cfg.Type {
+		case PROV_CHG_UU_UNI_SUBSCRIPTION, PROV_CHG_UU_MBMS_SUBSCRIPTION, PROV_CHG_PC5_SUBSCRIPTION:
+			sendProvChgNotification(sub, poaNameCache)
+		}
+	}
+}
+
+// sendProvChgNotification - Send notification if provisioning information for the subscription location changed
+func sendProvChgNotification(sub *sm.Subscription, poaNameCache map[string]string) {
+	// Make sure subscription is ready to send
+	if !subMgr.ReadyToSend(sub) {
+		return
+	}
+
+	// Only notify provisioning information changes
+	jsonInfo, jsonNotif := getProvChgNotification(sub, poaNameCache)
+	if jsonInfo == provChgInfoMap[sub.Cfg.Id] {
+		return
+	}
+	provChgInfoMap[sub.Cfg.Id] = jsonInfo
+
+	// No notification when location is no longer served by a cellular POA
+	if jsonNotif == "" {
+		return
+	}
+
+	log.Info("Sending provisioning information change notification for sub: ", sub.Cfg.Id)
+	go func() {
+		_ = subMgr.SendNotification(sub, []byte(jsonNotif))
+	}()
+}
+
+// getProvChgNotification - Get current provisioning information for the subscription location
+// Returns the notification with & without timestamp; empty strings if location is not served by a cellular POA
+func getProvChgNotification(sub *sm.Subscription, poaNameCache map[string]string) (jsonInfo string, jsonNotif string) {
+	switch sub.Cfg.Type {
+	case PROV_CHG_UU_UNI_SUBSCRIPTION:
+		subOrig := convertJsonToProvChgUuUniSubscription(sub.JsonSubOrig)
+		if subOrig == nil || subOrig.FilterCriteria == nil {
+			return "", ""
+		}
+		location := subOrig.FilterCriteria.LocationInfo
+		proInfo := getUuUnicastProvInfo(location, getLocationPoaName(location, poaNameCache))
+		if proInfo == nil {
+			return "", ""
+		}
+		notif := ProvChgUuUniNotification{
+			LocationInfo:         proInfo.LocationInfo,
+			NeighbourCellInfo:    proInfo.NeighbourCellInfo,
+			NotificationType:     PROV_CHG_UU_UNI_NOTIFICATION,
+			V2xApplicationServer: proInfo.V2xApplicationServer,
+		}
+		jsonInfo = convertProvChgUuUniNotificationToJson(&notif)
+		notif.TimeStamp = getTimeStamp()
+		jsonNotif = convertProvChgUuUniNotificationToJson(&notif)
+
+	case PROV_CHG_UU_MBMS_SUBSCRIPTION:
+		subOrig := convertJsonToProvChgUuMbmsSubscription(sub.JsonSubOrig)
+		if subOrig == nil || subOrig.FilterCriteria == nil {
+			return "", ""
+		}
+		location := subOrig.FilterCriteria.LocationInfo
+		proInfo := getUuMbmsProvInfo(location, getLocationPoaName(location, poaNameCache))
+		if proInfo == nil {
+			return "", ""
+		}
+		notif := ProvChgUuMbmsNotification{
+			LocationInfo:      proInfo.LocationInfo,
+			NeighbourCellInfo: proInfo.NeighbourCellInfo,
+			NotificationType:  PROV_CHG_UU_MBMS_NOTIFICATION,
+			V2xServerUsd:      proInfo.V2xServerUsd,
+		}
+		jsonInfo = convertProvChgUuMbmsNotificationToJson(&notif)
+		notif.TimeStamp = getTimeStamp()
+		jsonNotif = convertProvChgUuMbmsNotificationToJson(&notif)
+
+	case PROV_CHG_PC5_SUBSCRIPTION:
+		subOrig := convertJsonToProvChgPc5Subscription(sub.JsonSubOrig)
+		if subOrig == nil || subOrig.FilterCriteria == nil {
+			return "", ""
+		}
+		location := subOrig.FilterCriteria.LocationInfo
+		proInfo := getPc5ProvInfo(location, getLocationPoaName(location, poaNameCache))
+		if proInfo == nil {
+			return "", ""
+		}
+		notif := ProvChgPc5Notification{
+			DstLayer2Id:       proInfo.DstLayer2Id,
+			LocationInfo:      proInfo.LocationInfo,
+			NeighbourCellInfo: proInfo.NeighbourCellInfo,
+			NotificationType:  PROV_CHG_PC5_NOTIFICATION,
+		}
+		jsonInfo = convertProvChgPc5NotificationToJson(&notif)
+		notif.TimeStamp = getTimeStamp()
+		jsonNotif = convertProvChgPc5NotificationToJson(&notif)
+	}
+	return jsonInfo, jsonNotif
+}
+
+// isV2xMsgFilterMatch - Check if a published V2X message matches subscription filter criteria
+// Location filter matches if the message is published from a location served by the same POA
+func isV2xMsgFilterMatch(filter *V2xMsgSubscriptionFilterCriteria, msg *V2xMsgPublication, msgPoaName string, getPoaName func(*LocationInfo) string) bool {
+	if filter == nil {
+		return false
+	}
+
+	// Standardization organization & message type
+	if filter.StdOrganization != msg.StdOrganization {
+		return false
+	}
+	if len(filter.MsgType) > 0 {
+		found := false
+		for _, msgType := range filter.MsgType {
+			if filterMsgType, valid := parseMsgType(msgType); valid && filterMsgType == *msg.MsgType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	// Location
+	if len(filter.LocationInfo) > 0 {
+		if msgPoaName == "" {
+			return false
+		}
+		found := false
+		for i := range filter.LocationInfo {
+			if getPoaName(&filter.LocationInfo[i]) == msgPoaName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// getLocationPoaName - Get name of the POA serving the provided location; empty string if none
+// Resolved POA names are cached to limit GIS engine requests
+func getLocationPoaName(location *LocationInfo, cache map[string]string) string {
+	if location == nil {
+		return ""
+	}
+
+	// Check cache
+	key := getLocationKey(location)
+	if poaName, found := cache[key]; found {
+		return poaName
+	}
+
+	poaName := ""
+	if location.Ecgi != nil {
+		// Find POA with matching cell ID & PLMN
+		for _, poaInfo := range sbi.GetPoaInfoList() {
+			if poaInfo.CellId != location.Ecgi.CellId.CellId {
+				continue
+			}
+			if location.Ecgi.Plmn != nil && (poaInfo.Mcc != location.Ecgi.Plmn.Mcc || poaInfo.Mnc != location.Ecgi.Plmn.Mnc) {
+				continue
+			}
+			poaName = poaInfo.Name
+			break
+		}
+	} else if location.GeoArea != nil {
+		// Get POA with best signal at geo-coordinates
+		var geocoordinatesList gisClient.GeoCoordinateList
+		geocoordinatesList.GeoCoordinates = append(geocoordinatesList.GeoCoordinates, gisClient.GeoCoordinate{
+			Latitude:  location.GeoArea.Latitude,
+			Longitude: location.GeoArea.Longitude,
+		})
+		powerResp, _, err := gisAppClient.GeospatialDataApi.GetGeoDataPowerValues(context.TODO(), geocoordinatesList)
+		if err != nil {
+			log.Error("Failed to communicate with gis engine: ", err)
+			return ""
+		}
+		if len(powerResp.CoordinatesPower) == 1 {
+			poaName = powerResp.CoordinatesPower[0].PoaName
+		}
+	}
+
+	cache[key] = poaName
+	return poaName
+}
+
+func getLocationKey(location *LocationInfo) string {
+	if location.Ecgi != nil {
+		key := "ecgi:" + location.Ecgi.CellId.CellId
+		if location.Ecgi.Plmn != nil {
+			key += ":" + location.Ecgi.Plmn.Mcc + ":" + location.Ecgi.Plmn.Mnc
+		}
+		return key
+	} else if location.GeoArea != nil {
+		return fmt.Sprintf("geo:%f:%f", location.GeoArea.Latitude, location.GeoArea.Longitude)
+	}
+	return ""
+}
+
+// parseMsgType - Get V2X message type from its numeric value or name
+func parseMsgType(msgType string) (MsgType, bool) {
+	if value, err := strconv.Atoi(msgType); err == nil {
+		if _, found := msgTypeNames[MsgType(value)]; found {
+			return MsgType(value), true
+		}
+		return 0, false
+	}
+	for value, name := range msgTypeNames {
+		if strings.EqualFold(name, msgType) {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+func validateLocationInfo(location *LocationInfo) error {
+	if location.Ecgi == nil && location.GeoArea == nil {
+		return errors.New("Either ecgi or geoArea shall be present in locationInfo")
+	}
+	if location.Ecgi != nil && location.GeoArea != nil {
+		return errors.New("Only one of ecgi or geoArea shall be present in locationInfo")
+	}
+	if location.Ecgi != nil && (location.Ecgi.CellId == nil || location.Ecgi.CellId.CellId == "") {
+		return errors.New("Mandatory attribute ecgi.cellId is missing in locationInfo")
+	}
+	return nil
+}
+
+func validateV2xMsgPublication(msg *V2xMsgPublication) error {
+	if msg.StdOrganization != STD_ORGANIZATION_ETSI {
+		return errors.New("Invalid stdOrganization: " + msg.StdOrganization)
+	}
+	if msg.MsgType == nil {
+		return errors.New("Mandatory attribute msgType is missing in the request body")
+	}
+	if _, found := msgTypeNames[*msg.MsgType]; !found {
+		return errors.New("Invalid msgType: " + strconv.Itoa(int(*msg.MsgType)))
+	}
+	if msg.MsgEncodeFormat == "" {
+		return errors.New("Mandatory attribute msgEncodeFormat is missing in the request body")
+	}
+	if msg.MsgContent == "" {
+		return errors.New("Mandatory attribute msgContent is missing in the request body")
+	}
+	if msg.LocationInfo != nil {
+		err := validateLocationInfo(msg.LocationInfo)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSubscriptionNotifConfig(callbackReference string, websockNotifConfig *WebsockNotifConfig) error {
+	if callbackReference == "" && (websockNotifConfig == nil || !websockNotifConfig.RequestWebsocketUri) {
+		return errors.New("At least one of callbackReference and websockNotifConfig parameters should be present")
+	}
+	return nil
+}
+
+func validateSubscriptionLink(links *Links, subId string) error {
+	if links == nil || links.Self == nil {
+		return errors.New("Mandatory Link parameter not present")
+	}
+	selfUrl := strings.Split(links.Self.Href, "/")
+	linkSubId := selfUrl[len(selfUrl)-1]
+	if linkSubId != subId {
+		return errors.New("SubscriptionId in endpoint and in body not matching")
+	}
+	return nil
+}
+
+func validateProvChgSubscription(callbackReference string, websockNotifConfig *WebsockNotifConfig, location *LocationInfo) error {
+	err := validateSubscriptionNotifConfig(callbackReference, websockNotifConfig)
+	if err != nil {
+		return err
+	}
+	if location == nil {
+		return errors.New("Mandatory attribute filterCriteria.locationInfo is missing in the request body")
+	}
+	return validateLocationInfo(location)
+}
+
+func validateProvChgUuUniSubscription(provChgSub *ProvChgUuUniSubscription) error {
+	if provChgSub.FilterCriteria == nil {
+		return errors.New("Mandatory attribute filterCriteria is missing in the request body")
+	}
+	return validateProvChgSubscription(provChgSub.CallbackReference, provChgSub.WebsockNotifConfig, provChgSub.FilterCriteria.LocationInfo)
+}
+
+func validateProvChgUuMbmsSubscription(provChgSub *ProvChgUuMbmsSubscription) error {
+	if provChgSub.FilterCriteria == nil {
+		return errors.New("Mandatory attribute filterCriteria is missing in the request body")
+	}
+	return validateProvChgSubscription(provChgSub.CallbackReference, provChgSub.WebsockNotifConfig, provChgSub.FilterCriteria.LocationInfo)
+}
+
+func validateProvChgPc5Subscription(provChgSub *ProvChgPc5Subscription) error {
+	if provChgSub.FilterCriteria == nil {
+		return errors.New("Mandatory attribute filterCriteria is missing in the request body")
+	}
+	return validateProvChgSubscription(provChgSub.CallbackReference, provChgSub.WebsockNotifConfig, provChgSub.FilterCriteria.LocationInfo)
+}
+
+func validateV2xMsgSubscription(v2xMsgSub *V2xMsgSubscription) error {
+	err := validateSubscriptionNotifConfig(v2xMsgSub.CallbackReference, v2xMsgSub.WebsockNotifConfig)
+	if err != nil {
+		return err
+	}
+	filter := v2xMsgSub.FilterCriteria
+	if filter == nil {
+		return errors.New("Mandatory attribute filterCriteria is missing in the request body")
+	}
+	if filter.StdOrganization != STD_ORGANIZATION_ETSI {
+		return errors.New("Invalid filterCriteria.stdOrganization: " + filter.StdOrganization)
+	}
+	for _, msgType := range filter.MsgType {
+		if _, valid := parseMsgType(msgType); !valid {
+			return errors.New("Invalid filterCriteria.msgType: " + msgType)
+		}
+	}
+	for i := range filter.LocationInfo {
+		err := validateLocationInfo(&filter.LocationInfo[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func subscriptionsGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subId := vars["subscriptionId"]
+
+	// Find subscription by ID
+	sub, err := subMgr.GetSubscription(subId)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Return original marshalled subscription
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, sub.JsonSubOrig)
+}
+
+func subscriptionsPost(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Use discriminator to obtain subscription type
+	var discriminator OneOfsubscriptionsBody
+	bodyBytes, _ := ioutil.ReadAll(r.Body)
+	err := json.Unmarshal(bodyBytes, &discriminator)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	subscriptionType := discriminator.SubscriptionType
+
+	// Process subscription request
+	var jsonSub string
+
+	switch subscriptionType {
+	case V2X_MSG_SUBSCRIPTION:
+		var v2xMsgSub V2xMsgSubscription
+		err = json.Unmarshal(bodyBytes, &v2xMsgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateV2xMsgSubscription(&v2xMsgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		// Get a new subscription ID
+		subId := subMgr.GenerateSubscriptionId()
+
+		// Set resource link
+		v2xMsgSub.Links = &Links{
+			Self: &LinkType{
+				Href: hostUrl.String() + basePath + "subscriptions/" + subId,
+			},
+		}
+
+		// Create & store subscription
+		subCfg := newSubscriptionCfg(subId, V2X_MSG_SUBSCRIPTION, V2X_MSG_NOTIFICATION, v2xMsgSub.Links,
+			v2xMsgSub.CallbackReference, v2xMsgSub.ExpiryDeadline, v2xMsgSub.RequestTestNotification, v2xMsgSub.WebsockNotifConfig)
+		jsonSub = convertV2xMsgSubscriptionToJson(&v2xMsgSub)
+		sub, err := subMgr.CreateSubscription(subCfg, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateV2xMsgSubscriptionJson(&v2xMsgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Set response location header
+		w.Header().Set("Location", v2xMsgSub.Links.Self.Href)
+
+	case PROV_CHG_UU_UNI_SUBSCRIPTION:
+		var provChgSub ProvChgUuUniSubscription
+		err = json.Unmarshal(bodyBytes, &provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateProvChgUuUniSubscription(&provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		// Get a new subscription ID
+		subId := subMgr.GenerateSubscriptionId()
+
+		// Set resource link
+		provChgSub.Links = &Links{
+			Self: &LinkType{
+				Href: hostUrl.String() + basePath + "subscriptions/" + subId,
+			},
+		}
+
+		// Create & store subscription
+		subCfg := newSubscriptionCfg(subId, PROV_CHG_UU_UNI_SUBSCRIPTION, PROV_CHG_UU_UNI_NOTIFICATION, provChgSub.Links,
+			provChgSub.CallbackReference, provChgSub.ExpiryDeadline, provChgSub.RequestTestNotification, provChgSub.WebsockNotifConfig)
+		jsonSub = convertProvChgUuUniSubscriptionToJson(&provChgSub)
+		sub, err := subMgr.CreateSubscription(subCfg, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateProvChgUuUniSubscriptionJson(&provChgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Store current provisioning information
+		provChgInfoMap[subId], _ = getProvChgNotification(sub, map[string]string{})
+
+		// Set response location header
+		w.Header().Set("Location", provChgSub.Links.Self.Href)
+
+	case PROV_CHG_UU_MBMS_SUBSCRIPTION:
+		var provChgSub ProvChgUuMbmsSubscription
+		err = json.Unmarshal(bodyBytes, &provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateProvChgUuMbmsSubscription(&provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		// Get a new subscription ID
+		subId := subMgr.GenerateSubscriptionId()
+
+		// Set resource link
+		provChgSub.Links = &Links{
+			Self: &LinkType{
+				Href: hostUrl.String() + basePath + "subscriptions/" + subId,
+			},
+		}
+
+		// Create & store subscription
+		subCfg := newSubscriptionCfg(subId, PROV_CHG_UU_MBMS_SUBSCRIPTION, PROV_CHG_UU_MBMS_NOTIFICATION, provChgSub.Links,
+			provChgSub.CallbackReference, provChgSub.ExpiryDeadline, provChgSub.RequestTestNotification, provChgSub.WebsockNotifConfig)
+		jsonSub = convertProvChgUuMbmsSubscriptionToJson(&provChgSub)
+		sub, err := subMgr.CreateSubscription(subCfg, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateProvChgUuMbmsSubscriptionJson(&provChgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Store current provisioning information
+		provChgInfoMap[subId], _ = getProvChgNotification(sub, map[string]string{})
+
+		// Set response location header
+		w.Header().Set("Location", provChgSub.Links.Self.Href)
+
+	case PROV_CHG_PC5_SUBSCRIPTION:
+		var provChgSub ProvChgPc5Subscription
+		err = json.Unmarshal(bodyBytes, &provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateProvChgPc5Subscription(&provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		// Get a new subscription ID
+		subId := subMgr.GenerateSubscriptionId()
+
+		// Set resource link
+		provChgSub.Links = &Links{
+			Self: &LinkType{
+				Href: hostUrl.String() + basePath + "subscriptions/" + subId,
+			},
+		}
+
+		// Create & store subscription
+		subCfg := newSubscriptionCfg(subId, PROV_CHG_PC5_SUBSCRIPTION, PROV_CHG_PC5_NOTIFICATION, provChgSub.Links,
+			provChgSub.CallbackReference, provChgSub.ExpiryDeadline, provChgSub.RequestTestNotification, provChgSub.WebsockNotifConfig)
+		jsonSub = convertProvChgPc5SubscriptionToJson(&provChgSub)
+		sub, err := subMgr.CreateSubscription(subCfg, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateProvChgPc5SubscriptionJson(&provChgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to create subscription")
+			errHandlerProblemDetails(w, "Failed to create subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Store current provisioning information
+		provChgInfoMap[subId], _ = getProvChgNotification(sub, map[string]string{})
+
+		// Set response location header
+		w.Header().Set("Location", provChgSub.Links.Self.Href)
+	default:
+		errHandlerProblemDetails(w, "Invalid subscription type: "+subscriptionType, http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprint(w, jsonSub)
+}
+
+func subscriptionsPut(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subId := vars["subscriptionId"]
+
+	// Use discriminator to obtain subscription type
+	var discriminator OneOfsubscriptionsBody
+	bodyBytes, _ := ioutil.ReadAll(r.Body)
+	err := json.Unmarshal(bodyBytes, &discriminator)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	subscriptionType := discriminator.SubscriptionType
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Find subscription by ID
+	sub, err := subMgr.GetSubscription(subId)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if sub.Cfg.Type != subscriptionType {
+		log.Error("Subscription type does not match stored subscription")
+		errHandlerProblemDetails(w, "Subscription type does not match stored subscription", http.StatusBadRequest)
+		return
+	}
+
+	// Process subscription request
+	var jsonSub string
+
+	switch subscriptionType {
+	case V2X_MSG_SUBSCRIPTION:
+		var v2xMsgSub V2xMsgSubscription
+		err = json.Unmarshal(bodyBytes, &v2xMsgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateSubscriptionLink(v2xMsgSub.Links, subId)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = validateV2xMsgSubscription(&v2xMsgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Update subscription
+		sub.Cfg = newSubscriptionCfg(subId, V2X_MSG_SUBSCRIPTION, V2X_MSG_NOTIFICATION, v2xMsgSub.Links,
+			v2xMsgSub.CallbackReference, v2xMsgSub.ExpiryDeadline, v2xMsgSub.RequestTestNotification, v2xMsgSub.WebsockNotifConfig)
+		err = subMgr.UpdateSubscription(sub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateV2xMsgSubscriptionJson(&v2xMsgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+	case PROV_CHG_UU_UNI_SUBSCRIPTION:
+		var provChgSub ProvChgUuUniSubscription
+		err = json.Unmarshal(bodyBytes, &provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateSubscriptionLink(provChgSub.Links, subId)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = validateProvChgUuUniSubscription(&provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Update subscription
+		sub.Cfg = newSubscriptionCfg(subId, PROV_CHG_UU_UNI_SUBSCRIPTION, PROV_CHG_UU_UNI_NOTIFICATION, provChgSub.Links,
+			provChgSub.CallbackReference, provChgSub.ExpiryDeadline, provChgSub.RequestTestNotification, provChgSub.WebsockNotifConfig)
+		err = subMgr.UpdateSubscription(sub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateProvChgUuUniSubscriptionJson(&provChgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Store current provisioning information
+		provChgInfoMap[subId], _ = getProvChgNotification(sub, map[string]string{})
+
+	case PROV_CHG_UU_MBMS_SUBSCRIPTION:
+		var provChgSub ProvChgUuMbmsSubscription
+		err = json.Unmarshal(bodyBytes, &provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateSubscriptionLink(provChgSub.Links, subId)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = validateProvChgUuMbmsSubscription(&provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Update subscription
+		sub.Cfg = newSubscriptionCfg(subId, PROV_CHG_UU_MBMS_SUBSCRIPTION, PROV_CHG_UU_MBMS_NOTIFICATION, provChgSub.Links,
+			provChgSub.CallbackReference, provChgSub.ExpiryDeadline, provChgSub.RequestTestNotification, provChgSub.WebsockNotifConfig)
+		err = subMgr.UpdateSubscription(sub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateProvChgUuMbmsSubscriptionJson(&provChgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Store current provisioning information
+		provChgInfoMap[subId], _ = getProvChgNotification(sub, map[string]string{})
+
+	case PROV_CHG_PC5_SUBSCRIPTION:
+		var provChgSub ProvChgPc5Subscription
+		err = json.Unmarshal(bodyBytes, &provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Validate subscription
+		err = validateSubscriptionLink(provChgSub.Links, subId)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = validateProvChgPc5Subscription(&provChgSub)
+		if err != nil {
+			log.Error(err.Error())
+			errHandlerProblemDetails(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Update subscription
+		sub.Cfg = newSubscriptionCfg(subId, PROV_CHG_PC5_SUBSCRIPTION, PROV_CHG_PC5_NOTIFICATION, provChgSub.Links,
+			provChgSub.CallbackReference, provChgSub.ExpiryDeadline, provChgSub.RequestTestNotification, provChgSub.WebsockNotifConfig)
+		err = subMgr.UpdateSubscription(sub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Update subscription JSON based on subscription state
+		jsonSub = updateProvChgPc5SubscriptionJson(&provChgSub, sub)
+		err = subMgr.SetSubscriptionJson(sub, jsonSub)
+		if err != nil {
+			log.Error("Failed to update subscription")
+			errHandlerProblemDetails(w, "Failed to update subscription", http.StatusInternalServerError)
+			return
+		}
+
+		// Store current provisioning information
+		provChgInfoMap[subId], _ = getProvChgNotification(sub, map[string]string{})
+
+	default:
+		errHandlerProblemDetails(w, "Invalid subscription type: "+subscriptionType, http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, jsonSub)
+}
+
+func subscriptionsDelete(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subId := vars["subscriptionId"]
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Find subscription by ID
+	sub, err := subMgr.GetSubscription(subId)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Disable subscription websocket endpoint
+	if sub.Ws != nil && visRouter != nil {
+		route := visRouter.Get(sub.Cfg.Id)
+		if route != nil {
+			route.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+		}
+	}
+
+	// Delete subscription
+	err = subMgr.DeleteSubscription(sub)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	delete(provChgInfoMap, subId)
+
+	// Send response
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func subscriptionLinkListSubscriptionsGet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Validate query params
+	u, _ := url.Parse(r.URL.String())
+	q := u.Query()
+	validQueryParams := []string{"subscription_type"}
+	if !validateQueryParams(q, validQueryParams) {
+		errHandlerProblemDetails(w, "Invalid query parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Get & validate query param values
+	subType := q.Get("subscription_type")
+	if !validateQueryParamValue(subType, []string{"", "prov_chg_uu_uni", "prov_chg_uu_mbms", "prov_chg_uu_pc5", "v2x_msg"}) {
+		errHandlerProblemDetails(w, "Invalid subscription_type value: "+subType, http.StatusBadRequest)
+		return
+	}
+
+	// Find subscriptions by type
+	subscriptionType := ""
+	switch subType {
+	case "prov_chg_uu_uni":
+		subscriptionType = PROV_CHG_UU_UNI_SUBSCRIPTION
+	case "prov_chg_uu_mbms":
+		subscriptionType = PROV_CHG_UU_MBMS_SUBSCRIPTION
+	case "prov_chg_uu_pc5":
+		subscriptionType = PROV_CHG_PC5_SUBSCRIPTION
+	case "v2x_msg":
+		subscriptionType = V2X_MSG_SUBSCRIPTION
+	}
+	subList, err := subMgr.GetFilteredSubscriptions(instanceId, subscriptionType)
+	if err != nil {
+		log.Error(err.Error())
+		errHandlerProblemDetails(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Create subscription link list
+	subscriptionLinkList := &SubscriptionLinkList{
+		Links: &SubscriptionLinkListLinks{
+			Self: &LinkType{
+				Href: hostUrl.String() + basePath + "subscriptions",
+			},
+		},
+	}
+	for _, sub := range subList {
+		linkListSub := SubscriptionLinkListLinksSubscriptions{
+			Href:             sub.Cfg.Self,
+			SubscriptionType: sub.Cfg.Type,
+		}
+		subscriptionLinkList.Links.Subscriptions = append(subscriptionLinkList.Links.Subscriptions, linkListSub)
+	}
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertSubscriptionLinkListToJson(subscriptionLinkList))
+}
+
+func newSubscriptionCfg(subId string, subType string, notifType string, links *Links, callbackReference string, expiryDeadline *TimeStamp, requestTestNotif bool, websockNotifConfig *WebsockNotifConfig) *sm.SubscriptionCfg {
+	reqWsUri := false
+	if websockNotifConfig != nil {
+		reqWsUri = websockNotifConfig.RequestWebsocketUri
+	}
+	var expiryTime *time.Time
+	if expiryDeadline != nil {
+		expiry := time.Unix(int64(expiryDeadline.Seconds), 0)
+		expiryTime = &expiry
+	}
+	subCfg := &sm.SubscriptionCfg{
+		Id:                  subId,
+		AppId:               instanceId,
+		Type:                subType,
+		NotifType:           notifType,
+		Self:                links.Self.Href,
+		NotifyUrl:           callbackReference,
+		ExpiryTime:          expiryTime,
+		PeriodicInterval:    0,
+		RequestTestNotif:    requestTestNotif,
+		RequestWebsocketUri: reqWsUri,
+	}
+	return subCfg
+}
+
+func updateV2xMsgSubscriptionJson(v2xMsgSub *V2xMsgSubscription, sub *sm.Subscription) string {
+	v2xMsgSub.CallbackReference = sub.Cfg.NotifyUrl
+	v2xMsgSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+	if sub.Ws != nil {
+		v2xMsgSub.WebsockNotifConfig.WebsocketUri = sub.Ws.Uri
+	} else {
+		v2xMsgSub.WebsockNotifConfig = nil
+	}
+	return convertV2xMsgSubscriptionToJson(v2xMsgSub)
+}
+
+func updateProvChgUuUniSubscriptionJson(provChgSub *ProvChgUuUniSubscription, sub *sm.Subscription) string {
+	provChgSub.CallbackReference = sub.Cfg.NotifyUrl
+	provChgSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+	if sub.Ws != nil {
+		provChgSub.WebsockNotifConfig.WebsocketUri = sub.Ws.Uri
+	} else {
+		provChgSub.WebsockNotifConfig = nil
+	}
+	return convertProvChgUuUniSubscriptionToJson(provChgSub)
+}
+
+func updateProvChgUuMbmsSubscriptionJson(provChgSub *ProvChgUuMbmsSubscription, sub *sm.Subscription) string {
+	provChgSub.CallbackReference = sub.Cfg.NotifyUrl
+	provChgSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+	if sub.Ws != nil {
+		provChgSub.WebsockNotifConfig.WebsocketUri = sub.Ws.Uri
+	} else {
+		provChgSub.WebsockNotifConfig = nil
+	}
+	return convertProvChgUuMbmsSubscriptionToJson(provChgSub)
+}
+
+func updateProvChgPc5SubscriptionJson(provChgSub *ProvChgPc5Subscription, sub *sm.Subscription) string {
+	provChgSub.CallbackReference = sub.Cfg.NotifyUrl
+	provChgSub.RequestTestNotification = sub.Cfg.RequestTestNotif
+	if sub.Ws != nil {
+		provChgSub.WebsockNotifConfig.WebsocketUri = sub.Ws.Uri
+	} else {
+		provChgSub.WebsockNotifConfig = nil
+	}
+	return convertProvChgPc5SubscriptionToJson(provChgSub)
+}
+
+func ExpiredSubscriptionCb(sub *sm.Subscription) {
+	// MEC030 does not define an expiry notification; subscription is removed by the Subscription Manager
+	log.Info("Subscription expired: ", sub.Cfg.Id)
+
+	mutex.Lock()
+	delete(provChgInfoMap, sub.Cfg.Id)
+	mutex.Unlock()
+}
+
+func TestNotificationCb(sub *sm.Subscription) error {
+	// Build test notification
+	notif := TestNotification{
+		NotificationType: TEST_NOTIFICATION,
+		Links: &TestNotificationLinks{
+			Subscription: &LinkType{
+				Href: sub.Cfg.Self,
+			},
+		},
+	}
+
+	// Send test notification
+	log.Info("Sending Test notification for sub: ", sub.Cfg.Id)
+	return subMgr.SendNotification(sub, []byte(convertTestNotificationToJson(&notif)))
+}
+
+func NewWebsocketCb(sub *sm.Subscription) (string, error) {
+	if visRouter == nil {
+		return "", errors.New("Websocket router not set")
+	}
+
+	// Add Websocket endpoint
+	wsPath := "/" + visBasePath + sub.Ws.Endpoint
+	visRouter.HandleFunc(wsPath, sub.Ws.ConnectionHandler).Name(sub.Cfg.Id)
+	log.Info("Created websocket endpoint ", wsPath, " for subscription ", sub.Cfg.Id)
+
+	// Get Websocket URI
+	wsUrl, err := url.Parse(hostUrl.String())
+	if err != nil {
+		log.Error(err.Error())
+		return "", err
+	}
+	wsUrl.Scheme = "wss"
+	websocketUri := wsUrl.String() + basePath + sub.Ws.Endpoint
+
+	return websocketUri, nil
+}
+
+func validateQueryParams(params url.Values, validParamList []string) bool {
+	for param := range params {
+		found := false
+		for _, validParam := range validParamList {
+			if param == validParam {
+				found = true
+				break
+			}
+		}
+		if !found {
+			log.Error("Invalid query param: ", param)
+			return false
+		}
+	}
+	return true
+}
+
+func validateQueryParamValue(val string, validValues []string) bool {
+	for _, validVal := range validValues {
+		if val == validVal {
+			return true
+		}
+	}
+	log.Error("Invalid query param value: ", val)
+	return false
+}
+
 func errHandlerProblemDetails(w http.ResponseWriter, error string, code int) {
 	var pd ProblemDetails
 	pd.Detail = error
@@ -25,6 +25,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -496,55 +498,455 @@ const testScenarioName = "testScenario"
 var m *mod.Model
 var mqLocal *mq.MsgQueue
 
-func TestNotImplemented(t *testing.T) {
+func TestProvInfoGet(t *testing.T) {
 	fmt.Println("--- ", t.Name())
 	log.MeepTextLogInit(t.Name())
 
-	_, err := sendRequest(http.MethodDelete, "/subscriptions/1", nil, nil, nil, http.StatusNotImplemented, IndividualSubscriptionDELETE)
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf("Error running test basic procedure")
 	}
 
-	_, err = sendRequest(http.MethodGet, "/subscriptions/1", nil, nil, nil, http.StatusNotImplemented, IndividualSubscriptionGET)
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	expectedEcgi := Ecgi{CellId: &CellId{CellId: "2345678"}, Plmn: &Plmn{Mcc: "123", Mnc: "456"}}
+	query := map[string]string{"location_info": "ecgi,1234562345678"}
+
+	/******************************
+	 * Uu unicast provisioning info
+	 ******************************/
+	rr, err := sendRequest(http.MethodGet, "/queries/uu_unicast_provisioning_info", nil, nil, query, http.StatusOK, ProvInfoUuUnicastGET)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
+	}
+	var uuUnicastProvInfo UuUnicastProvisioningInfo
+	err = json.Unmarshal([]byte(rr), &uuUnicastProvInfo)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(uuUnicastProvInfo.ProInfoUuUnicast) != 1 {
+		t.Fatalf("Unexpected Uu unicast provisioning info")
+	}
+	uuUnicastInfo := uuUnicastProvInfo.ProInfoUuUnicast[0]
+	if !reflect.DeepEqual(*uuUnicastInfo.LocationInfo.Ecgi, expectedEcgi) || uuUnicastInfo.V2xApplicationServer == nil || uuUnicastInfo.V2xApplicationServer.UdpPort != "" {
+		t.Fatalf("Unexpected Uu unicast provisioning info")
 	}
 
-	_, err = sendRequest(http.MethodPut, "/subscriptions/1", nil, nil, nil, http.StatusNotImplemented, IndividualSubscriptionPUT)
+	/******************************
+	 * Uu MBMS provisioning info
+	 ******************************/
+	rr, err = sendRequest(http.MethodGet, "/queries/uu_mbms_provisioning_info", nil, nil, query, http.StatusOK, ProvInfoUuMbmsGET)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	var uuMbmsProvInfo UuMbmsProvisioningInfo
+	err = json.Unmarshal([]byte(rr), &uuMbmsProvInfo)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
+	}
+	if len(uuMbmsProvInfo.ProInfoUuMbms) != 1 {
+		t.Fatalf("Unexpected Uu MBMS provisioning info")
+	}
+	usd := uuMbmsProvInfo.ProInfoUuMbms[0].V2xServerUsd
+	if usd == nil || !reflect.DeepEqual(usd.ServiceAreaIdentifier, []string{"zone1"}) || usd.Tmgi.Mcc != "123" || usd.Tmgi.Mnc != "456" ||
+		usd.Tmgi.MbmsServiceId != "" || usd.SdpInfo != nil {
+		t.Fatalf("Unexpected Uu MBMS provisioning info")
 	}
 
-	_, err = sendRequest(http.MethodGet, "/queries/pc5_provisioning_info", nil, nil, nil, http.StatusNotImplemented, ProvInfoGET)
+	/******************************
+	 * PC5 provisioning info
+	 ******************************/
+	rr, err = sendRequest(http.MethodGet, "/queries/pc5_provisioning_info", nil, nil, query, http.StatusOK, ProvInfoGET)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
+	}
+	var pc5ProvInfo Pc5ProvisioningInfo
+	err = json.Unmarshal([]byte(rr), &pc5ProvInfo)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(pc5ProvInfo.ProInfoPc5) != 1 || pc5ProvInfo.ProInfoPc5[0].DstLayer2Id != "" {
+		t.Fatalf("Unexpected PC5 provisioning info")
 	}
 
-	_, err = sendRequest(http.MethodGet, "/queries/uu_mbms_provisioning_info", nil, nil, nil, http.StatusNotImplemented, ProvInfoUuMbmsGET)
+	/******************************
+	 * invalid & unknown locations
+	 ******************************/
+	_, err = sendRequest(http.MethodGet, "/queries/pc5_provisioning_info", nil, nil, nil, http.StatusBadRequest, ProvInfoGET)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	query = map[string]string{"location_info": "latitude,43.7,longitude"}
+	_, err = sendRequest(http.MethodGet, "/queries/pc5_provisioning_info", nil, nil, query, http.StatusBadRequest, ProvInfoGET)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
+	}
+	query = map[string]string{"location_info": "ecgi,1234569999999"}
+	_, err = sendRequest(http.MethodGet, "/queries/pc5_provisioning_info", nil, nil, query, http.StatusNotFound, ProvInfoGET)
+	if err != nil {
+		t.Fatalf(err.Error())
 	}
 
-	_, err = sendRequest(http.MethodGet, "/queries/uu_unicast_provisioning_info", nil, nil, nil, http.StatusNotImplemented, ProvInfoUuUnicastGET)
+	/******************************
+	 * back to initial state section
+	 ******************************/
+	terminateScenario()
+}
+
+func TestProvChgSubscription(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	/******************************
+	 * create subscription
+	 ******************************/
+	sub := ProvChgPc5Subscription{
+		CallbackReference: "http://localhost/callback",
+		FilterCriteria: &ProvChgPc5SubscriptionFilterCriteria{
+			LocationInfo: &LocationInfo{
+				Ecgi: &Ecgi{CellId: &CellId{CellId: "2345678"}, Plmn: &Plmn{Mcc: "123", Mnc: "456"}},
+			},
+		},
+		SubscriptionType: PROV_CHG_PC5_SUBSCRIPTION,
+	}
+	body, _ := json.Marshal(sub)
+	rr, err := sendRequest(http.MethodPost, "/subscriptions", bytes.NewBuffer(body), nil, nil, http.StatusCreated, SubPOST)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
+	}
+	var respSub ProvChgPc5Subscription
+	err = json.Unmarshal([]byte(rr), &respSub)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if respSub.Links == nil || respSub.Links.Self == nil {
+		t.Fatalf("Missing subscription link")
+	}
+	selfUrl := strings.Split(respSub.Links.Self.Href, "/")
+	subId := selfUrl[len(selfUrl)-1]
+	if provChgInfoMap[subId] == "" {
+		t.Fatalf("Missing provisioning info for subscription")
 	}
 
-	_, err = sendRequest(http.MethodGet, "/subscriptions", nil, nil, nil, http.StatusNotImplemented, SubGET)
+	// Missing filter location
+	invalidSub := sub
+	invalidSub.FilterCriteria = &ProvChgPc5SubscriptionFilterCriteria{}
+	body, _ = json.Marshal(invalidSub)
+	_, err = sendRequest(http.MethodPost, "/subscriptions", bytes.NewBuffer(body), nil, nil, http.StatusBadRequest, SubPOST)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
 	}
 
-	_, err = sendRequest(http.MethodPost, "/subscriptions", nil, nil, nil, http.StatusNotImplemented, SubPOST)
+	/******************************
+	 * get subscriptions
+	 ******************************/
+	query := map[string]string{"subscription_type": "prov_chg_uu_pc5"}
+	rr, err = sendRequest(http.MethodGet, "/subscriptions", nil, nil, query, http.StatusOK, SubGET)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
+	}
+	var subLinkList SubscriptionLinkList
+	err = json.Unmarshal([]byte(rr), &subLinkList)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(subLinkList.Links.Subscriptions) != 1 || subLinkList.Links.Subscriptions[0].SubscriptionType != PROV_CHG_PC5_SUBSCRIPTION {
+		t.Fatalf("Unexpected subscription link list")
 	}
 
-	_, err = sendRequest(http.MethodPost, "/publish_v2x_message", nil, nil, nil, http.StatusNotImplemented, V2xMessagePOST)
+	/******************************
+	 * delete subscription
+	 ******************************/
+	vars := map[string]string{"subscriptionId": subId}
+	_, err = sendRequest(http.MethodDelete, "/subscriptions/"+subId, nil, vars, nil, http.StatusNoContent, IndividualSubscriptionDELETE)
 	if err != nil {
-		t.Fatalf("Failed to get expected response")
+		t.Fatalf(err.Error())
+	}
+	if _, found := provChgInfoMap[subId]; found {
+		t.Fatalf("Provisioning info not removed for deleted subscription")
+	}
+
+	/******************************
+	 * back to initial state section
+	 ******************************/
+	terminateScenario()
+}
+
+func TestV2xMsgFilter(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	cam := CAM
+	msg := V2xMsgPublication{
+		MsgContent:      "content",
+		MsgEncodeFormat: "base64",
+		MsgType:         &cam,
+		StdOrganization: STD_ORGANIZATION_ETSI,
+	}
+	locationPoa1 := LocationInfo{GeoArea: &LocationInfoGeoArea{Latitude: 43.733505, Longitude: 7.413917}}
+	locationPoa2 := LocationInfo{Ecgi: &Ecgi{CellId: &CellId{CellId: "2345678"}}}
+	getPoaName := func(location *LocationInfo) string {
+		if location.GeoArea != nil {
+			return "poa1"
+		}
+		return "poa2"
+	}
+
+	fmt.Println("Validate message type parsing")
+	msgType, valid := parseMsgType("2")
+	if !valid || msgType != CAM {
+		t.Fatalf("Failed to parse numeric message type")
+	}
+	msgType, valid = parseMsgType("EV-RSR")
+	if !valid || msgType != EV_RSR {
+		t.Fatalf("Failed to parse message type name")
+	}
+	if _, valid = parseMsgType("14"); valid {
+		t.Fatalf("Invalid message type should not be parsed")
 	}
 
+	fmt.Println("Filter on standardization organization & message type")
+	filter := V2xMsgSubscriptionFilterCriteria{StdOrganization: STD_ORGANIZATION_ETSI}
+	if !isV2xMsgFilterMatch(&filter, &msg, "", getPoaName) {
+		t.Fatalf("Message should match filter without message type")
+	}
+	filter.MsgType = []string{"1", "cam"}
+	if !isV2xMsgFilterMatch(&filter, &msg, "", getPoaName) {
+		t.Fatalf("Message should match message type filter")
+	}
+	filter.MsgType = []string{"1"}
+	if isV2xMsgFilterMatch(&filter, &msg, "", getPoaName) {
+		t.Fatalf("Message should not match message type filter")
+	}
+	filter.MsgType = nil
+	filter.StdOrganization = "OTHER"
+	if isV2xMsgFilterMatch(&filter, &msg, "", getPoaName) {
+		t.Fatalf("Message should not match standardization organization filter")
+	}
+	filter.StdOrganization = STD_ORGANIZATION_ETSI
+
+	fmt.Println("Filter on location")
+	filter.LocationInfo = []LocationInfo{locationPoa1}
+	if isV2xMsgFilterMatch(&filter, &msg, "", getPoaName) {
+		t.Fatalf("Message without location should not match location filter")
+	}
+	if !isV2xMsgFilterMatch(&filter, &msg, "poa1", getPoaName) {
+		t.Fatalf("Message should match location filter")
+	}
+	if isV2xMsgFilterMatch(&filter, &msg, "poa2", getPoaName) {
+		t.Fatalf("Message should not match location filter")
+	}
+	filter.LocationInfo = append(filter.LocationInfo, locationPoa2)
+	if !isV2xMsgFilterMatch(&filter, &msg, "poa2", getPoaName) {
+		t.Fatalf("Message should match one of the location filters")
+	}
+
+	fmt.Println("Validate publication")
+	if err := validateV2xMsgPublication(&msg); err != nil {
+		t.Fatalf("Valid message failed validation: " + err.Error())
+	}
+	msg.LocationInfo = &LocationInfo{Ecgi: locationPoa2.Ecgi, GeoArea: locationPoa1.GeoArea}
+	if err := validateV2xMsgPublication(&msg); err == nil {
+		t.Fatalf("Message with ecgi & geoArea should fail validation")
+	}
+	msg.LocationInfo = nil
+	msg.MsgContent = ""
+	if err := validateV2xMsgPublication(&msg); err == nil {
+		t.Fatalf("Message without content should fail validation")
+	}
+}
+
+func TestV2xMsgSubscription(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	// Notification receiver
+	notifChan := make(chan V2xMsgNotification, 10)
+	notifServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var notif V2xMsgNotification
+		if json.NewDecoder(r.Body).Decode(&notif) == nil && notif.NotificationType == V2X_MSG_NOTIFICATION {
+			notifChan <- notif
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer notifServer.Close()
+
+	/******************************
+	 * create subscription
+	 ******************************/
+	sub := V2xMsgSubscription{
+		CallbackReference: notifServer.URL,
+		FilterCriteria: &V2xMsgSubscriptionFilterCriteria{
+			MsgType:         []string{"2"},
+			StdOrganization: STD_ORGANIZATION_ETSI,
+		},
+		SubscriptionType: V2X_MSG_SUBSCRIPTION,
+	}
+	body, err := json.Marshal(sub)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	rr, err := sendRequest(http.MethodPost, "/subscriptions", bytes.NewBuffer(body), nil, nil, http.StatusCreated, SubPOST)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	var respSub V2xMsgSubscription
+	err = json.Unmarshal([]byte(rr), &respSub)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if respSub.Links == nil || respSub.Links.Self == nil {
+		t.Fatalf("Missing subscription link")
+	}
+	selfUrl := strings.Split(respSub.Links.Self.Href, "/")
+	subId := selfUrl[len(selfUrl)-1]
+
+	// Invalid subscription
+	invalidSub := sub
+	invalidSub.FilterCriteria = &V2xMsgSubscriptionFilterCriteria{MsgType: []string{"99"}, StdOrganization: STD_ORGANIZATION_ETSI}
+	body, _ = json.Marshal(invalidSub)
+	_, err = sendRequest(http.MethodPost, "/subscriptions", bytes.NewBuffer(body), nil, nil, http.StatusBadRequest, SubPOST)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * get subscriptions
+	 ******************************/
+	vars := map[string]string{"subscriptionId": subId}
+	rr, err = sendRequest(http.MethodGet, "/subscriptions/"+subId, nil, vars, nil, http.StatusOK, IndividualSubscriptionGET)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if rr != convertV2xMsgSubscriptionToJson(&respSub) {
+		t.Fatalf("Unexpected subscription")
+	}
+	query := map[string]string{"subscription_type": "v2x_msg"}
+	rr, err = sendRequest(http.MethodGet, "/subscriptions", nil, nil, query, http.StatusOK, SubGET)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	var subLinkList SubscriptionLinkList
+	err = json.Unmarshal([]byte(rr), &subLinkList)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(subLinkList.Links.Subscriptions) != 1 || subLinkList.Links.Subscriptions[0].Href != respSub.Links.Self.Href {
+		t.Fatalf("Unexpected subscription link list")
+	}
+
+	/******************************
+	 * publish messages
+	 ******************************/
+	cam := CAM
+	denm := DENM
+	msg := V2xMsgPublication{
+		MsgContent:      "Y2FtIG1lc3NhZ2U=",
+		MsgEncodeFormat: "base64",
+		MsgType:         &cam,
+		StdOrganization: STD_ORGANIZATION_ETSI,
+	}
+	body, _ = json.Marshal(msg)
+	_, err = sendRequest(http.MethodPost, "/publish_v2x_message", bytes.NewBuffer(body), nil, nil, http.StatusNoContent, V2xMessagePOST)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	select {
+	case notif := <-notifChan:
+		if notif.MsgContent != msg.MsgContent || *notif.MsgType != CAM || notif.Links.Subscription.Href != respSub.Links.Self.Href {
+			t.Fatalf("Unexpected notification")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Notification not received")
+	}
+
+	// Filtered message type
+	msg.MsgType = &denm
+	body, _ = json.Marshal(msg)
+	_, err = sendRequest(http.MethodPost, "/publish_v2x_message", bytes.NewBuffer(body), nil, nil, http.StatusNoContent, V2xMessagePOST)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	select {
+	case <-notifChan:
+		t.Fatalf("Unexpected notification for filtered message type")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	/******************************
+	 * update subscription
+	 ******************************/
+	respSub.FilterCriteria.MsgType = []string{"denm"}
+	body, _ = json.Marshal(respSub)
+	_, err = sendRequest(http.MethodPut, "/subscriptions/"+subId, bytes.NewBuffer(body), vars, nil, http.StatusOK, IndividualSubscriptionPUT)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	body, _ = json.Marshal(msg)
+	_, err = sendRequest(http.MethodPost, "/publish_v2x_message", bytes.NewBuffer(body), nil, nil, http.StatusNoContent, V2xMessagePOST)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	select {
+	case notif := <-notifChan:
+		if *notif.MsgType != DENM {
+			t.Fatalf("Unexpected notification")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Notification not received")
+	}
+
+	/******************************
+	 * delete subscription
+	 ******************************/
+	_, err = sendRequest(http.MethodDelete, "/subscriptions/"+subId, nil, vars, nil, http.StatusNoContent, IndividualSubscriptionDELETE)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	_, err = sendRequest(http.MethodGet, "/subscriptions/"+subId, nil, vars, nil, http.StatusNotFound, IndividualSubscriptionGET)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * back to initial state section
+	 ******************************/
+	terminateScenario()
 }
 
 func TestPredictedQosPost(t *testing.T) {
@@ -45,6 +45,7 @@ type SbiCfg struct {
 	PostgisPort    string
 	Locality       []string
 	StaInfoCb      func(string, string, string, *int32, *int32, *int32)
+	StaMeasInfoCb  func(string, string, map[string]int32)
 	ApInfoCb       func(string, string, *float32, *float32, []string)
 	ScenarioNameCb func(string)
 	CleanUpCb      func()
@@ -64,6 +65,7 @@ type WaisSbi struct {
 	gisCache                *gc.GisCache
 	refreshTicker           *time.Ticker
 	updateStaInfoCB         func(string, string, string, *int32, *int32, *int32)
+	updateStaMeasInfoCB     func(string, string, map[string]int32)
 	updateAccessPointInfoCB func(string, string, *float32, *float32, []string)
 	updateScenarioNameCB    func(string)
 	cleanUpCB               func()
@@ -85,6 +87,7 @@ func Init(cfg SbiCfg) (err error) {
 	sbi.mepName = cfg.MepName
 	sbi.scenarioName = ""
 	sbi.updateStaInfoCB = cfg.StaInfoCb
+	sbi.updateStaMeasInfoCB = cfg.StaMeasInfoCb
 	sbi.updateAccessPointInfoCB = cfg.ApInfoCb
 	sbi.updateScenarioNameCB = cfg.ScenarioNameCb
 	sbi.cleanUpCB = cfg.CleanUpCb
@@ -306,6 +309,7 @@ func processActiveScenarioUpdate() {
 	// Get all POA positions & UE measurments
 	poaPositionMap, _ := sbi.gisCache.GetAllPositions(gc.TypePoa)
 	uePoaMeasMap, _ := sbi.gisCache.GetAllPoaMeasurements()
+	poaMacIdMap := getPoaWifiMacIdMap()
 
 	// Update UE info
 	ueNames := []string{}
@@ -341,6 +345,7 @@ func processActiveScenarioUpdate() {
 			sumUlKbps := int32(sumUl * 1000)
 			sumDlKbps := int32(sumDl * 1000)
 			sbi.updateStaInfoCB(name, ue.MacId, apMacId, rssi, &sumUlKbps, &sumDlKbps)
+			sbi.updateStaMeasInfoCB(name, ue.MacId, getApRssiMap(name, uePoaMeasMap, poaMacIdMap))
 		}
 	}
 
@@ -355,6 +360,7 @@ func processActiveScenarioUpdate() {
 		}
 		if !found {
 			sbi.updateStaInfoCB(prevUeName, "", "", nil, nil, nil)
+			sbi.updateStaMeasInfoCB(prevUeName, "", nil)
 			log.Info("Ue removed : ", prevUeName)
 		}
 	}
@@ -434,6 +440,7 @@ func refreshPositions() {
 func refreshMeasurements() {
 	// Update UE measurements
 	uePoaMeasMap, _ := sbi.gisCache.GetAllPoaMeasurements()
+	poaMacIdMap := getPoaWifiMacIdMap()
 	ueNameList := sbi.activeModel.GetNodeNames("UE")
 	for _, name := range ueNameList {
 		// Ignore disconnected UEs
@@ -463,6 +470,7 @@ func refreshMeasurements() {
 			sumUlKbps := int32(sumUl * 1000)
 			sumDlKbps := int32(sumDl * 1000)
 			sbi.updateStaInfoCB(name, ue.MacId, apMacId, rssi, &sumUlKbps, &sumDlKbps)
+			sbi.updateStaMeasInfoCB(name, ue.MacId, getApRssiMap(name, uePoaMeasMap, poaMacIdMap))
 		}
 	}
 }
@@ -477,6 +485,34 @@ func getRssi(ue string, poa string, uePoaMeasMap map[string]*gc.UePoaMeasurement
 	return nil
 }
 
+// getPoaWifiMacIdMap - Get MAC ID of all WiFi POAs in locality
+func getPoaWifiMacIdMap() map[string]string {
+	poaMacIdMap := make(map[string]string)
+	poaNameList := sbi.activeModel.GetNodeNames(mod.NodeTypePoaWifi)
+	for _, name := range poaNameList {
+		if !isInLocality(name) {
+			continue
+		}
+		if poa, ok := sbi.activeModel.GetNode(name).(*dataModel.NetworkLocation); ok && poa.PoaWifiConfig != nil {
+			poaMacIdMap[name] = poa.PoaWifiConfig.MacId
+		}
+	}
+	return poaMacIdMap
+}
+
+// getApRssiMap - Get RSSI of all WiFi POAs measured by UE, indexed by POA MAC ID
+func getApRssiMap(ue string, uePoaMeasMap map[string]*gc.UePoaMeasurement, poaMacIdMap map[string]string) map[string]int32 {
+	apRssiMap := make(map[string]int32)
+	if ueMeas, ueFound := uePoaMeasMap[ue]; ueFound {
+		for poa, meas := range ueMeas.Measurements {
+			if macId, found := poaMacIdMap[poa]; found && macId != "" {
+				apRssiMap[macId] = int32(meas.Rssi)
+			}
+		}
+	}
+	return apRssiMap
+}
+
 func isUeConnected(name string) bool {
 	node := sbi.activeModel.GetNode(name)
 	if node != nil {
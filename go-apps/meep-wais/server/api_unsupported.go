@@ -1,48 +0,0 @@
-/*
- * Copyright (c) 2022  The AdvantEDGE Authors
- *
- * Licensed under the Apache License, Version 2.0 (the "License");
- * you may not use this file except in compliance with the License.
- * You may obtain a copy of the License at
- *
- *    http://www.apache.org/licenses/LICENSE-2.0
- *
- * Unless required by applicable law or agreed to in writing, software
- * distributed under the License is distributed on an "AS IS" BASIS,
- * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
- * See the License for the specific language governing permissions and
- * limitations under the License.
- *
- * AdvantEDGE WLAN Access Information API
- *
- * WLAN Access Information Service is AdvantEDGE's implementation of [ETSI MEC ISG MEC028 WAI API](http://www.etsi.org/deliver/etsi_gs/MEC/001_099/028/02.02.01_60/gs_MEC028v020201p.pdf) <p>[Copyright (c) ETSI 2020](https://forge.etsi.org/etsi-forge-copyright-notice.txt) <p>**Micro-service**<br>[meep-wais](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-wais) <p>**Type & Usage**<br>Edge Service used by edge applications that want to get information about WLAN access information in the network <p>**Note**<br>AdvantEDGE supports a selected subset of WAI API subscription types. <p>Supported subscriptions: <p> - AssocStaSubscription <p> - StaDataRateSubscription
- *
- * API version: 2.2.1
- * Contact: AdvantEDGE@InterDigital.com
- * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
- */
-package server
-
-import (
-	"net/http"
-)
-
-func MeasurementLinkListMeasurementsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func MeasurementsDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func MeasurementsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func MeasurementsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
-
-func MeasurementsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
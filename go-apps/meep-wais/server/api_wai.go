@@ -31,6 +31,26 @@ func ApInfoGET(w http.ResponseWriter, r *http.Request) {
 	apInfoGET(w, r)
 }
 
+func MeasurementLinkListMeasurementsGET(w http.ResponseWriter, r *http.Request) {
+	measurementLinkListMeasurementsGET(w, r)
+}
+
+func MeasurementsDELETE(w http.ResponseWriter, r *http.Request) {
+	measurementsDELETE(w, r)
+}
+
+func MeasurementsGET(w http.ResponseWriter, r *http.Request) {
+	measurementsGET(w, r)
+}
+
+func MeasurementsPOST(w http.ResponseWriter, r *http.Request) {
+	measurementsPOST(w, r)
+}
+
+func MeasurementsPUT(w http.ResponseWriter, r *http.Request) {
+	measurementsPUT(w, r)
+}
+
 func Mec011AppTerminationPOST(w http.ResponseWriter, r *http.Request) {
 	mec011AppTerminationPost(w, r)
 }
@@ -161,3 +161,69 @@ func convertStaInfoListToJson(obj *[]StaInfo) string {
 	}
 	return string(jsonInfo)
 }
+
+func convertJsonToStaMeasData(jsonData string) *StaMeasData {
+	var obj StaMeasData
+	err := json.Unmarshal([]byte(jsonData), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertStaMeasDataToJson(obj *StaMeasData) string {
+	jsonData, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonData)
+}
+
+func convertJsonToMeasurementConfig(jsonData string) *MeasurementConfig {
+	var obj MeasurementConfig
+	err := json.Unmarshal([]byte(jsonData), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertMeasurementConfigToJson(obj *MeasurementConfig) string {
+	jsonData, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonData)
+}
+
+func convertMeasurementConfigLinkListToJson(obj *MeasurementConfigLinkList) string {
+	jsonData, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonData)
+}
+
+func convertJsonToMeasurementReport(jsonData string) *MeasurementReport {
+	var obj MeasurementReport
+	err := json.Unmarshal([]byte(jsonData), &obj)
+	if err != nil {
+		log.Error(err.Error())
+		return nil
+	}
+	return &obj
+}
+
+func convertMeasurementReportToJson(obj *MeasurementReport) string {
+	jsonData, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonData)
+}
@@ -22,10 +22,13 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -54,6 +57,23 @@ type StaData struct {
 	StaInfo *StaInfo `json:"staInfo"`
 }
 
+type StaMeasData struct {
+	StaMacId string           `json:"staMacId"`
+	ApRssi   map[string]int32 `json:"apRssi,omitempty"`
+}
+
+type MeasurementReport struct {
+	BeaconReport  []BeaconReport  `json:"beaconReport,omitempty"`
+	ChannelLoad   []ChannelLoad   `json:"channelLoad,omitempty"`
+	StaStatistics []StaStatistics `json:"staStatistics,omitempty"`
+}
+
+type MeasurementData struct {
+	staInfoMap   map[string]*StaInfo
+	staApRssiMap map[string]map[string]int32
+	apLoadMap    map[string]int32
+}
+
 const moduleName = "meep-wais"
 const waisBasePath = "wai/v2/"
 const waisKey = "wais"
@@ -69,6 +89,23 @@ const (
 	STA_DATA_RATE_SUBSCRIPTION      = "StaDataRateSubscription"
 	MEASUREMENT_REPORT_SUBSCRIPTION = "MeasurementReportSubscription"
 )
+
+// Measurement configuration
+const measurementTickerPeriod = 1000 * time.Millisecond
+const defaultMeasurementDuration = 1000 // TUs
+
+// WLAN radio model used to compute measurement reports
+const defaultApChannel = 36
+const defaultApPhyType = 9 // VHT
+const wifiChannelCapacityKbps = 200000
+const wifiFrameSize = 1500 // bytes
+const wifiMaxFrameErrorRate = 0.1
+const wifiRssiMin = 32 // GIS RSSI at minimum received power
+const wifiRssiMax = 77 // GIS RSSI at maximum received power
+const wifiRxPowerMin = -80.0
+const wifiRxPowerRange = 50.0
+const wifiNoiseFloor = -95.0
+
 const (
 	ASSOC_STA_NOTIFICATION     = "AssocStaNotification"
 	STA_DATA_RATE_NOTIFICATION = "StaDataRateNotification"
@@ -105,11 +142,10 @@ var sbxCtrlClient *scc.APIClient
 var registrationTicker *time.Ticker
 var subMgr *sm.SubscriptionMgr
 var waisRouter *mux.Router
-
-func notImplemented(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	w.WriteHeader(http.StatusNotImplemented)
-}
+var measMutex sync.Mutex
+var measScheduleMap = map[string]time.Time{}
+var measurementTicker *time.Ticker
+var nextMeasurementConfigIdAvailable int = 1
 
 func Init() (err error) {
 	// Retrieve Instance ID from environment variable if present
@@ -237,6 +273,7 @@ func Init() (err error) {
 		InfluxAddr:     influxAddr,
 		Locality:       locality,
 		StaInfoCb:      updateStaInfo,
+		StaMeasInfoCb:  updateStaMeasInfo,
 		ApInfoCb:       updateApInfo,
 		ScenarioNameCb: updateStoreName,
 		CleanUpCb:      cleanUp,
@@ -288,6 +325,10 @@ func Run() (err error) {
 	if appEnablementEnabled {
 		startRegistrationTicker()
 	}
+
+	// Start measurement report ticker
+	startMeasurementTicker()
+
 	return sbi.Run()
 }
 
@@ -298,6 +339,9 @@ func Stop() (err error) {
 		stopRegistrationTicker()
 	}
 
+	// Stop measurement report ticker
+	stopMeasurementTicker()
+
 	return sbi.Stop()
 }
 
@@ -1286,6 +1330,9 @@ func staInfoGET(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Add measurement reports
+	addStaMeasurementReports(staInfoList)
+
 	// Send response
 	w.WriteHeader(http.StatusOK)
 	fmt.Fprint(w, convertStaInfoListToJson(&staInfoList))
@@ -1382,6 +1429,716 @@ func populateStaInfo(key string, jsonInfo string, userData interface{}) error {
 	return nil
 }
 
+func measurementLinkListMeasurementsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Create measurement configuration link list
+	self := new(LinkType)
+	self.Href = hostUrl.String() + basePath + "measurements"
+	link := new(MeasurementConfigLinks)
+	link.Self = self
+	measurementConfigLinkList := new(MeasurementConfigLinkList)
+	measurementConfigLinkList.Links = link
+
+	// Get list of measurement configurations
+	var measConfigList []MeasurementConfig
+	keyName := baseKey + "MEAS:*"
+	err := rc.ForEachJSONEntry(keyName, populateMeasurementConfigList, &measConfigList)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sort.Slice(measConfigList, func(i, j int) bool {
+		return measConfigList[i].Links.Self.Href < measConfigList[j].Links.Self.Href
+	})
+
+	// Prepare response
+	for _, measConfig := range measConfigList {
+		var linkListMeasConfig MeasurementConfigLinkListMeasurementConfig
+		linkListMeasConfig.Href = measConfig.Links.Self.Href
+		linkListMeasConfig.MeasurementId = measConfig.MeasurementId
+		measurementConfigLinkList.MeasurementConfig = append(measurementConfigLinkList.MeasurementConfig, linkListMeasConfig)
+	}
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, convertMeasurementConfigLinkListToJson(measurementConfigLinkList))
+}
+
+func measurementsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	measConfigId := vars["measurementConfigId"]
+
+	// Find measurement configuration by ID
+	jsonMeasConfig, _ := rc.JSONGetEntry(baseKey+"MEAS:"+measConfigId, ".")
+	if jsonMeasConfig == "" {
+		log.Error("Measurement configuration not found: ", measConfigId)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, jsonMeasConfig)
+}
+
+func measurementsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	// Retrieve measurement configuration
+	var measConfig MeasurementConfig
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&measConfig)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate measurement configuration
+	err = validateMeasurementConfig(&measConfig)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	measMutex.Lock()
+	defer measMutex.Unlock()
+
+	// Get a new measurement configuration ID
+	measConfigId := strconv.Itoa(nextMeasurementConfigIdAvailable)
+	nextMeasurementConfigIdAvailable++
+
+	// Set resource link
+	self := new(LinkType)
+	self.Href = hostUrl.String() + basePath + "measurements/" + measConfigId
+	link := new(MeasurementConfigLinks)
+	link.Self = self
+	measConfig.Links = link
+
+	// Store measurement configuration & schedule first measurement
+	jsonMeasConfig := convertMeasurementConfigToJson(&measConfig)
+	err = rc.JSONSetEntry(baseKey+"MEAS:"+measConfigId, ".", jsonMeasConfig)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	scheduleMeasurement(measConfigId, measConfig.MeasurementInfo, time.Now())
+
+	// Send response
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprint(w, jsonMeasConfig)
+}
+
+func measurementsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	measConfigId := vars["measurementConfigId"]
+
+	// Retrieve measurement configuration
+	var measConfig MeasurementConfig
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&measConfig)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate parameters
+	link := measConfig.Links
+	if link == nil || link.Self == nil {
+		log.Error("Mandatory Link parameter not present")
+		http.Error(w, "Mandatory Link parameter not present", http.StatusBadRequest)
+		return
+	}
+	selfUrl := strings.Split(link.Self.Href, "/")
+	linkMeasConfigId := selfUrl[len(selfUrl)-1]
+	if linkMeasConfigId != measConfigId {
+		log.Error("MeasurementConfigId in endpoint and in body not matching")
+		http.Error(w, "MeasurementConfigId in endpoint and in body not matching", http.StatusBadRequest)
+		return
+	}
+	err = validateMeasurementConfig(&measConfig)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	measMutex.Lock()
+	defer measMutex.Unlock()
+
+	// Find measurement configuration by ID
+	key := baseKey + "MEAS:" + measConfigId
+	jsonMeasConfig, _ := rc.JSONGetEntry(key, ".")
+	if jsonMeasConfig == "" {
+		log.Error("Measurement configuration not found: ", measConfigId)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Update measurement configuration, flush previous reports & reschedule measurement
+	jsonMeasConfig = convertMeasurementConfigToJson(&measConfig)
+	err = rc.JSONSetEntry(key, ".", jsonMeasConfig)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_ = rc.DBFlush(baseKey + "MEAS-REPORT:" + measConfigId + ":")
+	scheduleMeasurement(measConfigId, measConfig.MeasurementInfo, time.Now())
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, jsonMeasConfig)
+}
+
+func measurementsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	measConfigId := vars["measurementConfigId"]
+
+	measMutex.Lock()
+	defer measMutex.Unlock()
+
+	// Find measurement configuration by ID
+	key := baseKey + "MEAS:" + measConfigId
+	jsonMeasConfig, _ := rc.JSONGetEntry(key, ".")
+	if jsonMeasConfig == "" {
+		log.Error("Measurement configuration not found: ", measConfigId)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Delete measurement configuration & reports
+	err := rc.JSONDelEntry(key, ".")
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_ = rc.DBFlush(baseKey + "MEAS-REPORT:" + measConfigId + ":")
+	delete(measScheduleMap, measConfigId)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validateMeasurementConfig(measConfig *MeasurementConfig) error {
+	if measConfig.MeasurementId == "" {
+		return errors.New("Mandatory MeasurementId parameter not present")
+	}
+	if len(measConfig.StaId) == 0 {
+		return errors.New("Mandatory StaId parameter not present")
+	}
+	for _, staId := range measConfig.StaId {
+		if staId.MacId == "" {
+			return errors.New("Mandatory StaId MacId parameter not present")
+		}
+	}
+
+	measInfo := measConfig.MeasurementInfo
+	if measInfo == nil {
+		return errors.New("Mandatory MeasurementInfo parameter not present")
+	}
+	if measInfo.MeasurementDuration < 0 || measInfo.RandomInterval < 0 {
+		return errors.New("MeasurementDuration and RandomInterval shall not be negative")
+	}
+	if measInfo.NeighborReportConf != nil {
+		return errors.New("Neighbor report measurements not supported")
+	}
+	if measInfo.BeaconRequestConf == nil && measInfo.ChannelLoadConf == nil && measInfo.StaStatisticsConf == nil {
+		return errors.New("At least one of BeaconRequestConf, ChannelLoadConf or StaStatisticsConf shall be present")
+	}
+	if conf := measInfo.BeaconRequestConf; conf != nil {
+		if conf.BeaconReportingConf == nil {
+			return errors.New("Mandatory BeaconReportingConf parameter not present")
+		}
+		if conf.BeaconReportingConf.ReportingCondition < 0 || conf.BeaconReportingConf.ReportingCondition > 10 {
+			return errors.New("Invalid BeaconReportingConf ReportingCondition")
+		}
+		if conf.MeasurementMode < 0 || conf.MeasurementMode > 2 {
+			return errors.New("Invalid BeaconRequestConf MeasurementMode")
+		}
+	}
+	if conf := measInfo.ChannelLoadConf; conf != nil {
+		if conf.ReportingCondition < 0 || conf.ReportingCondition > 2 {
+			return errors.New("Invalid ChannelLoadConf ReportingCondition")
+		}
+	}
+	if conf := measInfo.StaStatisticsConf; conf != nil {
+		if conf.GroupIdentity < 0 || conf.GroupIdentity > 9 {
+			return errors.New("Unsupported StaStatisticsConf GroupIdentity")
+		}
+	}
+	return nil
+}
+
+func populateMeasurementConfigList(key string, jsonInfo string, userData interface{}) error {
+	measConfigListPtr := userData.(*[]MeasurementConfig)
+	if measConfigListPtr == nil {
+		return errors.New("measConfigListPtr == nil")
+	}
+
+	measConfig := convertJsonToMeasurementConfig(jsonInfo)
+	if measConfig == nil || measConfig.Links == nil || measConfig.Links.Self == nil {
+		return nil
+	}
+	*measConfigListPtr = append(*measConfigListPtr, *measConfig)
+	return nil
+}
+
+// getMeasurementDuration - Get measurement duration in TUs, or default duration if not configured
+func getMeasurementDuration(measInfo *MeasurementInfo) int32 {
+	if measInfo == nil || measInfo.MeasurementDuration == 0 {
+		return defaultMeasurementDuration
+	}
+	return measInfo.MeasurementDuration
+}
+
+// getTuDuration - Convert a number of Time Units (TUs) of 1024 µs to a time duration
+func getTuDuration(tu int32) time.Duration {
+	return time.Duration(tu) * 1024 * time.Microsecond
+}
+
+// scheduleMeasurement - Schedule next measurement after a random interval
+// NOTE: measMutex must be held by caller
+func scheduleMeasurement(measConfigId string, measInfo *MeasurementInfo, start time.Time) {
+	next := start
+	if measInfo != nil && measInfo.RandomInterval > 0 {
+		next = next.Add(getTuDuration(rand.Int31n(measInfo.RandomInterval + 1)))
+	}
+	measScheduleMap[measConfigId] = next
+}
+
+func startMeasurementTicker() {
+	// Make sure ticker is not running
+	if measurementTicker != nil {
+		log.Warn("Measurement ticker already running")
+		return
+	}
+
+	// Start measurement ticker
+	measurementTicker = time.NewTicker(measurementTickerPeriod)
+	go func() {
+		for range measurementTicker.C {
+			refreshMeasurementReports()
+		}
+	}()
+}
+
+func stopMeasurementTicker() {
+	if measurementTicker != nil {
+		measurementTicker.Stop()
+		measurementTicker = nil
+	}
+}
+
+// refreshMeasurementReports - Generate reports of scheduled measurement configurations
+// Reports are computed from the STA data rates, the RSSI measured by the STA for each
+// AP in range and the resulting load of each AP. A new measurement is performed every
+// measurement duration, or every measurement ticker period if the duration is shorter.
+func refreshMeasurementReports() {
+	measMutex.Lock()
+	defer measMutex.Unlock()
+
+	// Get list of measurement configurations
+	var measConfigList []MeasurementConfig
+	err := rc.ForEachJSONEntry(baseKey+"MEAS:*", populateMeasurementConfigList, &measConfigList)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	var measData *MeasurementData
+	now := time.Now()
+	for _, measConfig := range measConfigList {
+		selfUrl := strings.Split(measConfig.Links.Self.Href, "/")
+		measConfigId := selfUrl[len(selfUrl)-1]
+
+		// Ignore if measurement not due
+		next, found := measScheduleMap[measConfigId]
+		if !found {
+			scheduleMeasurement(measConfigId, measConfig.MeasurementInfo, now)
+			continue
+		}
+		if now.Before(next) {
+			continue
+		}
+		measScheduleMap[measConfigId] = next.Add(getTuDuration(getMeasurementDuration(measConfig.MeasurementInfo)))
+		if measScheduleMap[measConfigId].Before(now) {
+			measScheduleMap[measConfigId] = now.Add(measurementTickerPeriod)
+		}
+
+		// Get latest STA & AP measurement data
+		if measData == nil {
+			measData = getMeasurementData()
+		}
+
+		// Generate & store measurement report of each target STA
+		for _, staId := range measConfig.StaId {
+			key := baseKey + "MEAS-REPORT:" + measConfigId + ":" + staId.MacId
+			report := getMeasurementReport(&measConfig, staId, measData)
+			if report == nil {
+				_ = rc.JSONDelEntry(key, ".")
+				continue
+			}
+			_ = rc.JSONSetEntry(key, ".", convertMeasurementReportToJson(report))
+		}
+	}
+}
+
+// getMeasurementData - Get STA info, STA AP RSSI & AP load used to compute measurement reports
+func getMeasurementData() *MeasurementData {
+	measData := new(MeasurementData)
+	measData.staInfoMap = make(map[string]*StaInfo)
+	measData.staApRssiMap = make(map[string]map[string]int32)
+	measData.apLoadMap = make(map[string]int32)
+
+	// Get associated STA info & AP load
+	var staInfoList []StaInfo
+	err := rc.ForEachJSONEntry(baseKey+"UE:*", populateStaInfo, &staInfoList)
+	if err != nil {
+		log.Error(err.Error())
+	}
+	for i := range staInfoList {
+		staInfo := &staInfoList[i]
+		if staInfo.StaId == nil || staInfo.ApAssociated == nil {
+			continue
+		}
+		measData.staInfoMap[staInfo.StaId.MacId] = staInfo
+		if staInfo.StaDataRate != nil {
+			measData.apLoadMap[staInfo.ApAssociated.Bssid] += staInfo.StaDataRate.StaLastDataDownlinkRate + staInfo.StaDataRate.StaLastDataUplinkRate
+		}
+	}
+
+	// Get RSSI of each AP measured by STAs
+	err = rc.ForEachJSONEntry(baseKey+"STA-MEAS:*", populateStaApRssiMap, &measData.staApRssiMap)
+	if err != nil {
+		log.Error(err.Error())
+	}
+	return measData
+}
+
+func populateStaApRssiMap(key string, jsonInfo string, userData interface{}) error {
+	staApRssiMapPtr := userData.(*map[string]map[string]int32)
+	if staApRssiMapPtr == nil {
+		return errors.New("staApRssiMapPtr == nil")
+	}
+
+	staMeasData := convertJsonToStaMeasData(jsonInfo)
+	if staMeasData != nil {
+		(*staApRssiMapPtr)[staMeasData.StaMacId] = staMeasData.ApRssi
+	}
+	return nil
+}
+
+// getMeasurementReport - Compute measurement report for a target STA; nil if STA is not associated
+func getMeasurementReport(measConfig *MeasurementConfig, staId StaIdentity, measData *MeasurementData) *MeasurementReport {
+	staInfo, found := measData.staInfoMap[staId.MacId]
+	if !found {
+		return nil
+	}
+	apRssiMap := measData.staApRssiMap[staId.MacId]
+	measInfo := measConfig.MeasurementInfo
+	duration := getMeasurementDuration(measInfo)
+	report := new(MeasurementReport)
+
+	// Beacon report for each AP in range matching the request
+	if conf := measInfo.BeaconRequestConf; conf != nil {
+		refRssi, refFound := apRssiMap[staInfo.ApAssociated.Bssid]
+		apMacIds := make([]string, 0, len(apRssiMap))
+		for apMacId := range apRssiMap {
+			apMacIds = append(apMacIds, apMacId)
+		}
+		sort.Strings(apMacIds)
+
+		for _, apMacId := range apMacIds {
+			if conf.Bssid != "" && !strings.EqualFold(conf.Bssid, apMacId) {
+				continue
+			}
+			if conf.ChannelId != 0 && conf.ChannelId != 255 && conf.ChannelId != defaultApChannel {
+				continue
+			}
+			rcpi := getRcpi(apRssiMap[apMacId])
+			rsni := getRsni(apRssiMap[apMacId])
+			if conf.BeaconReportingConf.ReportingCondition >= 5 && !refFound {
+				continue
+			}
+			if !isBeaconReportRequired(conf.BeaconReportingConf, rcpi, rsni, getRcpi(refRssi), getRsni(refRssi)) {
+				continue
+			}
+
+			var beaconReport BeaconReport
+			beaconReport.Bssid = apMacId
+			beaconReport.Channel = defaultApChannel
+			beaconReport.MeasurementId = measConfig.MeasurementId
+			beaconReport.OperatingClass = conf.OperatingClass
+			beaconReport.Rcpi = rcpi
+			beaconReport.Rsni = rsni
+			beaconReport.ReportedFrameInfo = &ReportedBeaconFrameInfo{FrameType: 0, PhyType: defaultApPhyType}
+			beaconReport.Ssid = conf.Ssid
+			beaconReport.StaId = &staId
+			report.BeaconReport = append(report.BeaconReport, beaconReport)
+		}
+	}
+
+	// Channel load of all APs in range sharing the requested channel
+	if conf := measInfo.ChannelLoadConf; conf != nil {
+		var load int32
+		if conf.Channel == defaultApChannel {
+			var loadKbps int64
+			for apMacId := range apRssiMap {
+				loadKbps += int64(measData.apLoadMap[apMacId])
+			}
+			load = int32(math.Min(255, math.Round(float64(loadKbps)*255/wifiChannelCapacityKbps)))
+		}
+		if (conf.ReportingCondition != 1 || load >= conf.Threshold) &&
+			(conf.ReportingCondition != 2 || load <= conf.Threshold) {
+			var channelLoad ChannelLoad
+			channelLoad.Channel = conf.Channel
+			channelLoad.ChannelLoad = load
+			channelLoad.MeasurementDuration = duration
+			channelLoad.MeasurementId = measConfig.MeasurementId
+			channelLoad.OperatingClass = conf.OperatingClass
+			channelLoad.StaId = &staId
+			report.ChannelLoad = append(report.ChannelLoad, channelLoad)
+		}
+	}
+
+	// STA statistics derived from STA data rates & serving AP RSSI
+	if conf := measInfo.StaStatisticsConf; conf != nil {
+		staStatistics := getStaStatistics(conf, staInfo, duration)
+		if staStatistics != nil {
+			staStatistics.MeasurementId = measConfig.MeasurementId
+			staStatistics.StaId = &staId
+			report.StaStatistics = append(report.StaStatistics, *staStatistics)
+		}
+	}
+	return report
+}
+
+// isBeaconReportRequired - Evaluate beacon reporting condition
+// NOTE: Offsets for reporting conditions 5 to 10 are in units of 0.5 dB, like RCPI & RSNI
+func isBeaconReportRequired(conf *BeaconReportingConfig, rcpi int32, rsni int32, refRcpi int32, refRsni int32) bool {
+	switch conf.ReportingCondition {
+	case 1:
+		return rcpi > conf.Threshold
+	case 2:
+		return rcpi < conf.Threshold
+	case 3:
+		return rsni > conf.Threshold
+	case 4:
+		return rsni < conf.Threshold
+	case 5:
+		return rcpi > refRcpi+conf.Threshold
+	case 6:
+		return rcpi < refRcpi+conf.Threshold
+	case 7:
+		return rsni > refRsni+conf.Threshold
+	case 8:
+		return rsni < refRsni+conf.Threshold
+	case 9:
+		return isInRange(rcpi, refRcpi, refRcpi+conf.Threshold)
+	case 10:
+		return isInRange(rsni, refRsni, refRsni+conf.Threshold)
+	default:
+		return true
+	}
+}
+
+func isInRange(value int32, bound1 int32, bound2 int32) bool {
+	if bound1 > bound2 {
+		bound1, bound2 = bound2, bound1
+	}
+	return value >= bound1 && value <= bound2
+}
+
+// getRxPower - Convert GIS RSSI to received power in dBm
+func getRxPower(rssi int32) float64 {
+	return float64(rssi-wifiRssiMin)*wifiRxPowerRange/float64(wifiRssiMax-wifiRssiMin) + wifiRxPowerMin
+}
+
+// getRcpi - Get RCPI from GIS RSSI, as defined in section 9.4.2.38 of IEEE 802.11-2016
+func getRcpi(rssi int32) int32 {
+	rcpi := math.Floor((getRxPower(rssi) + 110) * 2)
+	return int32(math.Max(0, math.Min(220, rcpi)))
+}
+
+// getRsni - Get RSNI from GIS RSSI, as defined in section 9.4.2.41 of IEEE 802.11-2016
+func getRsni(rssi int32) int32 {
+	snr := getRxPower(rssi) - wifiNoiseFloor
+	rsni := math.Floor((snr + 10) * 2)
+	return int32(math.Max(0, math.Min(254, rsni)))
+}
+
+// getFrameErrorRate - Estimate frame error rate from GIS RSSI
+func getFrameErrorRate(rssi int32) float64 {
+	rate := wifiMaxFrameErrorRate * float64(wifiRssiMax-rssi) / float64(wifiRssiMax-wifiRssiMin)
+	return math.Max(0, math.Min(wifiMaxFrameErrorRate, rate))
+}
+
+// getStaStatistics - Compute STA statistics group counters over the measurement duration
+// Traffic is assumed to use Best Effort (TID 0) and not to use RTS/CTS protection.
+// Returns nil if a triggered report is requested and no trigger condition is met.
+func getStaStatistics(conf *StaStatisticsConfig, staInfo *StaInfo, duration int32) *StaStatistics {
+	// Estimate frame counts from data rates
+	var ulKbps, dlKbps int32
+	if staInfo.StaDataRate != nil {
+		ulKbps = staInfo.StaDataRate.StaLastDataUplinkRate
+		dlKbps = staInfo.StaDataRate.StaLastDataDownlinkRate
+	}
+	seconds := getTuDuration(duration).Seconds()
+	txFrames := float64(ulKbps) * 1000 * seconds / (8 * wifiFrameSize)
+	rxFrames := float64(dlKbps) * 1000 * seconds / (8 * wifiFrameSize)
+
+	// Estimate retries & failures from serving AP signal
+	errorRate := wifiMaxFrameErrorRate
+	if staInfo.Rssi != nil {
+		errorRate = getFrameErrorRate(staInfo.Rssi.Rssi)
+	}
+	tx := int32(txFrames)
+	rx := int32(rxFrames)
+	txRetry := int32(txFrames * errorRate)
+	txFailed := int32(txFrames * errorRate * errorRate)
+	rxError := int32(rxFrames * errorRate)
+	rxDuplicate := int32(rxFrames * errorRate * errorRate)
+
+	staStatistics := new(StaStatistics)
+	staStatistics.GroupIdentity = conf.GroupIdentity
+	staStatistics.MeasurementDuration = duration
+
+	switch conf.GroupIdentity {
+	case 0:
+		var data StaStatisticsGroupZeroData
+		data.TransmittedFragmentCount = tx
+		data.TransmittedFrameCount = tx
+		data.FailedCount = txFailed
+		data.ReceivedFragmentCount = rx
+		data.FcsErrorCount = rxError
+		staStatistics.GroupZeroData = &data
+	case 1:
+		var data StaStatisticsGroupOneData
+		data.RetryCount = txRetry
+		data.MultipleRetryCount = txFailed
+		data.AckFailureCount = txRetry
+		data.FrameDuplicateCount = rxDuplicate
+		staStatistics.GroupOneData = &data
+	default:
+		var data StaStatisticsGroup2to9Data
+		if conf.GroupIdentity == 2 {
+			data.QosTransmittedFragmentCount = tx
+			data.QosTransmittedFrameCount = tx
+			data.QosFailedCount = txFailed
+			data.QosDiscardedFrameCount = txFailed
+			data.QosRetryCount = txRetry
+			data.QosMultipleRetryCount = txFailed
+			data.QosAckFailureCount = txRetry
+			data.QosReceivedFragmentCount = rx
+			data.QosMPDUsReceivedCount = rx
+			data.QosRetriesReceivedCount = rxError
+			data.QosFrameDuplicateCount = rxDuplicate
+		}
+		staStatistics.Group2to9Data = &data
+	}
+
+	// Evaluate trigger conditions
+	if conf.TriggeredReport && conf.TriggerCondition != nil && conf.GroupIdentity <= 1 {
+		cond := conf.TriggerCondition
+		var reason ReportingReasonStaCounters
+		reason.Failed = cond.FailedCountThreshold > 0 && txFailed >= cond.FailedCountThreshold
+		reason.FcsError = cond.FcsErrorCountThreshold > 0 && rxError >= cond.FcsErrorCountThreshold
+		reason.Retry = cond.RetryCountThreshold > 0 && txRetry >= cond.RetryCountThreshold
+		reason.MultipleRetry = cond.MultipleRetryCountThreshold > 0 && txFailed >= cond.MultipleRetryCountThreshold
+		reason.AckFailure = cond.AckFailureCountThreshold > 0 && txRetry >= cond.AckFailureCountThreshold
+		reason.FrameDuplicate = cond.FrameDuplicateCountThreshold > 0 && rxDuplicate >= cond.FrameDuplicateCountThreshold
+		if !reason.Failed && !reason.FcsError && !reason.Retry && !reason.MultipleRetry && !reason.AckFailure && !reason.FrameDuplicate {
+			return nil
+		}
+		if staStatistics.GroupZeroData != nil {
+			staStatistics.GroupZeroData.ReportingReasonStaCounters = &reason
+		} else {
+			staStatistics.GroupOneData.ReportingReasonStaCounters = &reason
+		}
+	}
+	return staStatistics
+}
+
+// addStaMeasurementReports - Add latest measurement reports to STA info
+func addStaMeasurementReports(staInfoList []StaInfo) {
+	staInfoMap := make(map[string]*StaInfo)
+	for i := range staInfoList {
+		if staInfoList[i].StaId != nil {
+			staInfoMap[staInfoList[i].StaId.MacId] = &staInfoList[i]
+		}
+	}
+
+	var reportList []MeasurementReport
+	err := rc.ForEachJSONEntry(baseKey+"MEAS-REPORT:*", populateMeasurementReportList, &reportList)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, report := range reportList {
+		for _, beaconReport := range report.BeaconReport {
+			if staInfo, found := staInfoMap[beaconReport.StaId.MacId]; found {
+				staInfo.BeaconReport = append(staInfo.BeaconReport, beaconReport)
+			}
+		}
+		for _, channelLoad := range report.ChannelLoad {
+			if staInfo, found := staInfoMap[channelLoad.StaId.MacId]; found {
+				staInfo.ChannelLoad = append(staInfo.ChannelLoad, channelLoad)
+			}
+		}
+		for _, staStatistics := range report.StaStatistics {
+			if staInfo, found := staInfoMap[staStatistics.StaId.MacId]; found {
+				staInfo.StaStatistics = append(staInfo.StaStatistics, staStatistics)
+			}
+		}
+	}
+}
+
+func populateMeasurementReportList(key string, jsonInfo string, userData interface{}) error {
+	reportListPtr := userData.(*[]MeasurementReport)
+	if reportListPtr == nil {
+		return errors.New("reportListPtr == nil")
+	}
+
+	report := convertJsonToMeasurementReport(jsonInfo)
+	if report != nil {
+		*reportListPtr = append(*reportListPtr, *report)
+	}
+	return nil
+}
+
+func updateStaMeasInfo(name string, ownMacId string, apRssi map[string]int32) {
+	key := baseKey + "STA-MEAS:" + name
+
+	// Remove STA measurements if STA is no longer connected
+	if ownMacId == "" {
+		_ = rc.JSONDelEntry(key, ".")
+		return
+	}
+
+	// Update DB if STA measurements do not exist or have changed
+	var staMeasData StaMeasData
+	staMeasData.StaMacId = ownMacId
+	staMeasData.ApRssi = apRssi
+	jsonStaMeasData, _ := rc.JSONGetEntry(key, ".")
+	if jsonStaMeasData != "" {
+		prevStaMeasData := convertJsonToStaMeasData(jsonStaMeasData)
+		if prevStaMeasData != nil && prevStaMeasData.StaMacId == ownMacId &&
+			((len(prevStaMeasData.ApRssi) == 0 && len(apRssi) == 0) || reflect.DeepEqual(prevStaMeasData.ApRssi, apRssi)) {
+			return
+		}
+	}
+	_ = rc.JSONSetEntry(key, ".", convertStaMeasDataToJson(&staMeasData))
+}
+
 func newAssocStaSubscriptionCfg(sub *AssocStaSubscription, subId string) *sm.SubscriptionCfg {
 	reqWsUri := false
 	if sub.WebsockNotifConfig != nil {
@@ -1543,6 +2300,12 @@ func cleanUp() {
 	// Flush subscriptions
 	_ = subMgr.DeleteAllSubscriptions()
 
+	// Flush measurement configurations
+	measMutex.Lock()
+	measScheduleMap = map[string]time.Time{}
+	nextMeasurementConfigIdAvailable = 1
+	measMutex.Unlock()
+
 	// Flush all service data
 	rc.DBFlush(baseKey)
 
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -6228,6 +6229,322 @@ func testSubscriptionDelete(t *testing.T, subscriptionId string, expectSuccess b
 	}
 }
 
+func TestSuccessMeasurementConfig(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+	//post
+	measConfigId, expectedGetResp := testMeasurementConfigPost(t)
+	//get
+	testMeasurementConfigGet(t, measConfigId, expectedGetResp)
+	//list
+	testMeasurementConfigListGet(t, []string{measConfigId})
+	//put
+	expectedGetResp = testMeasurementConfigPut(t, measConfigId, true)
+	//get
+	testMeasurementConfigGet(t, measConfigId, expectedGetResp)
+	//delete
+	testMeasurementConfigDelete(t, measConfigId, true)
+	//list
+	testMeasurementConfigListGet(t, []string{})
+	terminateScenario()
+}
+
+func TestFailMeasurementConfig(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post with missing measurement info
+	measConfig := MeasurementConfig{nil, "myMeasId", nil, []StaIdentity{{MacId: "101002000000"}}}
+	body, err := json.Marshal(measConfig)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	_, err = sendRequest(http.MethodPost, "/measurements", bytes.NewBuffer(body), nil, nil, http.StatusBadRequest, MeasurementsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	//get
+	testMeasurementConfigGet(t, "invalidMeasConfigId", "")
+	//put
+	_ = testMeasurementConfigPut(t, "invalidMeasConfigId", false)
+	//delete
+	testMeasurementConfigDelete(t, "invalidMeasConfigId", false)
+	terminateScenario()
+}
+
+func TestMeasurementReport(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Beacon reporting conditions
+	rcpi := getRcpi(60)
+	rsni := getRsni(60)
+	refRcpi := getRcpi(50)
+	refRsni := getRsni(50)
+	if rcpi <= refRcpi || rsni <= refRsni {
+		t.Fatalf("RCPI & RSNI must increase with RSSI")
+	}
+	if getRcpi(wifiRssiMin) != 60 || getRcpi(wifiRssiMax) != 160 {
+		t.Fatalf("Unexpected RCPI range")
+	}
+	if !isBeaconReportRequired(&BeaconReportingConfig{0, 0}, rcpi, rsni, refRcpi, refRsni) {
+		t.Fatalf("Report expected after each measurement")
+	}
+	if !isBeaconReportRequired(&BeaconReportingConfig{1, rcpi - 1}, rcpi, rsni, refRcpi, refRsni) ||
+		isBeaconReportRequired(&BeaconReportingConfig{1, rcpi}, rcpi, rsni, refRcpi, refRsni) {
+		t.Fatalf("Unexpected RCPI threshold result")
+	}
+	if !isBeaconReportRequired(&BeaconReportingConfig{5, 0}, rcpi, rsni, refRcpi, refRsni) ||
+		isBeaconReportRequired(&BeaconReportingConfig{6, 0}, rcpi, rsni, refRcpi, refRsni) {
+		t.Fatalf("Unexpected RCPI offset result")
+	}
+	if !isBeaconReportRequired(&BeaconReportingConfig{9, rcpi - refRcpi}, rcpi, rsni, refRcpi, refRsni) ||
+		isBeaconReportRequired(&BeaconReportingConfig{10, -1}, rcpi, rsni, refRcpi, refRsni) {
+		t.Fatalf("Unexpected range result")
+	}
+
+	// STA statistics
+	staInfo := StaInfo{
+		StaId:        &StaIdentity{MacId: "101002000000"},
+		ApAssociated: &ApAssociated{Bssid: "0050C272800A"},
+		Rssi:         &Rssi{40},
+		StaDataRate:  &StaDataRate{StaLastDataDownlinkRate: 12000, StaLastDataUplinkRate: 6000},
+	}
+	staStatistics := getStaStatistics(&StaStatisticsConfig{GroupIdentity: 0}, &staInfo, 1000)
+	if staStatistics == nil || staStatistics.GroupZeroData == nil {
+		t.Fatalf("Failed to get group 0 statistics")
+	}
+	if staStatistics.GroupZeroData.ReceivedFragmentCount != 2*staStatistics.GroupZeroData.TransmittedFrameCount {
+		t.Fatalf("Unexpected frame counts")
+	}
+	if staStatistics.GroupZeroData.FailedCount == 0 || staStatistics.GroupZeroData.FcsErrorCount == 0 {
+		t.Fatalf("Expected frame errors for weak signal")
+	}
+	staStatistics = getStaStatistics(&StaStatisticsConfig{GroupIdentity: 3}, &staInfo, 1000)
+	if staStatistics == nil || staStatistics.Group2to9Data == nil || staStatistics.Group2to9Data.QosTransmittedFrameCount != 0 {
+		t.Fatalf("Expected no traffic for TID 1")
+	}
+
+	// Triggered STA statistics
+	conf := StaStatisticsConfig{GroupIdentity: 1, TriggeredReport: true, TriggerCondition: &StaCounterTriggerCondition{RetryCountThreshold: math.MaxInt32}}
+	if getStaStatistics(&conf, &staInfo, 1000) != nil {
+		t.Fatalf("Unexpected triggered report")
+	}
+	conf.TriggerCondition.RetryCountThreshold = 1
+	staStatistics = getStaStatistics(&conf, &staInfo, 1000)
+	if staStatistics == nil || staStatistics.GroupOneData.ReportingReasonStaCounters == nil || !staStatistics.GroupOneData.ReportingReasonStaCounters.Retry {
+		t.Fatalf("Expected triggered report")
+	}
+
+	// Measurement report
+	measData := &MeasurementData{
+		staInfoMap:   map[string]*StaInfo{"101002000000": &staInfo},
+		staApRssiMap: map[string]map[string]int32{"101002000000": {"0050C272800A": 40, "0050C272800B": 60}},
+		apLoadMap:    map[string]int32{"0050C272800A": 18000, "0050C272800B": 2000},
+	}
+	measInfo := MeasurementInfo{
+		BeaconRequestConf: &BeaconRequestConfig{BeaconReportingConf: &BeaconReportingConfig{5, 0}, ChannelId: 0, OperatingClass: 115},
+		ChannelLoadConf:   &ChannelLoadConfig{Channel: defaultApChannel, OperatingClass: 115},
+	}
+	measConfig := MeasurementConfig{nil, "myMeasId", &measInfo, []StaIdentity{{MacId: "101002000000"}}}
+	report := getMeasurementReport(&measConfig, measConfig.StaId[0], measData)
+	if report == nil || len(report.BeaconReport) != 1 || report.BeaconReport[0].Bssid != "0050C272800B" {
+		t.Fatalf("Failed to get expected beacon report")
+	}
+	if len(report.ChannelLoad) != 1 || report.ChannelLoad[0].ChannelLoad != int32(math.Round(20000.0*255/wifiChannelCapacityKbps)) {
+		t.Fatalf("Failed to get expected channel load")
+	}
+	if getMeasurementReport(&measConfig, StaIdentity{MacId: "unknown"}, measData) != nil {
+		t.Fatalf("Unexpected report for unknown STA")
+	}
+}
+
+func testMeasurementConfigPost(t *testing.T) (string, string) {
+
+	/******************************
+	 * request body section
+	 ******************************/
+	staId := []StaIdentity{{MacId: "101002000000"}}
+	measInfo := MeasurementInfo{ChannelLoadConf: &ChannelLoadConfig{Channel: defaultApChannel, OperatingClass: 115}}
+	measConfig := MeasurementConfig{nil, "myMeasId", &measInfo, staId}
+
+	body, err := json.Marshal(measConfig)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	rr, err := sendRequest(http.MethodPost, "/measurements", bytes.NewBuffer(body), nil, nil, http.StatusCreated, MeasurementsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody MeasurementConfig
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	self := respBody.Links.Self.Href
+	measConfigId := self[strings.LastIndex(self, "/")+1:]
+	expectedResponse := MeasurementConfig{&MeasurementConfigLinks{&LinkType{self}}, "myMeasId", &measInfo, staId}
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return measConfigId, string(expectedResponseStr)
+}
+
+func testMeasurementConfigPut(t *testing.T, measConfigId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedLinkType := LinkType{"/" + testSandboxName + "/wai/v2/measurements/" + measConfigId}
+	staId := []StaIdentity{{MacId: "101002000000"}}
+	measInfo := MeasurementInfo{
+		MeasurementDuration: 2000,
+		StaStatisticsConf:   &StaStatisticsConfig{GroupIdentity: 0},
+	}
+	expectedResponse := MeasurementConfig{&MeasurementConfigLinks{&expectedLinkType}, "myMeasId2", &measInfo, staId}
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["measurementConfigId"] = measConfigId
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPut, "/measurements", bytes.NewBuffer(expectedResponseStr), vars, nil, http.StatusOK, MeasurementsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPut, "/measurements", bytes.NewBuffer(expectedResponseStr), vars, nil, http.StatusNotFound, MeasurementsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testMeasurementConfigGet(t *testing.T, measConfigId string, expectedResponse string) {
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["measurementConfigId"] = measConfigId
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	if expectedResponse == "" {
+		_, err := sendRequest(http.MethodGet, "/measurements", nil, vars, nil, http.StatusNotFound, MeasurementsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/measurements", nil, vars, nil, http.StatusOK, MeasurementsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testMeasurementConfigListGet(t *testing.T, expectedMeasConfigIds []string) {
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	rr, err := sendRequest(http.MethodGet, "/measurements", nil, nil, nil, http.StatusOK, MeasurementLinkListMeasurementsGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody MeasurementConfigLinkList
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if len(respBody.MeasurementConfig) != len(expectedMeasConfigIds) {
+		t.Fatalf("Failed to get expected number of measurement configurations")
+	}
+	for i, measConfigId := range expectedMeasConfigIds {
+		if !strings.HasSuffix(respBody.MeasurementConfig[i].Href, "/measurements/"+measConfigId) {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testMeasurementConfigDelete(t *testing.T, measConfigId string, expectSuccess bool) {
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["measurementConfigId"] = measConfigId
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	expectedCode := http.StatusNoContent
+	if !expectSuccess {
+		expectedCode = http.StatusNotFound
+	}
+	_, err := sendRequest(http.MethodDelete, "/measurements", nil, vars, nil, expectedCode, MeasurementsDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
 /*
 func TestExpiryNotification(t *testing.T) {
 
@@ -477,6 +477,10 @@ func deployRunScriptsAndGetFlags(targetName string, chart string, cobraCmd *cobr
 		if authEnabled {
 			flags = utils.HelmFlags(flags, "--set", authUrlAnnotation+"="+authUrl+"?svc=meep-platform-ctrl")
 		}
+		sessionKeySecret := utils.RepoCfg.GetString("repo.deployment.auth.session.key-secret")
+		if sessionKeySecret != "" {
+			flags = utils.HelmFlags(flags, "--set", "image.envSecret.MEEP_SESSION_KEY.name="+sessionKeySecret)
+		}
 		gcTarget := "repo.deployment.gc"
 		gcEnabled := utils.RepoCfg.GetBool(gcTarget + ".enabled")
 		if gcEnabled {
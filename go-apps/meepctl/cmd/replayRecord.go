@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	sandbox "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+)
+
+// replayRecordCmd represents the replay record command
+var replayRecordCmd = &cobra.Command{
+	Use:   `record <filename> ["description"]`,
+	Short: "Records events sent to the active scenario into a replay file",
+	Long: `Records events sent to the active scenario into a replay file.
+
+Recording starts immediately and captures every event processed by the sandbox
+(UI, API, GIS automation & PDU sessions) until stopped using the --stop flag.
+The replay file is stored when recording stops.`,
+	Args: cobra.RangeArgs(1, 2),
+	Example: `  # Start recording
+    meepctl replay record myfilename "description-of-the-content (string)"
+  # Stop recording & store replay file
+    meepctl replay record myfilename --stop`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		v, _ := cmd.Flags().GetBool("verbose")
+		stop, _ := cmd.Flags().GetBool("stop")
+		if v {
+			fmt.Println("Replay record called")
+			fmt.Println("[flag] verbose:", v)
+			fmt.Println("[flag] stop:", stop)
+		}
+
+		if stop {
+			replayRecordStop(cmd, args[0])
+		} else {
+			desc := ""
+			if len(args) == 2 {
+				desc = args[1]
+			}
+			replayRecordStart(cmd, args[0], desc)
+		}
+	},
+}
+
+func init() {
+	setSandboxFlag(replayRecordCmd)
+	replayRecordCmd.Flags().Bool("stop", false, "Stop recording & store replay file")
+	replayCmd.AddCommand(replayRecordCmd)
+}
+
+func replayRecordStart(cobraCmd *cobra.Command, filename string, description string) {
+	verbose, _ := cobraCmd.Flags().GetBool("verbose")
+
+	client, err := createClient(getBasePath(cobraCmd))
+	if err != nil {
+		printError("Error creating client: ", err, verbose)
+		return
+	}
+
+	var replayInfo sandbox.ReplayInfo
+	replayInfo.Description = description
+	opts := sandbox.StartReplayRecordingOpts{ReplayInfo: optional.NewInterface(replayInfo)}
+
+	_, err = client.EventReplayApi.StartReplayRecording(context.TODO(), filename, &opts)
+	if err != nil {
+		printError("Error: ", err, verbose)
+	} else {
+		if verbose {
+			fmt.Println("Command successful")
+		}
+	}
+}
+
+func replayRecordStop(cobraCmd *cobra.Command, filename string) {
+	verbose, _ := cobraCmd.Flags().GetBool("verbose")
+
+	client, err := createClient(getBasePath(cobraCmd))
+	if err != nil {
+		printError("Error creating client: ", err, verbose)
+		return
+	}
+
+	_, err = client.EventReplayApi.StopReplayRecording(context.TODO(), filename)
+	if err != nil {
+		printError("Error: ", err, verbose)
+	} else {
+		if verbose {
+			fmt.Println("Command successful")
+		}
+	}
+}
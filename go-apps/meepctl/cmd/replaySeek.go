@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	sandbox "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
+)
+
+// replaySeekCmd represents the replay seek command
+var replaySeekCmd = &cobra.Command{
+	Use:   "seek <filename>",
+	Short: "Moves execution of an auto-replay",
+	Long: `Moves execution of an auto-replay to the provided event index or replay time (ms).
+Events between the current and new position are not played.`,
+	Args:    cobra.ExactValidArgs(1),
+	Example: "meepctl replay seek myfilename --timestamp 2400000",
+	Run: func(cmd *cobra.Command, args []string) {
+
+		v, _ := cmd.Flags().GetBool("verbose")
+		i, _ := cmd.Flags().GetInt32("index")
+		t, _ := cmd.Flags().GetInt32("timestamp")
+		if v {
+			fmt.Println("Replay seek called")
+			fmt.Println("[flag] verbose:", v)
+			fmt.Println("[flag] index:", i)
+			fmt.Println("[flag] timestamp:", t)
+		}
+
+		replaySeek(cmd, args[0])
+	},
+}
+
+func init() {
+	setSandboxFlag(replaySeekCmd)
+	replaySeekCmd.Flags().Int32P("index", "i", 0, "Index of the next event to play")
+	replaySeekCmd.Flags().Int32("timestamp", 0, "Replay time (ms) to move to")
+	replayCmd.AddCommand(replaySeekCmd)
+}
+
+func replaySeek(cobraCmd *cobra.Command, filename string) {
+	verbose, _ := cobraCmd.Flags().GetBool("verbose")
+
+	// Exactly one of index or time must be provided
+	indexSet := cobraCmd.Flags().Changed("index")
+	timeSet := cobraCmd.Flags().Changed("timestamp")
+	if indexSet == timeSet {
+		fmt.Println("Either --index or --timestamp must be provided")
+		return
+	}
+	opts := new(sandbox.SeekReplayFileOpts)
+	if indexSet {
+		index, _ := cobraCmd.Flags().GetInt32("index")
+		opts.Index = optional.NewInt32(index)
+	} else {
+		t, _ := cobraCmd.Flags().GetInt32("timestamp")
+		opts.Time = optional.NewInt32(t)
+	}
+
+	client, err := createClient(getBasePath(cobraCmd))
+	if err != nil {
+		printError("Error creating client: ", err, verbose)
+		return
+	}
+
+	_, err = client.EventReplayApi.SeekReplayFile(context.TODO(), filename, opts)
+	if err != nil {
+		printError("Error: ", err, verbose)
+	} else {
+		if verbose {
+			fmt.Println("Command successful")
+		}
+	}
+}
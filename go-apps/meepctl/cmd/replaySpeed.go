@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// replaySpeedCmd represents the replay speed command
+var replaySpeedCmd = &cobra.Command{
+	Use:     "speed <filename> <factor>",
+	Short:   "Sets playback speed of an auto-replay",
+	Long:    "Sets playback speed factor of an auto-replay (0.1 to 10).",
+	Args:    cobra.ExactValidArgs(2),
+	Example: "meepctl replay speed myfilename 4",
+	Run: func(cmd *cobra.Command, args []string) {
+
+		v, _ := cmd.Flags().GetBool("verbose")
+		if v {
+			fmt.Println("Replay speed called")
+			fmt.Println("[flag] verbose:", v)
+		}
+
+		replaySpeed(cmd, args[0], args[1])
+	},
+}
+
+func init() {
+	setSandboxFlag(replaySpeedCmd)
+	replayCmd.AddCommand(replaySpeedCmd)
+}
+
+func replaySpeed(cobraCmd *cobra.Command, filename string, factor string) {
+	verbose, _ := cobraCmd.Flags().GetBool("verbose")
+
+	speed, err := strconv.ParseFloat(factor, 32)
+	if err != nil {
+		fmt.Println("Invalid speed factor: " + factor)
+		return
+	}
+
+	client, err := createClient(getBasePath(cobraCmd))
+	if err != nil {
+		printError("Error creating client: ", err, verbose)
+		return
+	}
+
+	_, err = client.EventReplayApi.SetReplaySpeed(context.TODO(), filename, float32(speed))
+	if err != nil {
+		printError("Error: ", err, verbose)
+	} else {
+		if verbose {
+			fmt.Println("Command successful")
+		}
+	}
+}
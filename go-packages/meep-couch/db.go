@@ -144,6 +144,22 @@ func (dbCon *Connector) GetDocList() (docNameList []string, docList [][]byte, er
 	return docNameList, docList, nil
 }
 
+// getDocNameList - Get document name list from DB
+func (dbCon *Connector) GetDocNameList() (docNameList []string, err error) {
+	log.Debug("Get all doc names from DB")
+	rows, err := dbCon.dbHandle.AllDocs(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+
+	// Loop through docs and populate doc name list to return
+	for rows.Next() {
+		docNameList = append(docNameList, rows.ID())
+	}
+
+	return docNameList, nil
+}
+
 // addDoc - Add scenario to DB
 func (dbCon *Connector) AddDoc(docName string, doc []byte) (string, error) {
 	log.Debug("Add new doc to DB: " + docName)
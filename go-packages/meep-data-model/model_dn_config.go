@@ -31,4 +31,6 @@ type DnConfig struct {
 	Ladn bool `json:"ladn,omitempty"`
 	// Edge Compute Service Provider
 	Ecsp string `json:"ecsp,omitempty"`
+	// Names of the network slices through which the data network is reachable; reachable through any network slice if not set
+	NetworkSlices []string `json:"networkSlices,omitempty"`
 }
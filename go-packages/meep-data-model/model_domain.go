@@ -46,4 +46,5 @@ type Domain struct {
 	UserMeta             map[string]string     `json:"userMeta,omitempty"`
 	CellularDomainConfig *CellularDomainConfig `json:"cellularDomainConfig,omitempty"`
 	Zones                []Zone                `json:"zones,omitempty"`
+	HandoverConfig       *HandoverConfig       `json:"handoverConfig,omitempty"`
 }
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Gilbert-Elliott correlated packet loss model parameters. The good to bad state transition probability is derived for each flow so that the average loss matches the flow packet loss percentage.
+type GilbertElliottLoss struct {
+	// Bad to good state transition probability percentage
+	R float64 `json:"r,omitempty"`
+	// Packet loss probability percentage in bad state (1-h)
+	LossBad float64 `json:"lossBad,omitempty"`
+	// Packet loss probability percentage in good state (1-k)
+	LossGood float64 `json:"lossGood,omitempty"`
+}
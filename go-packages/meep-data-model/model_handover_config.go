@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// UE handover policy configuration
+type HandoverConfig struct {
+	// Handover policy used for POA selection; defaults to DISTANCE
+	Policy string `json:"policy,omitempty"`
+	// A3 event offset (in dB)
+	A3Offset float32 `json:"a3Offset,omitempty"`
+	// A3 event hysteresis (in dB)
+	Hysteresis float32 `json:"hysteresis,omitempty"`
+	// A3 event time-to-trigger (in ms)
+	TimeToTrigger int32 `json:"timeToTrigger,omitempty"`
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Network characteristics profile. Samples are played back while the scenario is running, each sample replacing the element latency, latency variation, throughput & packet loss for the profile interval.
+type NetCharProfile struct {
+	// Time between profile samples in ms
+	Interval int32 `json:"interval,omitempty"`
+	// Restart profile playback from the first sample after the last sample; last sample values are kept otherwise
+	Loop bool `json:"loop,omitempty"`
+	// Profile samples played back in order
+	Samples []NetCharProfileSample `json:"samples,omitempty"`
+	// CSV trace used when no samples are provided; one sample per line with columns latency,latencyVariation,throughputDl,throughputUl,packetLoss. Header & comment (#) lines are ignored.
+	Trace string `json:"trace,omitempty"`
+}
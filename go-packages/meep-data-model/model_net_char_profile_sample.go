@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Network characteristics profile sample
+type NetCharProfileSample struct {
+	// Latency in ms
+	Latency int32 `json:"latency,omitempty"`
+	// Latency variation in ms
+	LatencyVariation int32 `json:"latencyVariation,omitempty"`
+	// Downlink throughput limit in Mbps
+	ThroughputDl int32 `json:"throughputDl,omitempty"`
+	// Uplink throughput limit in Mbps
+	ThroughputUl int32 `json:"throughputUl,omitempty"`
+	// Packet loss percentage
+	PacketLoss float64 `json:"packetLoss,omitempty"`
+}
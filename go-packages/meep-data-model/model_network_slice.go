@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// NetworkSlice - Network slice object
+type NetworkSlice struct {
+	// Unique network slice ID
+	Id string `json:"id,omitempty"`
+	// Network slice name
+	Name   string  `json:"name,omitempty"`
+	Snssai *Snssai `json:"snssai,omitempty"`
+	// Percentage of POA throughput allocated to the network slice. Slice flows are limited to this share on POAs serving the slice; flows outside a slice share the remaining POA throughput
+	CapacityShare float64 `json:"capacityShare,omitempty"`
+}
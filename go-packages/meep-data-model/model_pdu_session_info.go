@@ -26,4 +26,10 @@ package model
 type PduSessionInfo struct {
 	// Data Network Name as defined in the scenario
 	Dnn string `json:"dnn,omitempty"`
+	// Traffic weight applied to flows using this PDU session; overrides process traffic weight
+	TrafficWeight int32 `json:"trafficWeight,omitempty"`
+	// Traffic priority level applied to flows using this PDU session; overrides process traffic priority
+	TrafficPriority int32       `json:"trafficPriority,omitempty"`
+	Qos             *QosProfile `json:"qos,omitempty"`
+	Snssai          *Snssai     `json:"snssai,omitempty"`
 }
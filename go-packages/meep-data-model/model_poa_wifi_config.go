@@ -26,5 +26,6 @@ package model
 // WIFI POA configuration information
 type PoaWifiConfig struct {
 	// WIFI POA MAC Address
-	MacId string `json:"macId,omitempty"`
+	MacId       string             `json:"macId,omitempty"`
+	Propagation *PropagationConfig `json:"propagation,omitempty"`
 }
@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// An area in coordinate space bounded by linear rings (GeoJSON); a linear ring is a closed array of four or more positions
+type Polygon struct {
+	// Must be Polygon
+	Type_ string `json:"type"`
+	// For a Polygon, coordinates is an array of linear rings; the first ring is the exterior boundary and a position is an array of two decimal numbers (longitude and latitude precisely in that order)
+	Coordinates [][][]float32 `json:"coordinates,omitempty"`
+}
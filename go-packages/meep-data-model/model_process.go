@@ -69,4 +69,8 @@ type Process struct {
 	AppPacketLoss float64 `json:"appPacketLoss,omitempty"`
 	// Identifier used for process placement in AdvantEDGE cluster
 	PlacementId string `json:"placementId,omitempty"`
+	// Traffic weight used by the WEIGHTED_FAIR_SHARE & STRICT_PRIORITY network characteristics algorithms to share bandwidth between flows. Default value is 1.
+	TrafficWeight int32 `json:"trafficWeight,omitempty"`
+	// Traffic priority level used by the STRICT_PRIORITY network characteristics algorithm; lower values have higher priority. Flows without priority level have the lowest priority.
+	TrafficPriority int32 `json:"trafficPriority,omitempty"`
 }
@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Replay condition object
+type ReplayCondition struct {
+	// Condition type: <li>UE_ATTACHED: UE is connected to POA <li>UE_IN_RANGE: POA is in range of UE <p>Condition is evaluated using the GIS cache
+	Type_ string `json:"type,omitempty"`
+	// UE name
+	Ue string `json:"ue,omitempty"`
+	// POA name
+	Poa string `json:"poa,omitempty"`
+	// Maximum time (ms) to wait for a wait-until condition to be met before aborting the replay (0 = no timeout)
+	Timeout int32 `json:"timeout,omitempty"`
+}
@@ -37,4 +37,10 @@ type ReplayStatus struct {
 	TimeRemaining int32 `json:"timeRemaining,omitempty"`
 	// Time remaining until the next event for the replay file after last event
 	TimeToNextEvent int32 `json:"timeToNextEvent,omitempty"`
+	// Replay execution is paused
+	Paused bool `json:"paused,omitempty"`
+	// Playback speed factor
+	Speed float32 `json:"speed,omitempty"`
+	// Current replay time (ms)
+	Time int32 `json:"time,omitempty"`
 }
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Structural differences between two scenario versions
+type ScenarioDiff struct {
+	// Scenario name
+	ScenarioName string `json:"scenarioName,omitempty"`
+	// Version compared from
+	FromVersion int32 `json:"fromVersion,omitempty"`
+	// Version compared to
+	ToVersion int32 `json:"toVersion,omitempty"`
+	// Nodes present only in the version compared to
+	AddedNodes []ScenarioNodeDiff `json:"addedNodes,omitempty"`
+	// Nodes present only in the version compared from
+	RemovedNodes []ScenarioNodeDiff `json:"removedNodes,omitempty"`
+	// Nodes present in both versions with modified attributes
+	ModifiedNodes []ScenarioNodeDiff `json:"modifiedNodes,omitempty"`
+	// Nodes present in both versions with modified network characteristics
+	NetCharChanges []ScenarioNetCharDiff `json:"netCharChanges,omitempty"`
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Scenario version object
+type ScenarioVersion struct {
+	// Scenario name
+	ScenarioName string `json:"scenarioName,omitempty"`
+	// Scenario version number
+	Version int32 `json:"version,omitempty"`
+	// Author of the scenario version
+	Author string `json:"author,omitempty"`
+	// Time when the scenario version was created (RFC 3339)
+	Timestamp string `json:"timestamp,omitempty"`
+	// Scenario version description
+	Description string    `json:"description,omitempty"`
+	Scenario    *Scenario `json:"scenario,omitempty"`
+}
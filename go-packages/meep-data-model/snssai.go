@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// S-NSSAI limits (3GPP TS 23.003)
+const (
+	SST_MIN                    = 0
+	SST_MAX                    = 255
+	REGEX_SLICE_DIFFERENTIATOR = `^[0-9a-fA-F]{6}$`
+)
+
+// ValidateSnssai - Validate the provided S-NSSAI
+func ValidateSnssai(snssai *Snssai) error {
+	if snssai.Sst < SST_MIN || snssai.Sst > SST_MAX {
+		return fmt.Errorf("Invalid SST: %d not in valid range: [%d - %d]", snssai.Sst, SST_MIN, SST_MAX)
+	}
+	if snssai.Sd != "" {
+		matched, err := regexp.MatchString(REGEX_SLICE_DIFFERENTIATOR, snssai.Sd)
+		if err != nil || !matched {
+			return errors.New("SD must be 6 hexadecimal digits")
+		}
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
@@ -47,14 +48,16 @@ const profiling = false
 var profilingTimers map[string]time.Time
 
 const (
-	FieldPosition  = "position"
-	FieldPath      = "path"
-	FieldMode      = "mode"
-	FieldVelocity  = "velocity"
-	FieldConnected = "connected"
-	FieldPriority  = "priority"
-	FieldSubtype   = "subtype"
-	FieldRadius    = "radius"
+	FieldPosition    = "position"
+	FieldPath        = "path"
+	FieldMode        = "mode"
+	FieldVelocity    = "velocity"
+	FieldConnected   = "connected"
+	FieldPriority    = "priority"
+	FieldSubtype     = "subtype"
+	FieldRadius      = "radius"
+	FieldPropagation = "propagation"
+	FieldHandover    = "handover"
 )
 
 const (
@@ -102,14 +105,15 @@ type D2DMeasurement struct {
 }
 
 type PoaMeasurement struct {
-	Poa      string
-	SubType  string
-	Radius   float32
-	Distance float32
-	InRange  bool
-	Rssi     float32
-	Rsrp     float32
-	Rsrq     float32
+	Poa         string
+	SubType     string
+	Radius      float32
+	Distance    float32
+	InRange     bool
+	Rssi        float32
+	Rsrp        float32
+	Rsrq        float32
+	Propagation *Propagation
 }
 
 type Ue struct {
@@ -131,14 +135,18 @@ type Ue struct {
 	D2DInRange      []string
 	D2DMeasurements map[string]*D2DMeasurement
 	PoaMeasurements map[string]*PoaMeasurement
+	Handover        Handover
+	HoCandidate     string
+	HoCandidateTime time.Time
 }
 
 type Poa struct {
-	Id       string
-	Name     string
-	SubType  string
-	Position string
-	Radius   float32
+	Id          string
+	Name        string
+	SubType     string
+	Position    string
+	Radius      float32
+	Propagation *Propagation
 }
 
 type Compute struct {
@@ -161,6 +169,9 @@ type AssetMgr struct {
 	db        *sql.DB
 	connected bool
 	updateCb  func(string, string)
+	hoCb      func(string)
+	hoTimers  map[string]*time.Timer
+	hoMutex   sync.Mutex
 }
 
 type CoordinatePowerValue struct {
@@ -207,6 +218,7 @@ func NewAssetMgr(name, namespace, user, pwd, host, port string) (am *AssetMgr, e
 	am.pwd = pwd
 	am.host = host
 	am.port = port
+	am.hoTimers = make(map[string]*time.Timer)
 
 	// Connect to Postgis DB
 	for retry := 0; retry <= DbMaxRetryCount; retry++ {
@@ -288,9 +300,18 @@ func (am *AssetMgr) notifyListener(cbType string, assetName string) {
 	}
 }
 
+// SetHandoverListener - Set listener invoked when a pending UE handover candidate is re-evaluated
+func (am *AssetMgr) SetHandoverListener(listener func(string)) error {
+	am.hoCb = listener
+	return nil
+}
+
 // DeleteAssetMgr -
 func (am *AssetMgr) DeleteAssetMgr() (err error) {
 
+	// Stop pending handover checks
+	am.stopHandoverChecks()
+
 	if am.db == nil {
 		err = errors.New("Asset Manager database not initialized")
 		log.Error(err.Error())
@@ -365,6 +386,12 @@ func (am *AssetMgr) CreateTables() (err error) {
 		d2d_in_range    varchar(100)[]          NOT NULL DEFAULT array[]::varchar[],
 		connected       boolean                 NOT NULL DEFAULT 'false',
 		start_time      timestamptz             NOT NULL DEFAULT now(),
+		ho_policy       varchar(20)             NOT NULL DEFAULT '',
+		ho_a3_offset    decimal(10,1)           NOT NULL DEFAULT '0.0',
+		ho_hysteresis   decimal(10,1)           NOT NULL DEFAULT '0.0',
+		ho_ttt          integer                 NOT NULL DEFAULT '0',
+		ho_candidate    varchar(100)            NOT NULL DEFAULT '',
+		ho_candidate_ts timestamptz             NOT NULL DEFAULT now(),
 		PRIMARY KEY (id)
 	)`)
 	if err != nil {
@@ -418,6 +445,12 @@ func (am *AssetMgr) CreateTables() (err error) {
 		type            varchar(20)             NOT NULL DEFAULT '',
 		radius          decimal(10,1)           NOT NULL DEFAULT '0.0',
 		position        geometry(POINT,4326)    NOT NULL,
+		prop_model      varchar(20)             NOT NULL DEFAULT '',
+		tx_power        decimal(10,1)           DEFAULT NULL,
+		frequency       decimal(10,1)           NOT NULL DEFAULT '0.0',
+		antenna_height  decimal(10,1)           NOT NULL DEFAULT '0.0',
+		ue_height       decimal(10,1)           NOT NULL DEFAULT '0.0',
+		path_loss_exp   decimal(10,2)           NOT NULL DEFAULT '0.00',
 		PRIMARY KEY (id)
 	)`)
 	if err != nil {
@@ -479,6 +512,7 @@ func (am *AssetMgr) CreateUe(id string, name string, data map[string]interface{}
 	var connected bool
 	var d2dRadius float32
 	var priority string
+	var handover *Handover
 	var ok bool
 
 	// Validate input
@@ -542,6 +576,18 @@ func (am *AssetMgr) CreateUe(id string, name string, data map[string]interface{}
 		}
 	}
 
+	// Get handover policy (optional)
+	if dataHandover, found := data[FieldHandover]; found {
+		if handover, ok = dataHandover.(*Handover); !ok {
+			return errors.New("Invalid handover data type")
+		}
+	}
+	if handover == nil {
+		handover = &Handover{Policy: HoPolicyDistance}
+	} else if !IsSupportedHandoverPolicy(handover.Policy) {
+		return errors.New("Unsupported handover policy: " + handover.Policy)
+	}
+
 	if path != "" {
 		// Validate Path parameters
 		if mode == "" {
@@ -549,9 +595,11 @@ func (am *AssetMgr) CreateUe(id string, name string, data map[string]interface{}
 		}
 
 		// Create UE entry with path
-		query := `INSERT INTO ` + UeTable + ` (id, name, position, path, path_json, path_mode, path_velocity, poa_type_prio, d2d_radius, connected)
-			VALUES ($1, $2, ST_GeomFromGeoJSON('` + position + `'), ST_GeomFromGeoJSON('` + path + `'), $3, $4, $5, $6, $7, $8)`
-		_, err = am.db.Exec(query, id, name, path, mode, velocity, pq.Array(priorityList), d2dRadius, connected)
+		query := `INSERT INTO ` + UeTable + ` (id, name, position, path, path_json, path_mode, path_velocity, poa_type_prio, d2d_radius, connected,
+				ho_policy, ho_a3_offset, ho_hysteresis, ho_ttt)
+			VALUES ($1, $2, ST_GeomFromGeoJSON('` + position + `'), ST_GeomFromGeoJSON('` + path + `'), $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+		_, err = am.db.Exec(query, id, name, path, mode, velocity, pq.Array(priorityList), d2dRadius, connected,
+			handover.Policy, handover.A3Offset, handover.Hysteresis, handover.TimeToTrigger)
 		if err != nil {
 			log.Error(err.Error())
 			return err
@@ -565,9 +613,11 @@ func (am *AssetMgr) CreateUe(id string, name string, data map[string]interface{}
 		}
 	} else {
 		// Create UE entry without path
-		query := `INSERT INTO ` + UeTable + ` (id, name, position, poa_type_prio, d2d_radius, connected)
-			VALUES ($1, $2, ST_GeomFromGeoJSON('` + position + `'), $3, $4, $5)`
-		_, err = am.db.Exec(query, id, name, pq.Array(priorityList), d2dRadius, connected)
+		query := `INSERT INTO ` + UeTable + ` (id, name, position, poa_type_prio, d2d_radius, connected,
+				ho_policy, ho_a3_offset, ho_hysteresis, ho_ttt)
+			VALUES ($1, $2, ST_GeomFromGeoJSON('` + position + `'), $3, $4, $5, $6, $7, $8, $9)`
+		_, err = am.db.Exec(query, id, name, pq.Array(priorityList), d2dRadius, connected,
+			handover.Policy, handover.A3Offset, handover.Hysteresis, handover.TimeToTrigger)
 		if err != nil {
 			log.Error(err.Error())
 			return err
@@ -600,6 +650,7 @@ func (am *AssetMgr) CreatePoa(id string, name string, data map[string]interface{
 	var subtype string
 	var position string
 	var radius float32
+	var prop *Propagation
 	var ok bool
 
 	// Validate input
@@ -635,10 +686,24 @@ func (am *AssetMgr) CreatePoa(id string, name string, data map[string]interface{
 		return errors.New("Invalid radius data type")
 	}
 
+	// Get propagation model (optional)
+	if dataProp, found := data[FieldPropagation]; found {
+		if prop, ok = dataProp.(*Propagation); !ok {
+			return errors.New("Invalid propagation data type")
+		}
+	}
+	if prop == nil {
+		prop = &Propagation{Model: PropModelLinear}
+	} else if !IsSupportedPropagationModel(prop.Model) {
+		return errors.New("Unsupported propagation model: " + prop.Model)
+	}
+
 	// Create POA entry
-	query := `INSERT INTO ` + PoaTable + ` (id, name, type, position, radius)
-		VALUES ($1, $2, $3, ST_GeomFromGeoJSON('` + position + `'), $4)`
-	_, err = am.db.Exec(query, id, name, subtype, radius)
+	query := `INSERT INTO ` + PoaTable + ` (id, name, type, position, radius,
+			prop_model, tx_power, frequency, antenna_height, ue_height, path_loss_exp)
+		VALUES ($1, $2, $3, ST_GeomFromGeoJSON('` + position + `'), $4, $5, $6, $7, $8, $9, $10)`
+	_, err = am.db.Exec(query, id, name, subtype, radius,
+		prop.Model, prop.TxPower, prop.Frequency, prop.AntennaHeight, prop.UeAntennaHeight, prop.PathLossExponent)
 	if err != nil {
 		log.Error(err.Error())
 		return err
@@ -851,6 +916,36 @@ func (am *AssetMgr) UpdateUe(name string, data map[string]interface{}) (err erro
 		}
 	}
 
+	// Update handover policy
+	if dataHandover, found := data[FieldHandover]; found {
+		if handover, ok := dataHandover.(*Handover); ok && handover != nil {
+			if !IsSupportedHandoverPolicy(handover.Policy) {
+				return errors.New("Unsupported handover policy: " + handover.Policy)
+			}
+
+			// Update handover policy & reset handover candidate
+			query := `UPDATE ` + UeTable + `
+			SET ho_policy = $2,
+				ho_a3_offset = $3,
+				ho_hysteresis = $4,
+				ho_ttt = $5,
+				ho_candidate = ''
+			WHERE name = ($1)`
+			_, err = am.db.Exec(query, name, handover.Policy, handover.A3Offset, handover.Hysteresis, handover.TimeToTrigger)
+			if err != nil {
+				log.Error(err.Error())
+				return err
+			}
+
+			// Refresh UE information
+			err = am.refreshUe(name)
+			if err != nil {
+				log.Error(err.Error())
+				return err
+			}
+		}
+	}
+
 	// Notify listener
 	am.notifyListener(TypeUe, name)
 
@@ -904,6 +999,30 @@ func (am *AssetMgr) UpdatePoa(name string, data map[string]interface{}) (err err
 		}
 	}
 
+	// Update propagation model
+	if dataProp, found := data[FieldPropagation]; found {
+		if prop, ok := dataProp.(*Propagation); ok && prop != nil {
+			if !IsSupportedPropagationModel(prop.Model) {
+				return errors.New("Unsupported propagation model: " + prop.Model)
+			}
+			// Update POA propagation model
+			query := `UPDATE ` + PoaTable + `
+				SET prop_model = $2,
+					tx_power = $3,
+					frequency = $4,
+					antenna_height = $5,
+					ue_height = $6,
+					path_loss_exp = $7
+				WHERE name = ($1)`
+			_, err = am.db.Exec(query, name, prop.Model, prop.TxPower, prop.Frequency,
+				prop.AntennaHeight, prop.UeAntennaHeight, prop.PathLossExponent)
+			if err != nil {
+				log.Error(err.Error())
+				return err
+			}
+		}
+	}
+
 	// Refresh all UE information
 	err = am.refreshAllUe()
 	if err != nil {
@@ -1086,7 +1205,8 @@ func (am *AssetMgr) GetPoa(name string) (poa *Poa, err error) {
 	// Get Poa entry
 	var rows *sql.Rows
 	rows, err = am.db.Query(`
-		SELECT id, name, type, ST_AsGeoJSON(position), radius
+		SELECT id, name, type, ST_AsGeoJSON(position), radius,
+			prop_model, tx_power, frequency, antenna_height, ue_height, path_loss_exp
 		FROM `+PoaTable+`
 		WHERE name = ($1)`, name)
 	if err != nil {
@@ -1098,7 +1218,10 @@ func (am *AssetMgr) GetPoa(name string) (poa *Poa, err error) {
 	// Scan result
 	for rows.Next() {
 		poa = new(Poa)
-		err = rows.Scan(&poa.Id, &poa.Name, &poa.SubType, &poa.Position, &poa.Radius)
+		poa.Propagation = new(Propagation)
+		err = rows.Scan(&poa.Id, &poa.Name, &poa.SubType, &poa.Position, &poa.Radius,
+			&poa.Propagation.Model, &poa.Propagation.TxPower, &poa.Propagation.Frequency,
+			&poa.Propagation.AntennaHeight, &poa.Propagation.UeAntennaHeight, &poa.Propagation.PathLossExponent)
 		if err != nil {
 			log.Error(err.Error())
 			return nil, err
@@ -1266,7 +1389,8 @@ func (am *AssetMgr) GetAllPoa() (poaMap map[string]*Poa, err error) {
 	// Get POA entries
 	var rows *sql.Rows
 	rows, err = am.db.Query(`
-		SELECT id, name, type, ST_AsGeoJSON(position), radius
+		SELECT id, name, type, ST_AsGeoJSON(position), radius,
+			prop_model, tx_power, frequency, antenna_height, ue_height, path_loss_exp
 		FROM ` + PoaTable)
 	if err != nil {
 		log.Error(err.Error())
@@ -1277,9 +1401,12 @@ func (am *AssetMgr) GetAllPoa() (poaMap map[string]*Poa, err error) {
 	// Scan results
 	for rows.Next() {
 		poa := new(Poa)
+		poa.Propagation = new(Propagation)
 
 		// Fill POA
-		err = rows.Scan(&poa.Id, &poa.Name, &poa.SubType, &poa.Position, &poa.Radius)
+		err = rows.Scan(&poa.Id, &poa.Name, &poa.SubType, &poa.Position, &poa.Radius,
+			&poa.Propagation.Model, &poa.Propagation.TxPower, &poa.Propagation.Frequency,
+			&poa.Propagation.AntennaHeight, &poa.Propagation.UeAntennaHeight, &poa.Propagation.PathLossExponent)
 		if err != nil {
 			log.Error(err.Error())
 			return poaMap, err
@@ -1364,6 +1491,9 @@ func (am *AssetMgr) DeleteUe(name string) (err error) {
 		return err
 	}
 
+	// Cancel pending handover check
+	am.scheduleHandoverChecks(map[string]time.Duration{name: 0})
+
 	// Notify listener
 	am.notifyListener(TypeUe, name)
 
@@ -1458,6 +1588,9 @@ func (am *AssetMgr) DeleteAllUe() (err error) {
 		return err
 	}
 
+	// Stop pending handover checks
+	am.stopHandoverChecks()
+
 	// Notify listener
 	am.notifyListener(TypeUe, "")
 
@@ -1773,11 +1906,13 @@ func (am *AssetMgr) parseUeInfo(name string, ueMap map[string]*Ue) (err error) {
 	var rows *sql.Rows
 	if name == "" {
 		rows, err = am.db.Query(`
-			SELECT ue.name, ue.poa_type_prio, ue.poa
+			SELECT ue.name, ue.poa_type_prio, ue.poa,
+				ue.ho_policy, ue.ho_a3_offset, ue.ho_hysteresis, ue.ho_ttt, ue.ho_candidate, ue.ho_candidate_ts
 			FROM ` + UeTable + ` AS ue`)
 	} else {
 		rows, err = am.db.Query(`
-			SELECT ue.name, ue.poa_type_prio, ue.poa
+			SELECT ue.name, ue.poa_type_prio, ue.poa,
+				ue.ho_policy, ue.ho_a3_offset, ue.ho_hysteresis, ue.ho_ttt, ue.ho_candidate, ue.ho_candidate_ts
 			FROM `+UeTable+` AS ue
 			WHERE ue.name = ($1)`, name)
 	}
@@ -1797,8 +1932,12 @@ func (am *AssetMgr) parseUeInfo(name string, ueMap map[string]*Ue) (err error) {
 		ueName := ""
 		poaTypePrio := []string{}
 		curPoa := ""
+		handover := Handover{}
+		hoCandidate := ""
+		hoCandidateTime := time.Time{}
 
-		err := rows.Scan(&ueName, pq.Array(&poaTypePrio), &curPoa)
+		err := rows.Scan(&ueName, pq.Array(&poaTypePrio), &curPoa,
+			&handover.Policy, &handover.A3Offset, &handover.Hysteresis, &handover.TimeToTrigger, &hoCandidate, &hoCandidateTime)
 		if err != nil {
 			log.Error(err.Error())
 			return err
@@ -1816,6 +1955,9 @@ func (am *AssetMgr) parseUeInfo(name string, ueMap map[string]*Ue) (err error) {
 			ue.D2DRadius = float32(0)
 			ue.D2DInRange = []string{}
 			ue.D2DMeasurements = map[string]*D2DMeasurement{}
+			ue.Handover = handover
+			ue.HoCandidate = hoCandidate
+			ue.HoCandidateTime = hoCandidateTime
 			ueMap[ueName] = ue
 		}
 	}
@@ -1980,14 +2122,16 @@ func (am *AssetMgr) parseUePoaInfo(name string, ueMap map[string]*Ue, poaMap map
 		rows, err = am.db.Query(`
 			SELECT ue.name, poa.name, poa.type, poa.radius,
 				ST_Distance(ue.position::geography, poa.position::geography),
-				ST_DWithin(ue.position::geography, poa.position::geography, poa.radius)
+				ST_DWithin(ue.position::geography, poa.position::geography, poa.radius),
+				poa.prop_model, poa.tx_power, poa.frequency, poa.antenna_height, poa.ue_height, poa.path_loss_exp
 			FROM ` + UeTable + `, ` + PoaTable + `
 			WHERE poa.radius > 0`)
 	} else {
 		rows, err = am.db.Query(`
 			SELECT ue.name, poa.name, poa.type, poa.radius,
 				ST_Distance(ue.position::geography, poa.position::geography),
-				ST_DWithin(ue.position::geography, poa.position::geography, poa.radius)
+				ST_DWithin(ue.position::geography, poa.position::geography, poa.radius),
+				poa.prop_model, poa.tx_power, poa.frequency, poa.antenna_height, poa.ue_height, poa.path_loss_exp
 			FROM `+UeTable+`, `+PoaTable+`
 			WHERE poa.radius > 0 AND ue.name = ($1)`, name)
 	}
@@ -2010,8 +2154,10 @@ func (am *AssetMgr) parseUePoaInfo(name string, ueMap map[string]*Ue, poaMap map
 		poaRadius := float32(0)
 		dist := float32(0)
 		inRange := false
+		prop := new(Propagation)
 
-		err := rows.Scan(&ueName, &poaName, &poaType, &poaRadius, &dist, &inRange)
+		err := rows.Scan(&ueName, &poaName, &poaType, &poaRadius, &dist, &inRange,
+			&prop.Model, &prop.TxPower, &prop.Frequency, &prop.AntennaHeight, &prop.UeAntennaHeight, &prop.PathLossExponent)
 		if err != nil {
 			log.Error(err.Error())
 			return err
@@ -2033,6 +2179,7 @@ func (am *AssetMgr) parseUePoaInfo(name string, ueMap map[string]*Ue, poaMap map
 		meas.SubType = poaType
 		meas.Radius = poaRadius
 		meas.Distance = dist
+		meas.Propagation = prop
 		if inRange {
 			meas.InRange = true
 			ue.PoaInRange = append(ue.PoaInRange, poaName)
@@ -2115,8 +2262,12 @@ func (am *AssetMgr) updateUeInfo(ueMap map[string]*Ue) (err error) {
 		log.Error(err.Error())
 		return err
 	}
+
+	// Schedule handover checks once transaction is committed
+	hoChecks := make(map[string]time.Duration)
 	defer func() {
 		_ = tx.Commit()
+		am.scheduleHandoverChecks(hoChecks)
 	}()
 
 	// !!! IMPORTANT NOTE !!!
@@ -2132,25 +2283,41 @@ func (am *AssetMgr) updateUeInfo(ueMap map[string]*Ue) (err error) {
 	}
 	sort.Strings(ueNames)
 
-	// For each UE, run POA Selection & Measurement calculations
+	// For each UE, run Measurement calculations & POA Selection
+	now := time.Now()
 	for _, ueName := range ueNames {
 		// Get UE info
 		ue := ueMap[ueName]
 
+		// Calculate power measurements
+		for poaName, meas := range ue.PoaMeasurements {
+			meas.Rssi, meas.Rsrp, meas.Rsrq = calculatePower(meas.SubType, meas.Radius, meas.Distance, meas.Propagation)
+			if meas.Rsrp == 0 && meas.Rsrq == 0 && meas.Rssi == 0 {
+				log.Error("ERROR: Zero Rsrp, Rsrq and Rssi should not happen: ", meas.SubType, "---", meas.Radius, "---", meas.Distance, "---", poaName, "---", ueName)
+			}
+		}
+
 		// Update POA Selection
-		selectedPoa := selectPoa(ue)
+		selectedPoa := selectPoa(ue, now)
 		poaDistance := float32(0)
 		if selectedPoa != "" {
 			poaDistance = ue.PoaMeasurements[selectedPoa].Distance
 		}
+		if ue.HoCandidateTime.IsZero() {
+			ue.HoCandidateTime = now
+		}
+		hoChecks[ueName] = getHandoverCheckDelay(ue, now)
 
 		query := `UPDATE ` + UeTable + `
 			SET poa = $2,
 				poa_distance = $3,
 				poa_in_range = $4,
-				d2d_in_range = $5
+				d2d_in_range = $5,
+				ho_candidate = $6,
+				ho_candidate_ts = $7
 			WHERE name = ($1)`
-		_, err = tx.Exec(query, ueName, selectedPoa, poaDistance, pq.Array(ue.PoaInRange), pq.Array(ue.D2DInRange))
+		_, err = tx.Exec(query, ueName, selectedPoa, poaDistance, pq.Array(ue.PoaInRange), pq.Array(ue.D2DInRange),
+			ue.HoCandidate, ue.HoCandidateTime)
 		if err != nil {
 			log.Error(err.Error())
 			return err
@@ -2158,11 +2325,7 @@ func (am *AssetMgr) updateUeInfo(ueMap map[string]*Ue) (err error) {
 
 		// Update POA measurements
 		for poaName, meas := range ue.PoaMeasurements {
-			// Calculate power measurements
-			rssi, rsrp, rsrq := calculatePower(meas.SubType, meas.Radius, meas.Distance)
-			if rsrp == 0 && rsrq == 0 && rssi == 0 {
-				log.Error("ERROR: Zero Rsrp, Rsrq and Rssi should not happen: ", meas.SubType, "---", meas.Radius, "---", meas.Distance, "---", poaName, "---", ueName)
-			}
+			rssi, rsrp, rsrq := meas.Rssi, meas.Rsrp, meas.Rsrq
 
 			// Add new entry or update existing one
 			id := ueName + "-" + poaName
@@ -2202,8 +2365,74 @@ func (am *AssetMgr) updateUeInfo(ueMap map[string]*Ue) (err error) {
 	return nil
 }
 
-// POA Selection Algorithm
-func selectPoa(ue *Ue) (selectedPoa string) {
+// Start or cancel UE timers used to re-evaluate pending handover candidates once time-to-trigger expires
+// NOTE: A delay of 0 cancels the pending handover check, if any
+func (am *AssetMgr) scheduleHandoverChecks(hoChecks map[string]time.Duration) {
+	am.hoMutex.Lock()
+	defer am.hoMutex.Unlock()
+
+	for ueName, delay := range hoChecks {
+		if timer, found := am.hoTimers[ueName]; found {
+			timer.Stop()
+			delete(am.hoTimers, ueName)
+		}
+		if delay > 0 {
+			name := ueName
+			var timer *time.Timer
+			timer = time.AfterFunc(delay, func() {
+				// Ignore expiry if check was rescheduled or cancelled in the meantime
+				am.hoMutex.Lock()
+				if am.hoTimers[name] != timer {
+					am.hoMutex.Unlock()
+					return
+				}
+				delete(am.hoTimers, name)
+				am.hoMutex.Unlock()
+
+				am.checkHandover(name)
+			})
+			am.hoTimers[name] = timer
+		}
+	}
+}
+
+// Stop all pending handover checks
+func (am *AssetMgr) stopHandoverChecks() {
+	am.hoMutex.Lock()
+	defer am.hoMutex.Unlock()
+
+	for ueName, timer := range am.hoTimers {
+		timer.Stop()
+		delete(am.hoTimers, ueName)
+	}
+}
+
+// Re-evaluate UE POA selection & notify handover listener
+func (am *AssetMgr) checkHandover(name string) {
+	err := am.refreshUe(name)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	if am.hoCb != nil {
+		am.hoCb(name)
+	}
+}
+
+// Get time until pending handover candidate time-to-trigger expires; 0 if no candidate is pending
+func getHandoverCheckDelay(ue *Ue, now time.Time) time.Duration {
+	if ue.HoCandidate == "" {
+		return 0
+	}
+	delay := ue.HoCandidateTime.Add(time.Duration(ue.Handover.TimeToTrigger) * time.Millisecond).Sub(now)
+	if delay < time.Millisecond {
+		delay = time.Millisecond
+	}
+	return delay
+}
+
+// Distance-based POA Selection Algorithm
+func selectPoaDistance(ue *Ue) (selectedPoa string) {
 
 	// Only evaluate POAs in range
 	if len(ue.PoaInRange) >= 1 {
@@ -2282,7 +2511,12 @@ func getPoaTypePriority(poaType string, poaTypePrio []string) int {
 	return priority
 }
 
-func calculatePower(subtype string, radius float32, distance float32) (rssi float32, rsrp float32, rsrq float32) {
+// Calculate power measurements using the POA propagation model; defaults to linear model
+func calculatePower(subtype string, radius float32, distance float32, prop *Propagation) (rssi float32, rsrp float32, rsrq float32) {
+	if model := NewPropagationModel(subtype, prop); model != nil {
+		return calculateModelPower(subtype, distance, prop, model)
+	}
+
 	switch subtype {
 	case PoaTypeCell4g:
 		rsrp, rsrq = calculateCell4gPower(radius, distance)
@@ -2432,6 +2666,40 @@ func (am *AssetMgr) GetWithinRangeBetweenPoints(srcCoordinates string, dstCoordi
 	return within, err
 }
 
+// Get within distance between a coordinate and a GeoJSON polygon
+// A distance of 0 checks if the coordinate is inside the polygon
+func (am *AssetMgr) GetWithinPolygonDistance(srcCoordinates string, polygon string, distance string) (bool, error) {
+	if profiling {
+		profilingTimers["distance - query"] = time.Now()
+	}
+
+	dbQuery := "SELECT ST_DWithin(" + "'SRID=4326;POINT" + srcCoordinates + "'::geography, ST_SetSRID(ST_GeomFromGeoJSON($1), 4326)::geography, " + distance + ");"
+
+	var rows *sql.Rows
+	rows, err := am.db.Query(dbQuery, polygon)
+	if err != nil {
+		log.Error(err.Error())
+		return false, err
+	}
+	defer rows.Close()
+
+	within := false
+
+	if rows.Next() {
+		err = rows.Scan(&within)
+		if err != nil {
+			log.Error(err.Error())
+			return within, err
+		}
+		return within, nil
+	}
+	err = rows.Err()
+	if err != nil {
+		log.Error(err)
+	}
+	return within, err
+}
+
 // Calculate RSRQ/RSRP for a given list of coordinates
 func (am *AssetMgr) GetPowerValuesForCoordinates(coordinates []Coordinate) ([]CoordinatePowerValue, error) {
 	poaMap, err := am.GetAllPoa()
@@ -2471,7 +2739,7 @@ func (am *AssetMgr) GetPowerValuesForCoordinates(coordinates []Coordinate) ([]Co
 				continue
 			}
 
-			_, rsrp, rsrq := calculatePower(poa.SubType, poaRadius, distance)
+			_, rsrp, rsrq := calculatePower(poa.SubType, poaRadius, distance, poa.Propagation)
 
 			if rsrp > maxRsrp {
 				maxRsrp = rsrp
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 )
@@ -1607,6 +1608,54 @@ func TestAssetMgrWithinRange(t *testing.T) {
 	}
 }
 
+func TestAssetMgrWithinPolygon(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Create Connector
+	fmt.Println("Create valid GIS Asset Manager")
+	am, err := NewAssetMgr(amName, amNamespace, amDBUser, amDBPwd, amDBHost, amDBPort)
+	if err != nil || am == nil {
+		t.Fatalf("Failed to create GIS Asset Manager")
+	}
+
+	// create coordinates & polygon
+	fmt.Println("Coordinates & polygon creation")
+
+	polygon := "{\"type\":\"Polygon\",\"coordinates\":[[[7.4215,43.7362],[7.4221,43.7362],[7.4221,43.7368],[7.4215,43.7368],[7.4215,43.7362]]]}"
+	coordIn := "(7.421802 43.736515)"
+	coordOut := "(7.4187 43.732403)"
+	distanceIn := "0"
+	distanceOut := "300" // coordOut is more than 300m away from the polygon
+	distanceNear := "600"
+
+	within, _ := am.GetWithinPolygonDistance(coordIn, polygon, distanceIn)
+	if !within {
+		t.Fatalf("Expected within polygon")
+	}
+
+	within, _ = am.GetWithinPolygonDistance(coordOut, polygon, distanceIn)
+	if within {
+		t.Fatalf("Not expected within polygon")
+	}
+
+	within, _ = am.GetWithinPolygonDistance(coordOut, polygon, distanceOut)
+	if within {
+		t.Fatalf("Not expected within polygon distance")
+	}
+
+	within, _ = am.GetWithinPolygonDistance(coordOut, polygon, distanceNear)
+	if !within {
+		t.Fatalf("Expected within polygon distance")
+	}
+
+	// Invalid polygon
+	_, err = am.GetWithinPolygonDistance(coordIn, "{\"type\":\"Polygon\"}", distanceIn)
+	if err == nil {
+		t.Fatalf("Invalid polygon should fail")
+	}
+}
+
 func TestAssetMgrGetPowerValuesForCoordinates(t *testing.T) {
 	fmt.Println("--- ", t.Name())
 	log.MeepTextLogInit(t.Name())
@@ -1742,3 +1791,170 @@ func TestAssetMgrGetPowerValuesForCoordinates(t *testing.T) {
 	}
 
 }
+
+func TestAssetMgrPropagationModels(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Linear model must remain the default
+	fmt.Println("Validate default linear model")
+	for _, prop := range []*Propagation{nil, {}, {Model: PropModelLinear}} {
+		_, rsrp, rsrq := calculatePower(PoaTypeCell4g, 100, 50, prop)
+		expRsrp, expRsrq := calculateCell4gPower(100, 50)
+		if rsrp != expRsrp || rsrq != expRsrq {
+			t.Fatalf("Unexpected linear 4G power values")
+		}
+		rssi, _, _ := calculatePower(PoaTypeWifi, 100, 50, prop)
+		if rssi != calculateWifiPower(100, 50) {
+			t.Fatalf("Unexpected linear WiFi power value")
+		}
+	}
+
+	// Validate model selection
+	fmt.Println("Validate model selection")
+	if !IsSupportedPropagationModel(PropModel3gppUmi) || IsSupportedPropagationModel("INVALID") {
+		t.Fatalf("Unexpected propagation model validation")
+	}
+	if NewPropagationModel(PoaTypeCell4g, &Propagation{Model: "INVALID"}) != nil {
+		t.Fatalf("Unexpected propagation model")
+	}
+
+	// Validate free space reference for log-distance model
+	fmt.Println("Validate log-distance model")
+	model := NewPropagationModel(PoaTypeWifi, &Propagation{Model: PropModelLogDistance, Frequency: 2400, PathLossExponent: 2})
+	if pl := model.PathLoss(1); pl < 40 || pl > 40.1 {
+		t.Fatalf("Unexpected log-distance path loss at 1m: %f", pl)
+	}
+	if pl := model.PathLoss(10); pl < 60 || pl > 60.1 {
+		t.Fatalf("Unexpected log-distance path loss at 10m: %f", pl)
+	}
+
+	// Validate Okumura-Hata reference value (900 MHz, hb=30m, hm=1.5m, d=1km)
+	fmt.Println("Validate Okumura-Hata model")
+	model = NewPropagationModel(PoaTypeCell4g, &Propagation{Model: PropModelOkumuraHata, Frequency: 900, AntennaHeight: 30, UeAntennaHeight: 1.5})
+	if pl := model.PathLoss(1000); pl < 126 || pl > 127 {
+		t.Fatalf("Unexpected Okumura-Hata path loss: %f", pl)
+	}
+
+	// Validate COST-231 Hata is used above Okumura-Hata frequency range
+	fmt.Println("Validate Okumura-Hata model above 1500 MHz")
+	model = NewPropagationModel(PoaTypeCell4g, &Propagation{Model: PropModelOkumuraHata})
+	if _, ok := model.(*Cost231HataModel); !ok {
+		t.Fatalf("Unexpected Okumura-Hata model above 1500 MHz")
+	}
+	metroModel := NewPropagationModel(PoaTypeCell4g, &Propagation{Model: PropModelCost231Hata})
+	if pl := metroModel.PathLoss(1000) - model.PathLoss(1000); pl < 2.99 || pl > 3.01 {
+		t.Fatalf("Unexpected COST-231 Hata metropolitan correction: %f", pl)
+	}
+
+	// Validate 0 dBm transmit power is not replaced by default
+	fmt.Println("Validate 0 dBm transmit power")
+	txPower := float32(0)
+	_, defaultRsrp, _ := calculatePower(PoaTypeCell4g, 1000, 100, &Propagation{Model: PropModelLogDistance})
+	_, rsrp, _ := calculatePower(PoaTypeCell4g, 1000, 100, &Propagation{Model: PropModelLogDistance, TxPower: &txPower})
+	if defaultRsrp-rsrp < 45 || defaultRsrp-rsrp > 47 {
+		t.Fatalf("Unexpected RSRP for 0 dBm transmit power: %f", rsrp)
+	}
+
+	// Validate signal decreases with distance for all models
+	fmt.Println("Validate power decreases with distance")
+	for _, modelName := range []string{PropModelLogDistance, PropModelOkumuraHata, PropModelCost231Hata, PropModel3gppUma, PropModel3gppUmi} {
+		for _, subtype := range []string{PoaTypeCell4g, PoaTypeCell5g, PoaTypeWifi} {
+			prop := &Propagation{Model: modelName}
+			nearRssi, nearRsrp, nearRsrq := calculatePower(subtype, 1000, 50, prop)
+			farRssi, farRsrp, farRsrq := calculatePower(subtype, 1000, 800, prop)
+			if nearRssi < farRssi || nearRsrp < farRsrp || nearRsrq < farRsrq {
+				t.Fatalf("Power increases with distance for model %s and type %s", modelName, subtype)
+			}
+			if subtype == PoaTypeWifi && nearRssi == 0 {
+				t.Fatalf("Missing RSSI for model %s", modelName)
+			} else if subtype != PoaTypeWifi && nearRsrp == 0 {
+				t.Fatalf("Missing RSRP for model %s and type %s", modelName, subtype)
+			}
+		}
+	}
+}
+
+func TestAssetMgrHandoverPolicies(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	newUe := func(policy string, servingRsrp float32, neighbourRsrp float32) *Ue {
+		ue := &Ue{
+			Name:        ue1Name,
+			Poa:         poa1Name,
+			PoaInRange:  []string{poa1Name, poa2Name},
+			PoaTypePrio: []string{"wifi", "5g", "4g", "other"},
+			PoaMeasurements: map[string]*PoaMeasurement{
+				poa1Name: {Poa: poa1Name, SubType: PoaTypeCell4g, Distance: 50, InRange: true, Rsrp: servingRsrp, Rsrq: servingRsrp},
+				poa2Name: {Poa: poa2Name, SubType: PoaTypeCell4g, Distance: 40, InRange: true, Rsrp: neighbourRsrp, Rsrq: neighbourRsrp},
+			},
+			Handover: Handover{Policy: policy, A3Offset: 3, Hysteresis: 1, TimeToTrigger: 160},
+		}
+		return ue
+	}
+	now := time.Now()
+
+	// Distance policy remains on current POA of the same RAT
+	fmt.Println("Validate distance policy")
+	ue := newUe(HoPolicyDistance, 40, 60)
+	if poa := selectPoa(ue, now); poa != poa1Name {
+		t.Fatalf("Unexpected POA selection: %s", poa)
+	}
+
+	// A3 policy does not trigger below offset + hysteresis
+	fmt.Println("Validate A3 entering condition")
+	ue = newUe(HoPolicyA3Rsrp, 50, 54)
+	if poa := selectPoa(ue, now); poa != poa1Name || ue.HoCandidate != "" {
+		t.Fatalf("Unexpected A3 handover")
+	}
+
+	// A3 policy waits for time-to-trigger
+	fmt.Println("Validate A3 time-to-trigger")
+	ue = newUe(HoPolicyA3Rsrp, 50, 55)
+	if poa := selectPoa(ue, now); poa != poa1Name || ue.HoCandidate != poa2Name {
+		t.Fatalf("Unexpected A3 handover before time-to-trigger")
+	}
+	if delay := getHandoverCheckDelay(ue, now.Add(100*time.Millisecond)); delay != 60*time.Millisecond {
+		t.Fatalf("Invalid handover check delay: %v", delay)
+	}
+	if poa := selectPoa(ue, now.Add(100*time.Millisecond)); poa != poa1Name {
+		t.Fatalf("Unexpected A3 handover before time-to-trigger")
+	}
+	if poa := selectPoa(ue, now.Add(160*time.Millisecond)); poa != poa2Name || ue.HoCandidate != "" {
+		t.Fatalf("Missing A3 handover after time-to-trigger")
+	}
+	if delay := getHandoverCheckDelay(ue, now.Add(160*time.Millisecond)); delay != 0 {
+		t.Fatalf("Unexpected handover check delay: %v", delay)
+	}
+
+	// A3 candidate is reset when entering condition no longer holds
+	fmt.Println("Validate A3 candidate reset")
+	ue = newUe(HoPolicyA3Rsrp, 50, 55)
+	_ = selectPoa(ue, now)
+	ue.PoaMeasurements[poa2Name].Rsrp = 52
+	if poa := selectPoa(ue, now.Add(200*time.Millisecond)); poa != poa1Name || ue.HoCandidate != "" {
+		t.Fatalf("Unexpected A3 handover after candidate reset")
+	}
+
+	// A3 RSRQ policy uses 0.5 dB reporting steps
+	fmt.Println("Validate A3 RSRQ policy")
+	ue = newUe(HoPolicyA3Rsrq, 50, 58)
+	ue.Handover.TimeToTrigger = 0
+	if poa := selectPoa(ue, now); poa != poa1Name {
+		t.Fatalf("Unexpected A3 RSRQ handover")
+	}
+	ue.PoaMeasurements[poa2Name].Rsrq = 59
+	if poa := selectPoa(ue, now); poa != poa2Name {
+		t.Fatalf("Missing A3 RSRQ handover")
+	}
+
+	// Serving POA loss results in immediate selection of best POA
+	fmt.Println("Validate serving POA loss")
+	ue = newUe(HoPolicyA3Rsrp, 50, 51)
+	ue.PoaInRange = []string{poa2Name}
+	ue.PoaMeasurements[poa1Name].InRange = false
+	if poa := selectPoa(ue, now); poa != poa2Name {
+		t.Fatalf("Missing handover on serving POA loss")
+	}
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gisassetmgr
+
+import (
+	"time"
+)
+
+// Handover Policies
+const (
+	HoPolicyDistance = "DISTANCE"
+	HoPolicyA3Rsrp   = "A3_RSRP"
+	HoPolicyA3Rsrq   = "A3_RSRQ"
+)
+
+// Handover - UE handover policy configuration
+type Handover struct {
+	Policy        string
+	A3Offset      float32 // dB
+	Hysteresis    float32 // dB
+	TimeToTrigger int32   // ms
+}
+
+// IsSupportedHandoverPolicy - Validate handover policy name
+func IsSupportedHandoverPolicy(policy string) bool {
+	switch policy {
+	case "", HoPolicyDistance, HoPolicyA3Rsrp, HoPolicyA3Rsrq:
+		return true
+	}
+	return false
+}
+
+// POA Selection Algorithm
+// Runs the UE handover policy; A3 policies may update the UE handover candidate state
+func selectPoa(ue *Ue, now time.Time) (selectedPoa string) {
+	switch ue.Handover.Policy {
+	case HoPolicyA3Rsrp, HoPolicyA3Rsrq:
+		return selectPoaA3(ue, now)
+	default:
+		return selectPoaDistance(ue)
+	}
+}
+
+// A3 event-based POA Selection (3GPP TS 36.331 5.5.4.4)
+// Handover to a neighbour POA of the same RAT priority is triggered when the neighbour
+// measurement exceeds the serving POA measurement by offset + hysteresis for time-to-trigger.
+// A more localized RAT in range or loss of the serving POA results in an immediate selection.
+func selectPoaA3(ue *Ue, now time.Time) (selectedPoa string) {
+	// Find best POA in range with highest RAT priority
+	bestPoa := ""
+	for _, poa := range ue.PoaInRange {
+		poaInfo := ue.PoaMeasurements[poa]
+		if !isSupportedPoaType(poaInfo.SubType, ue.PoaTypePrio) {
+			continue
+		}
+		if bestPoa == "" {
+			bestPoa = poa
+			continue
+		}
+		bestInfo := ue.PoaMeasurements[bestPoa]
+		cmp := comparePoaTypes(poaInfo.SubType, bestInfo.SubType, ue.PoaTypePrio)
+		if cmp > 0 || (cmp == 0 && getA3Measurement(ue.Handover.Policy, poaInfo) > getA3Measurement(ue.Handover.Policy, bestInfo)) {
+			bestPoa = poa
+		}
+	}
+
+	// Select best POA immediately if serving POA is lost or a more localized RAT is available
+	currentPoaInfo, found := ue.PoaMeasurements[ue.Poa]
+	if !found || !currentPoaInfo.InRange || !isSupportedPoaType(currentPoaInfo.SubType, ue.PoaTypePrio) || bestPoa == "" {
+		resetHandoverCandidate(ue)
+		return bestPoa
+	}
+	bestInfo := ue.PoaMeasurements[bestPoa]
+	if comparePoaTypes(bestInfo.SubType, currentPoaInfo.SubType, ue.PoaTypePrio) > 0 {
+		resetHandoverCandidate(ue)
+		return bestPoa
+	}
+
+	// Evaluate A3 entering condition: Mn - Hys > Mp + Off
+	// RSRQ measurements are reported in 0.5 dB steps
+	scale := float32(1)
+	if ue.Handover.Policy == HoPolicyA3Rsrq && bestInfo.SubType != PoaTypeWifi {
+		scale = 2
+	}
+	if bestPoa == ue.Poa ||
+		getA3Measurement(ue.Handover.Policy, bestInfo)-scale*ue.Handover.Hysteresis <=
+			getA3Measurement(ue.Handover.Policy, currentPoaInfo)+scale*ue.Handover.A3Offset {
+		resetHandoverCandidate(ue)
+		return ue.Poa
+	}
+
+	// Start time-to-trigger on new candidate
+	if ue.HoCandidate != bestPoa {
+		ue.HoCandidate = bestPoa
+		ue.HoCandidateTime = now
+	}
+
+	// Trigger handover once condition held for time-to-trigger
+	if now.Sub(ue.HoCandidateTime) >= time.Duration(ue.Handover.TimeToTrigger)*time.Millisecond {
+		resetHandoverCandidate(ue)
+		return bestPoa
+	}
+	return ue.Poa
+}
+
+// Get POA measurement used in A3 evaluation; WiFi POAs use RSSI
+func getA3Measurement(policy string, meas *PoaMeasurement) float32 {
+	if meas.SubType == PoaTypeWifi {
+		return meas.Rssi
+	}
+	if policy == HoPolicyA3Rsrq {
+		return meas.Rsrq
+	}
+	return meas.Rsrp
+}
+
+func resetHandoverCandidate(ue *Ue) {
+	ue.HoCandidate = ""
+	ue.HoCandidateTime = time.Time{}
+}
@@ -0,0 +1,300 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gisassetmgr
+
+import (
+	"math"
+)
+
+// Propagation Models
+const (
+	PropModelLinear      = "LINEAR"
+	PropModelLogDistance = "LOG_DISTANCE"
+	PropModelOkumuraHata = "OKUMURA_HATA"
+	PropModelCost231Hata = "COST231_HATA"
+	PropModel3gppUma     = "3GPP_38901_UMA"
+	PropModel3gppUmi     = "3GPP_38901_UMI"
+)
+
+// Default propagation parameters per POA type
+const (
+	defaultCellTxPower         = float32(46)   // dBm
+	defaultCell4gFrequency     = float32(1800) // MHz
+	defaultCell5gFrequency     = float32(3500) // MHz
+	defaultCellAntennaHeight   = float32(25)   // m
+	defaultWifiTxPower         = float32(20)   // dBm
+	defaultWifiFrequency       = float32(2400) // MHz
+	defaultWifiAntennaHeight   = float32(3)    // m
+	defaultUeAntennaHeight     = float32(1.5)  // m
+	defaultPathLossExponent    = float32(3)
+	okumuraHataMaxFrequency    = float32(1500) // MHz
+	minPropagationDistance     = float32(1)    // m
+	speedOfLight               = 3.0e8         // m/s
+	defaultHataMetropolitanAdj = 3             // dB
+)
+
+// Propagation - POA radio propagation configuration
+// Unset parameters use POA type defaults; TxPower is nil when unset as 0 dBm is a valid value
+type Propagation struct {
+	Model            string
+	TxPower          *float32
+	Frequency        float32
+	AntennaHeight    float32
+	UeAntennaHeight  float32
+	PathLossExponent float32
+}
+
+// PropagationModel - Radio propagation model interface
+type PropagationModel interface {
+	// PathLoss - Returns the path loss in dB at the provided distance in meters
+	PathLoss(distance float32) float32
+}
+
+// LogDistanceModel - Log-distance path loss model with free space reference at 1m
+type LogDistanceModel struct {
+	Frequency float32
+	Exponent  float32
+}
+
+// OkumuraHataModel - Okumura-Hata urban path loss model (150-1500 MHz)
+// COST-231 Hata medium city model is used instead above 1500 MHz
+type OkumuraHataModel struct {
+	Frequency       float32
+	AntennaHeight   float32
+	UeAntennaHeight float32
+}
+
+// Cost231HataModel - COST-231 Hata extension (1500-2000 MHz) for medium cities or metropolitan areas
+type Cost231HataModel struct {
+	Frequency       float32
+	AntennaHeight   float32
+	UeAntennaHeight float32
+	// Area correction factor (dB): 0 for medium cities, 3 for metropolitan areas
+	AreaCorrection float32
+}
+
+// Uma38901Model - 3GPP TR 38.901 Urban Macro path loss model (NLOS)
+type Uma38901Model struct {
+	Frequency       float32
+	AntennaHeight   float32
+	UeAntennaHeight float32
+}
+
+// Umi38901Model - 3GPP TR 38.901 Urban Micro Street Canyon path loss model (NLOS)
+type Umi38901Model struct {
+	Frequency       float32
+	AntennaHeight   float32
+	UeAntennaHeight float32
+}
+
+// NewPropagationModel - Create propagation model from configuration; returns nil for linear model
+func NewPropagationModel(subtype string, prop *Propagation) PropagationModel {
+	if prop == nil {
+		return nil
+	}
+	p := withPropagationDefaults(subtype, prop)
+	switch p.Model {
+	case PropModelLogDistance:
+		return &LogDistanceModel{Frequency: p.Frequency, Exponent: p.PathLossExponent}
+	case PropModelOkumuraHata:
+		if p.Frequency > okumuraHataMaxFrequency {
+			return &Cost231HataModel{Frequency: p.Frequency, AntennaHeight: p.AntennaHeight, UeAntennaHeight: p.UeAntennaHeight}
+		}
+		return &OkumuraHataModel{Frequency: p.Frequency, AntennaHeight: p.AntennaHeight, UeAntennaHeight: p.UeAntennaHeight}
+	case PropModelCost231Hata:
+		return &Cost231HataModel{Frequency: p.Frequency, AntennaHeight: p.AntennaHeight, UeAntennaHeight: p.UeAntennaHeight,
+			AreaCorrection: defaultHataMetropolitanAdj}
+	case PropModel3gppUma:
+		return &Uma38901Model{Frequency: p.Frequency, AntennaHeight: p.AntennaHeight, UeAntennaHeight: p.UeAntennaHeight}
+	case PropModel3gppUmi:
+		return &Umi38901Model{Frequency: p.Frequency, AntennaHeight: p.AntennaHeight, UeAntennaHeight: p.UeAntennaHeight}
+	default:
+		return nil
+	}
+}
+
+// IsSupportedPropagationModel - Validate propagation model name
+func IsSupportedPropagationModel(model string) bool {
+	switch model {
+	case "", PropModelLinear, PropModelLogDistance, PropModelOkumuraHata, PropModelCost231Hata, PropModel3gppUma, PropModel3gppUmi:
+		return true
+	}
+	return false
+}
+
+// Fill unset propagation parameters with POA type defaults
+func withPropagationDefaults(subtype string, prop *Propagation) Propagation {
+	p := *prop
+	if p.TxPower == nil {
+		txPower := defaultCellTxPower
+		if subtype == PoaTypeWifi {
+			txPower = defaultWifiTxPower
+		}
+		p.TxPower = &txPower
+	}
+	if p.Frequency <= 0 {
+		switch subtype {
+		case PoaTypeWifi:
+			p.Frequency = defaultWifiFrequency
+		case PoaTypeCell5g:
+			p.Frequency = defaultCell5gFrequency
+		default:
+			p.Frequency = defaultCell4gFrequency
+		}
+	}
+	if p.AntennaHeight <= 0 {
+		if subtype == PoaTypeWifi {
+			p.AntennaHeight = defaultWifiAntennaHeight
+		} else {
+			p.AntennaHeight = defaultCellAntennaHeight
+		}
+	}
+	if p.UeAntennaHeight <= 0 {
+		p.UeAntennaHeight = defaultUeAntennaHeight
+	}
+	if p.PathLossExponent <= 0 {
+		p.PathLossExponent = defaultPathLossExponent
+	}
+	return p
+}
+
+// PathLoss - PL(d) = FSPL(d0) + 10 * n * log10(d/d0), d0 = 1m
+func (m *LogDistanceModel) PathLoss(distance float32) float32 {
+	d := math.Max(float64(distance), float64(minPropagationDistance))
+	fspl := 20*math.Log10(float64(m.Frequency)) - 27.55
+	return float32(fspl + 10*float64(m.Exponent)*math.Log10(d))
+}
+
+// Okumura-Hata mobile antenna height correction factor for small/medium cities
+func hataUeHeightCorrection(f float64, hm float64) float64 {
+	return (1.1*math.Log10(f)-0.7)*hm - (1.56*math.Log10(f) - 0.8)
+}
+
+// PathLoss - Okumura-Hata urban path loss
+func (m *OkumuraHataModel) PathLoss(distance float32) float32 {
+	f := float64(m.Frequency)
+	hb := float64(m.AntennaHeight)
+	dKm := math.Max(float64(distance), float64(minPropagationDistance)) / 1000
+	return float32(69.55 + 26.16*math.Log10(f) - 13.82*math.Log10(hb) -
+		hataUeHeightCorrection(f, float64(m.UeAntennaHeight)) +
+		(44.9-6.55*math.Log10(hb))*math.Log10(dKm))
+}
+
+// PathLoss - COST-231 Hata path loss
+func (m *Cost231HataModel) PathLoss(distance float32) float32 {
+	f := float64(m.Frequency)
+	hb := float64(m.AntennaHeight)
+	dKm := math.Max(float64(distance), float64(minPropagationDistance)) / 1000
+	return float32(46.3 + 33.9*math.Log10(f) - 13.82*math.Log10(hb) -
+		hataUeHeightCorrection(f, float64(m.UeAntennaHeight)) +
+		(44.9-6.55*math.Log10(hb))*math.Log10(dKm) + float64(m.AreaCorrection))
+}
+
+// 3D distance & breakpoint distance as defined in 3GPP TR 38.901 Table 7.4.1-1
+func get38901Distances(distance float32, fc float64, hBs float64, hUt float64) (d3d float64, dBp float64) {
+	d2d := math.Max(float64(distance), float64(minPropagationDistance))
+	d3d = math.Sqrt(d2d*d2d + (hBs-hUt)*(hBs-hUt))
+	dBp = 4 * (hBs - 1) * (hUt - 1) * fc * 1e9 / speedOfLight
+	return d3d, dBp
+}
+
+// PathLoss - 3GPP TR 38.901 UMa NLOS path loss
+func (m *Uma38901Model) PathLoss(distance float32) float32 {
+	fc := float64(m.Frequency) / 1000
+	hBs := float64(m.AntennaHeight)
+	hUt := float64(m.UeAntennaHeight)
+	d3d, dBp := get38901Distances(distance, fc, hBs, hUt)
+
+	// LOS
+	var plLos float64
+	if d3d <= dBp {
+		plLos = 28.0 + 22*math.Log10(d3d) + 20*math.Log10(fc)
+	} else {
+		plLos = 28.0 + 40*math.Log10(d3d) + 20*math.Log10(fc) - 9*math.Log10(dBp*dBp+(hBs-hUt)*(hBs-hUt))
+	}
+
+	// NLOS
+	plNlos := 13.54 + 39.08*math.Log10(d3d) + 20*math.Log10(fc) - 0.6*(hUt-1.5)
+	return float32(math.Max(plLos, plNlos))
+}
+
+// PathLoss - 3GPP TR 38.901 UMi Street Canyon NLOS path loss
+func (m *Umi38901Model) PathLoss(distance float32) float32 {
+	fc := float64(m.Frequency) / 1000
+	hBs := float64(m.AntennaHeight)
+	hUt := float64(m.UeAntennaHeight)
+	d3d, dBp := get38901Distances(distance, fc, hBs, hUt)
+
+	// LOS
+	var plLos float64
+	if d3d <= dBp {
+		plLos = 32.4 + 21*math.Log10(d3d) + 20*math.Log10(fc)
+	} else {
+		plLos = 32.4 + 40*math.Log10(d3d) + 20*math.Log10(fc) - 9.5*math.Log10(dBp*dBp+(hBs-hUt)*(hBs-hUt))
+	}
+
+	// NLOS
+	plNlos := 22.4 + 35.3*math.Log10(d3d) + 21.3*math.Log10(fc) - 0.3*(hUt-1.5)
+	return float32(math.Max(plLos, plNlos))
+}
+
+// Calculate power measurements using the configured propagation model
+// Received power is converted to the reported measurement ranges used by the linear model
+func calculateModelPower(subtype string, distance float32, prop *Propagation, model PropagationModel) (rssi float32, rsrp float32, rsrq float32) {
+	p := withPropagationDefaults(subtype, prop)
+	rxPower := *p.TxPower - model.PathLoss(distance)
+
+	switch subtype {
+	case PoaTypeCell4g:
+		// RSRP index: dBm + 140, RSRQ index: 2 * (dB + 19)
+		// RSRQ estimated from RSRP over the real world signal range
+		rsrp = clampPower(float32(math.Floor(float64(rxPower+140))), -17, 97)
+		rsrqDb := estimateRsrq(rxPower, -100, -70)
+		rsrq = clampPower(float32(math.Floor(float64(2*(rsrqDb+19)))), -30, 46)
+	case PoaTypeCell5g:
+		// RSRP index: dBm + 157, RSRQ index: 2 * (dB + 43.5)
+		// RSRQ estimated from RSRP over the real world signal range
+		rsrp = clampPower(float32(math.Floor(float64(rxPower+157))), 0, 127)
+		rsrqDb := estimateRsrq(rxPower, -115, -65)
+		rsrq = clampPower(float32(math.Floor(float64(2*(rsrqDb+43.5)))), 0, 127)
+	case PoaTypeWifi:
+		// RSSI index: linear mapping of -80 dBm..-30 dBm to 32..77
+		rssi = clampPower(float32(math.Floor(float64(minWifiRssi+(rxPower+80)*(maxWifiRssi-minWifiRssi)/50))), 0, 100)
+	default:
+	}
+	return rssi, rsrp, rsrq
+}
+
+// Estimate RSRQ in dB (-20 dB to -5 dB) from RSRP position within provided RSRP range
+func estimateRsrq(rsrp float32, minRsrp float32, maxRsrp float32) float32 {
+	ratio := (rsrp - minRsrp) / (maxRsrp - minRsrp)
+	if ratio < 0 {
+		ratio = 0
+	} else if ratio > 1 {
+		ratio = 1
+	}
+	return -20 + 15*ratio
+}
+
+func clampPower(value float32, minValue float32, maxValue float32) float32 {
+	if value < minValue {
+		return minValue
+	} else if value > maxValue {
+		return maxValue
+	}
+	return value
+}
@@ -45,6 +45,8 @@ const (
 	fieldRsrq      = "rsrq"
 	fieldSrc       = "src"
 	fieldSrcType   = "srcType"
+	fieldInRange   = "inRange"
+	fieldConnected = "connected"
 )
 
 const (
@@ -72,10 +74,12 @@ type UePoaMeasurement struct {
 }
 
 type PoaMeasurement struct {
-	Rssi     float32
-	Rsrp     float32
-	Rsrq     float32
-	Distance float32
+	Rssi      float32
+	Rsrp      float32
+	Rsrq      float32
+	Distance  float32
+	InRange   bool
+	Connected bool
 }
 
 type GisCache struct {
@@ -285,6 +289,8 @@ func (gc *GisCache) SetPoaMeasurement(src string, srcType string, dest string, d
 	fields[fieldRsrp] = fmt.Sprintf("%f", meas.Rsrp)
 	fields[fieldRsrq] = fmt.Sprintf("%f", meas.Rsrq)
 	fields[fieldDistance] = fmt.Sprintf("%f", meas.Distance)
+	fields[fieldInRange] = strconv.FormatBool(meas.InRange)
+	fields[fieldConnected] = strconv.FormatBool(meas.Connected)
 
 	// Update entry in DB
 	err := gc.rc.SetEntry(key, fields)
@@ -343,6 +349,12 @@ func getPoaMeasurement(key string, fields map[string]string, userData interface{
 	if distance, err := strconv.ParseFloat(fields[fieldDistance], 32); err == nil {
 		meas.Distance = float32(distance)
 	}
+	if inRange, err := strconv.ParseBool(fields[fieldInRange]); err == nil {
+		meas.InRange = inRange
+	}
+	if connected, err := strconv.ParseBool(fields[fieldConnected]); err == nil {
+		meas.Connected = connected
+	}
 
 	// Add measurement to map
 	uePoaMeas, found := measurementMap[ueName]
@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+// Presence metric: UE entering/leaving a zone or access point
+const PresMetName = "presence"
+const PresMetScope = "scope"
+const PresMetZone = "zone"
+const PresMetPoa = "poa"
+const PresMetAddress = "address"
+const PresMetEvent = "event"
+const PresMetDwellTime = "dwell"
+const PresMetTime = "time"
+
+const PresMetScopeZone = "zone"
+const PresMetScopePoa = "poa"
+const PresMetEventEntering = "entering"
+const PresMetEventLeaving = "leaving"
+
+// Occupancy metric: number of UEs in a zone or access point
+const OccMetName = "occupancy"
+const OccMetScope = "scope"
+const OccMetZone = "zone"
+const OccMetPoa = "poa"
+const OccMetUsers = "users"
+const OccMetTime = "time"
+
+const OccMetScopeZone = "zone"
+const OccMetScopePoa = "poa"
+
+// Position metric: UE geographic position
+const PosMetName = "position"
+const PosMetZone = "zone"
+const PosMetAddress = "address"
+const PosMetLatitude = "lat"
+const PosMetLongitude = "long"
+const PosMetTime = "time"
+
+type PresenceMetric struct {
+	Time      interface{}
+	Scope     string
+	Zone      string
+	Poa       string
+	Address   string
+	Event     string
+	DwellTime float64
+}
+
+type OccupancyMetric struct {
+	Time  interface{}
+	Scope string
+	Zone  string
+	Poa   string
+	Users int32
+}
+
+type PositionMetric struct {
+	Time      interface{}
+	Zone      string
+	Address   string
+	Latitude  float64
+	Longitude float64
+}
+
+// SetPresenceMetric
+func (ms *MetricStore) SetPresenceMetric(pm PresenceMetric) error {
+	metricList := make([]Metric, 1)
+	metric := &metricList[0]
+	metric.Name = PresMetName
+	metric.Tags = map[string]string{
+		PresMetScope: pm.Scope,
+		PresMetZone:  pm.Zone,
+		PresMetPoa:   pm.Poa,
+	}
+	metric.Fields = map[string]interface{}{
+		PresMetAddress:   pm.Address,
+		PresMetEvent:     pm.Event,
+		PresMetDwellTime: pm.DwellTime,
+	}
+	return ms.SetInfluxMetric(metricList)
+}
+
+// GetPresenceMetric
+func (ms *MetricStore) GetPresenceMetric(scope string, zone string, poa string, duration string, count int) (metrics []PresenceMetric, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		err = errors.New("Store name not specified")
+		return
+	}
+
+	// Get Presence metrics
+	tags := map[string]string{PresMetScope: scope}
+	if zone != "" {
+		tags[PresMetZone] = zone
+	}
+	if poa != "" {
+		tags[PresMetPoa] = poa
+	}
+	fields := []string{PresMetZone, PresMetPoa, PresMetAddress, PresMetEvent, PresMetDwellTime}
+	var valuesArray []map[string]interface{}
+	valuesArray, err = ms.GetInfluxMetric(PresMetName, tags, fields, duration, count)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+		return
+	}
+
+	// Format presence metrics
+	metrics = make([]PresenceMetric, len(valuesArray))
+	for index, values := range valuesArray {
+		metrics[index].Time = values[PresMetTime]
+		metrics[index].Scope = scope
+		if val, ok := values[PresMetZone].(string); ok {
+			metrics[index].Zone = val
+		}
+		if val, ok := values[PresMetPoa].(string); ok {
+			metrics[index].Poa = val
+		}
+		if val, ok := values[PresMetAddress].(string); ok {
+			metrics[index].Address = val
+		}
+		if val, ok := values[PresMetEvent].(string); ok {
+			metrics[index].Event = val
+		}
+		if val, ok := values[PresMetDwellTime].(json.Number); ok {
+			metrics[index].DwellTime = JsonNumToFloat64(val)
+		}
+	}
+	return
+}
+
+// SetOccupancyMetric
+func (ms *MetricStore) SetOccupancyMetric(om OccupancyMetric) error {
+	metricList := make([]Metric, 1)
+	metric := &metricList[0]
+	metric.Name = OccMetName
+	metric.Tags = map[string]string{
+		OccMetScope: om.Scope,
+		OccMetZone:  om.Zone,
+		OccMetPoa:   om.Poa,
+	}
+	metric.Fields = map[string]interface{}{
+		OccMetUsers: om.Users,
+	}
+	return ms.SetInfluxMetric(metricList)
+}
+
+// GetOccupancyMetric
+func (ms *MetricStore) GetOccupancyMetric(scope string, zone string, poa string, duration string, count int) (metrics []OccupancyMetric, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		err = errors.New("Store name not specified")
+		return
+	}
+
+	// Get Occupancy metrics
+	tags := map[string]string{OccMetScope: scope}
+	if zone != "" {
+		tags[OccMetZone] = zone
+	}
+	if poa != "" {
+		tags[OccMetPoa] = poa
+	}
+	fields := []string{OccMetZone, OccMetPoa, OccMetUsers}
+	var valuesArray []map[string]interface{}
+	valuesArray, err = ms.GetInfluxMetric(OccMetName, tags, fields, duration, count)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+		return
+	}
+	metrics = formatOccupancyMetrics(scope, valuesArray)
+	return
+}
+
+// GetOccupancyMetricSince - Get occupancy metrics logged since start time, including the last metric before start time
+func (ms *MetricStore) GetOccupancyMetricSince(scope string, zone string, poa string, start time.Time) (metrics []OccupancyMetric, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		err = errors.New("Store name not specified")
+		return
+	}
+
+	// Get Occupancy metrics
+	tags := map[string]string{OccMetScope: scope}
+	if zone != "" {
+		tags[OccMetZone] = zone
+	}
+	if poa != "" {
+		tags[OccMetPoa] = poa
+	}
+	fields := []string{OccMetZone, OccMetPoa, OccMetUsers}
+	var valuesArray []map[string]interface{}
+	valuesArray, err = ms.GetInfluxMetricRange(OccMetName, tags, fields, start, time.Time{}, 0)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+		return
+	}
+	var prevValuesArray []map[string]interface{}
+	prevValuesArray, err = ms.GetInfluxMetricRange(OccMetName, tags, fields, time.Time{}, start.Add(time.Nanosecond), 1)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+		return
+	}
+	metrics = formatOccupancyMetrics(scope, append(valuesArray, prevValuesArray...))
+	return
+}
+
+// formatOccupancyMetrics - Format occupancy metrics from metric store values
+func formatOccupancyMetrics(scope string, valuesArray []map[string]interface{}) (metrics []OccupancyMetric) {
+	metrics = make([]OccupancyMetric, len(valuesArray))
+	for index, values := range valuesArray {
+		metrics[index].Time = values[OccMetTime]
+		metrics[index].Scope = scope
+		if val, ok := values[OccMetZone].(string); ok {
+			metrics[index].Zone = val
+		}
+		if val, ok := values[OccMetPoa].(string); ok {
+			metrics[index].Poa = val
+		}
+		if val, ok := values[OccMetUsers].(json.Number); ok {
+			metrics[index].Users = JsonNumToInt32(val)
+		}
+	}
+	return
+}
+
+// SetPositionMetric
+func (ms *MetricStore) SetPositionMetric(pm PositionMetric) error {
+	metricList := make([]Metric, 1)
+	metric := &metricList[0]
+	metric.Name = PosMetName
+	metric.Tags = map[string]string{
+		PosMetZone: pm.Zone,
+	}
+	metric.Fields = map[string]interface{}{
+		PosMetAddress:   pm.Address,
+		PosMetLatitude:  pm.Latitude,
+		PosMetLongitude: pm.Longitude,
+	}
+	return ms.SetInfluxMetric(metricList)
+}
+
+// GetPositionMetric
+func (ms *MetricStore) GetPositionMetric(zone string, duration string, count int) (metrics []PositionMetric, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		err = errors.New("Store name not specified")
+		return
+	}
+
+	// Get Position metrics
+	tags := map[string]string{}
+	if zone != "" {
+		tags[PosMetZone] = zone
+	}
+	fields := []string{PosMetZone, PosMetAddress, PosMetLatitude, PosMetLongitude}
+	var valuesArray []map[string]interface{}
+	valuesArray, err = ms.GetInfluxMetric(PosMetName, tags, fields, duration, count)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+		return
+	}
+
+	// Format position metrics
+	metrics = make([]PositionMetric, len(valuesArray))
+	for index, values := range valuesArray {
+		metrics[index].Time = values[PosMetTime]
+		if val, ok := values[PosMetZone].(string); ok {
+			metrics[index].Zone = val
+		}
+		if val, ok := values[PosMetAddress].(string); ok {
+			metrics[index].Address = val
+		}
+		if val, ok := values[PosMetLatitude].(json.Number); ok {
+			metrics[index].Latitude = JsonNumToFloat64(val)
+		}
+		if val, ok := values[PosMetLongitude].(json.Number); ok {
+			metrics[index].Longitude = JsonNumToFloat64(val)
+		}
+	}
+	return
+}
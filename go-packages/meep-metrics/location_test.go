@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+const locStoreName string = "loc-store"
+const locStoreNamespace string = "loc-ns"
+const locStoreInfluxAddr string = "http://localhost:30986"
+const locStoreRedisAddr string = MetricsDbDisabled
+
+func TestLocationMetricsGetSet(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Create valid Metric Store")
+	ms, err := NewMetricStore(locStoreName, locStoreNamespace, locStoreInfluxAddr, locStoreRedisAddr)
+	if err != nil {
+		t.Fatalf("Unable to create Metric Store")
+	}
+
+	fmt.Println("Flush store metrics")
+	ms.Flush()
+
+	fmt.Println("Set presence metrics")
+	err = ms.SetPresenceMetric(PresenceMetric{nil, PresMetScopeZone, "zone1", "poa1", "ue1", PresMetEventEntering, 0})
+	if err != nil {
+		t.Fatalf("Unable to set presence metric")
+	}
+	err = ms.SetPresenceMetric(PresenceMetric{nil, PresMetScopePoa, "zone1", "poa1", "ue1", PresMetEventEntering, 0})
+	if err != nil {
+		t.Fatalf("Unable to set presence metric")
+	}
+	err = ms.SetPresenceMetric(PresenceMetric{nil, PresMetScopeZone, "zone1", "poa2", "ue1", PresMetEventLeaving, 12.5})
+	if err != nil {
+		t.Fatalf("Unable to set presence metric")
+	}
+
+	fmt.Println("Get presence metrics")
+	pml, err := ms.GetPresenceMetric(PresMetScopeZone, "", "", "1ms", 0)
+	if err != nil || len(pml) != 0 {
+		t.Fatalf("No metrics should be found in the last 1 ms")
+	}
+	pml, err = ms.GetPresenceMetric(PresMetScopeZone, "zone1", "", "", 0)
+	if err != nil || len(pml) != 2 {
+		t.Fatalf("Failed to get metric")
+	}
+	if !validatePresenceMetric(pml[0], "zone1", "poa2", "ue1", PresMetEventLeaving, 12.5) {
+		t.Fatalf("Invalid presence metric")
+	}
+	if !validatePresenceMetric(pml[1], "zone1", "poa1", "ue1", PresMetEventEntering, 0) {
+		t.Fatalf("Invalid presence metric")
+	}
+	pml, err = ms.GetPresenceMetric(PresMetScopePoa, "", "poa1", "", 0)
+	if err != nil || len(pml) != 1 {
+		t.Fatalf("Failed to get metric")
+	}
+
+	fmt.Println("Set occupancy metrics")
+	err = ms.SetOccupancyMetric(OccupancyMetric{nil, OccMetScopeZone, "zone1", "", 1})
+	if err != nil {
+		t.Fatalf("Unable to set occupancy metric")
+	}
+	err = ms.SetOccupancyMetric(OccupancyMetric{nil, OccMetScopeZone, "zone1", "", 2})
+	if err != nil {
+		t.Fatalf("Unable to set occupancy metric")
+	}
+
+	fmt.Println("Get occupancy metrics")
+	oml, err := ms.GetOccupancyMetric(OccMetScopeZone, "zone1", "", "", 0)
+	if err != nil || len(oml) != 2 {
+		t.Fatalf("Failed to get metric")
+	}
+	if oml[0].Zone != "zone1" || oml[0].Users != 2 || oml[1].Users != 1 {
+		t.Fatalf("Invalid occupancy metric")
+	}
+	time.Sleep(10 * time.Millisecond)
+	start := time.Now()
+	err = ms.SetOccupancyMetric(OccupancyMetric{nil, OccMetScopeZone, "zone1", "", 3})
+	if err != nil {
+		t.Fatalf("Unable to set occupancy metric")
+	}
+	oml, err = ms.GetOccupancyMetricSince(OccMetScopeZone, "zone1", "", start)
+	if err != nil || len(oml) != 2 {
+		t.Fatalf("Failed to get metric")
+	}
+	if oml[0].Users != 3 || oml[1].Users != 2 {
+		t.Fatalf("Invalid occupancy metric")
+	}
+
+	fmt.Println("Set position metrics")
+	err = ms.SetPositionMetric(PositionMetric{nil, "zone1", "ue1", 43.7364, 7.4218})
+	if err != nil {
+		t.Fatalf("Unable to set position metric")
+	}
+	err = ms.SetPositionMetric(PositionMetric{nil, "zone2", "ue2", 43.7370, 7.4225})
+	if err != nil {
+		t.Fatalf("Unable to set position metric")
+	}
+
+	fmt.Println("Get position metrics")
+	pos, err := ms.GetPositionMetric("", "", 0)
+	if err != nil || len(pos) != 2 {
+		t.Fatalf("Failed to get metric")
+	}
+	pos, err = ms.GetPositionMetric("zone1", "", 0)
+	if err != nil || len(pos) != 1 {
+		t.Fatalf("Failed to get metric")
+	}
+	if pos[0].Address != "ue1" || pos[0].Latitude != 43.7364 || pos[0].Longitude != 7.4218 {
+		t.Fatalf("Invalid position metric")
+	}
+
+	// t.Fatalf("DONE")
+}
+
+func validatePresenceMetric(pm PresenceMetric, zone string, poa string, address string, event string, dwell float64) bool {
+	return pm.Zone == zone && pm.Poa == poa && pm.Address == address && pm.Event == event && pm.DwellTime == dwell
+}
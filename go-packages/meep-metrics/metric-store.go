@@ -272,6 +272,29 @@ func (ms *MetricStore) SetInfluxMetric(metricList []Metric) error {
 
 // GetInfluxMetric - Generic metric getter
 func (ms *MetricStore) GetInfluxMetric(metric string, tags map[string]string, fields []string, duration string, limit int) (values []map[string]interface{}, err error) {
+	startTime := ""
+	// Set start time if duration is set
+	if duration != "" {
+		startTime = strconv.FormatInt(time.Now().UnixNano(), 10) + " - " + duration
+	}
+	return ms.getInfluxMetric(metric, tags, fields, startTime, "", limit)
+}
+
+// GetInfluxMetricRange - Generic metric getter for metrics logged after start time & before stop time
+// NOTE: Zero start or stop time is ignored
+func (ms *MetricStore) GetInfluxMetricRange(metric string, tags map[string]string, fields []string, start time.Time, stop time.Time, limit int) (values []map[string]interface{}, err error) {
+	startTime := ""
+	if !start.IsZero() {
+		startTime = strconv.FormatInt(start.UnixNano(), 10)
+	}
+	stopTime := ""
+	if !stop.IsZero() {
+		stopTime = strconv.FormatInt(stop.UnixNano(), 10)
+	}
+	return ms.getInfluxMetric(metric, tags, fields, startTime, stopTime, limit)
+}
+
+func (ms *MetricStore) getInfluxMetric(metric string, tags map[string]string, fields []string, startTime string, stopTime string, limit int) (values []map[string]interface{}, err error) {
 	// Make sure we have set a store
 	if ms.name == "" {
 		return values, errors.New("Store name not specified")
@@ -315,16 +338,10 @@ func (ms *MetricStore) GetInfluxMetric(metric string, tags map[string]string, fi
 	}
 
 	metricCount := 0
-	startTime := ""
-	// Set start time if duration is set
-	if duration != "" {
-		startTime = strconv.FormatInt(time.Now().UnixNano(), 10) + " - " + duration
-	}
 
 	// Fetch metrics from DB
 	// Run multiple times if more than MAX_LIMIT metrics retrieved
 	for {
-		stopTime := ""
 		if len(values) > 0 {
 			logTime, _ := time.Parse(time.RFC3339, values[len(values)-1][metricsTime].(string))
 			stopTime = strconv.FormatInt(logTime.UnixNano(), 10)
@@ -40,6 +40,12 @@ var (
 		Help:    "A histogram of http notification durations",
 		Buckets: prometheus.LinearBuckets(10, 10, 5),
 	}, []string{"sbox", "svc", "notif", "url", "method", "status"})
+
+	metricContextTransferDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "metrics_context_transfer_duration",
+		Help:    "A histogram of application context transfer durations",
+		Buckets: prometheus.ExponentialBuckets(100, 2, 10),
+	}, []string{"sbox", "svc", "app", "result"})
 )
 
 // ResponseWriter wrapper to capture status code
@@ -100,3 +106,8 @@ func ObserveNotification(sbox string, svc string, notif string, url string, resp
 	// Store HTTP Notification metrics
 	metricHttpNotificationDuration.WithLabelValues(sbox, svc, notif, url, "POST", status).Observe(duration)
 }
+
+// ObserveContextTransfer - Store application context transfer duration (ms) & result
+func ObserveContextTransfer(sbox string, svc string, app string, result string, duration float64) {
+	metricContextTransferDuration.WithLabelValues(sbox, svc, app, result).Observe(duration)
+}
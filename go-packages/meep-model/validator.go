@@ -56,6 +56,15 @@ const (
 	JITTER_MAX                   = 250000
 	PACKET_LOSS_MIN              = float64(0.0)
 	PACKET_LOSS_MAX              = float64(100.0)
+	PERCENTAGE_MIN               = float64(0.0)
+	PERCENTAGE_MAX               = float64(100.0)
+	PROFILE_INTERVAL_MIN         = 100
+	PROFILE_INTERVAL_MAX         = 3600000
+	PROFILE_SAMPLES_MAX          = 100000
+	TRAFFIC_WEIGHT_MIN           = 0
+	TRAFFIC_WEIGHT_MAX           = 1000
+	TRAFFIC_PRIORITY_MIN         = 0
+	TRAFFIC_PRIORITY_MAX         = 127
 	THROUGHPUT_MIN               = 1
 	THROUGHPUT_MAX               = 1000000
 	THROUGHPUT_DEFAULT           = 1000
@@ -79,14 +88,35 @@ const (
 	MIN_MEMORY_MAX               = 1000000
 	MAX_MEMORY_MIN               = 1
 	MAX_MEMORY_MAX               = 1000000
+	TX_POWER_MIN                 = -50
+	TX_POWER_MAX                 = 80
+	FREQUENCY_MIN                = 0
+	FREQUENCY_MAX                = 100000
+	ANTENNA_HEIGHT_MIN           = 0
+	ANTENNA_HEIGHT_MAX           = 1000
+	PATH_LOSS_EXPONENT_MIN       = 0
+	PATH_LOSS_EXPONENT_MAX       = 10
+	HO_OFFSET_MIN                = -30
+	HO_OFFSET_MAX                = 30
+	HO_HYSTERESIS_MIN            = 0
+	HO_HYSTERESIS_MAX            = 30
+	HO_TIME_TO_TRIGGER_MIN       = 0
+	HO_TIME_TO_TRIGGER_MAX       = 5120
+	TRAFFIC_LOAD_MIN             = 0
+	TRAFFIC_LOAD_MAX             = 1000000
+	TRAFFIC_LOAD_HOURS           = 24
 )
 
 // Enums
 var LATENCY_DIST_ENUM = []string{"Normal", "Pareto", "Paretonormal", "Uniform"}
+var PACKET_LOSS_MODEL_ENUM = []string{"", "Random", "GilbertElliott"}
 var EOP_MODE_ENUM = []string{"LOOP", "REVERSE"}
 var GPU_TYPE_ENUM = []string{"NVIDIA"}
 var PROTOCOL_ENUM = []string{"UDP", "TCP"}
 var CONNECTIVITY_MODEL_ENUM = []string{"OPEN", "PDU"}
+var NET_CHAR_ALGORITHM_ENUM = []string{"", "SEGMENT", "WEIGHTED_FAIR_SHARE", "STRICT_PRIORITY"}
+var HANDOVER_POLICY_ENUM = []string{"", "DISTANCE", "A3_RSRP", "A3_RSRQ"}
+var PROPAGATION_MODEL_ENUM = []string{"", "LINEAR", "LOG_DISTANCE", "OKUMURA_HATA", "COST231_HATA", "3GPP_38901_UMA", "3GPP_38901_UMI"}
 
 // Current validator version
 var ValidatorVersion = semver.Version{Major: 1, Minor: 9, Patch: 2}
@@ -490,11 +520,26 @@ func validateScenario(scenario *dataModel.Scenario) error {
 	if err := validateDeployment(deployment); err != nil {
 		return err
 	}
+	if err := validateNetCharProfile(deployment.NetChar); err != nil {
+		return err
+	}
+	sliceMap, err := validateNetworkSlices(deployment.NetworkSlices)
+	if err != nil {
+		return err
+	}
+	if err := ValidateTrafficLoadModel(deployment.TrafficLoadModel); err != nil {
+		return err
+	}
 
 	// Validate domains
 	for domainIndex := range deployment.Domains {
 		domain := &deployment.Domains[domainIndex]
-		// TODO -- Add Domain validation
+		if err := validateHandover(domain.HandoverConfig); err != nil {
+			return err
+		}
+		if err := validateNetCharProfile(domain.NetChar); err != nil {
+			return err
+		}
 		if err := validateUniqueId(domain.Id, idMap); err != nil {
 			return err
 		}
@@ -505,7 +550,9 @@ func validateScenario(scenario *dataModel.Scenario) error {
 		// Validate zones
 		for zoneIndex := range domain.Zones {
 			zone := &domain.Zones[zoneIndex]
-			// TODO -- Add Zone validation
+			if err := validateNetCharProfile(zone.NetChar); err != nil {
+				return err
+			}
 			if err := validateUniqueId(zone.Id, idMap); err != nil {
 				return err
 			}
@@ -516,7 +563,15 @@ func validateScenario(scenario *dataModel.Scenario) error {
 			// Validate Network Locations
 			for nlIndex := range zone.NetworkLocations {
 				nl := &zone.NetworkLocations[nlIndex]
-				// TODO -- Add NetworkLocation validation
+				if err := validateNetLoc(nl); err != nil {
+					return err
+				}
+				if err := validateNetCharProfile(nl.NetChar); err != nil {
+					return err
+				}
+				if err := validateNetworkSliceRefs(nl.NetworkSlices, sliceMap); err != nil {
+					return err
+				}
 				if err := validateUniqueId(nl.Id, idMap); err != nil {
 					return err
 				}
@@ -530,6 +585,14 @@ func validateScenario(scenario *dataModel.Scenario) error {
 					if err := validatePhyLoc(pl); err != nil {
 						return err
 					}
+					if err := validateNetCharProfile(pl.NetChar); err != nil {
+						return err
+					}
+					if pl.DataNetwork != nil {
+						if err := validateNetworkSliceRefs(pl.DataNetwork.NetworkSlices, sliceMap); err != nil {
+							return err
+						}
+					}
 					if err := validateUniqueId(pl.Id, idMap); err != nil {
 						return err
 					}
@@ -543,6 +606,9 @@ func validateScenario(scenario *dataModel.Scenario) error {
 						if err := validateProc(proc); err != nil {
 							return err
 						}
+						if err := validateNetCharProfile(proc.NetChar); err != nil {
+							return err
+						}
 						if err := validateUniqueId(proc.Id, idMap); err != nil {
 							return err
 						}
@@ -598,6 +664,170 @@ func validateDeployment(deployment *dataModel.Deployment) (err error) {
 		return errors.New("Invalid D2D radius: " + err.Error())
 	}
 
+	// Net Char Algorithm
+	err = validateStringEnum(deployment.NetCharAlgorithm, NET_CHAR_ALGORITHM_ENUM)
+	if err != nil {
+		return errors.New("Invalid net char algorithm: " + err.Error())
+	}
+
+	return nil
+}
+
+// Validate the network slices; returns map of network slice names
+func validateNetworkSlices(slices []dataModel.NetworkSlice) (sliceMap map[string]bool, err error) {
+	sliceMap = make(map[string]bool)
+	idMap := make(map[string]bool)
+	snssaiMap := make(map[string]bool)
+	totalShare := float64(0)
+	for index := range slices {
+		slice := &slices[index]
+		// ID: Create new UUID if none provided
+		if slice.Id == "" {
+			slice.Id = uuid.New().String()
+		}
+		if err = validateUniqueId(slice.Id, idMap); err != nil {
+			return nil, err
+		}
+		// Name
+		if err = validateName(slice.Name); err != nil {
+			return nil, errors.New("Invalid network slice name: " + err.Error())
+		}
+		if err = validateUniqueName(slice.Name, sliceMap); err != nil {
+			return nil, err
+		}
+		// S-NSSAI
+		if slice.Snssai == nil {
+			return nil, errors.New("Missing S-NSSAI for network slice: " + slice.Name)
+		}
+		if err = dataModel.ValidateSnssai(slice.Snssai); err != nil {
+			return nil, err
+		}
+		snssai := GetSnssaiKey(slice.Snssai)
+		if _, found := snssaiMap[snssai]; found {
+			return nil, errors.New("S-NSSAI not unique: " + snssai)
+		}
+		snssaiMap[snssai] = true
+		// Capacity share
+		err = validateFloat64Range(slice.CapacityShare, PERCENTAGE_MIN, PERCENTAGE_MAX)
+		if err != nil {
+			return nil, errors.New("Invalid network slice capacity share: " + err.Error())
+		}
+		totalShare += slice.CapacityShare
+	}
+	if totalShare > PERCENTAGE_MAX {
+		return nil, errors.New("Network slice capacity shares exceed 100%")
+	}
+	return sliceMap, nil
+}
+
+// Validate the network slice references
+func validateNetworkSliceRefs(names []string, sliceMap map[string]bool) (err error) {
+	for _, name := range names {
+		if _, found := sliceMap[name]; !found {
+			return errors.New("Unknown network slice: " + name)
+		}
+	}
+	return nil
+}
+
+// ValidateTrafficLoadModel - Validate the provided traffic load model
+func ValidateTrafficLoadModel(model *dataModel.TrafficLoadModel) (err error) {
+	// Optional field
+	if model == nil {
+		return nil
+	}
+	// UE load
+	if model.UeLoad < TRAFFIC_LOAD_MIN || model.UeLoad > TRAFFIC_LOAD_MAX {
+		return errors.New("Invalid traffic load model UE load: value out of range")
+	}
+	// Cells
+	cellMap := make(map[string]bool)
+	for index := range model.Cells {
+		cell := &model.Cells[index]
+		if err = ValidateTrafficLoadCell(cell); err != nil {
+			return err
+		}
+		if err = validateUniqueName(cell.Name, cellMap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateTrafficLoadCell - Validate the provided traffic load cell
+func ValidateTrafficLoadCell(cell *dataModel.TrafficLoadCell) (err error) {
+	if cell == nil {
+		return errors.New("cell == nil")
+	}
+	// Name
+	if err = validateVariableName(cell.Name); err != nil {
+		return errors.New("Invalid traffic load cell name: " + err.Error())
+	}
+	// Area: exterior ring must be a closed ring of at least 4 positions
+	area := cell.Area
+	if area == nil || area.Type_ != "Polygon" || len(area.Coordinates) == 0 {
+		return errors.New("Invalid traffic load cell area: " + cell.Name)
+	}
+	for _, ring := range area.Coordinates {
+		if len(ring) < 4 {
+			return errors.New("Invalid traffic load cell area ring: " + cell.Name)
+		}
+		for _, position := range ring {
+			if len(position) != 2 {
+				return errors.New("Invalid traffic load cell area position: " + cell.Name)
+			}
+		}
+		first := ring[0]
+		last := ring[len(ring)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return errors.New("Traffic load cell area ring not closed: " + cell.Name)
+		}
+	}
+	// Hourly loads
+	if len(cell.Loads) != TRAFFIC_LOAD_HOURS {
+		return errors.New("Traffic load cell must provide 24 hourly loads: " + cell.Name)
+	}
+	for _, load := range cell.Loads {
+		err = validateInt32Range(load, TRAFFIC_LOAD_MIN, TRAFFIC_LOAD_MAX)
+		if err != nil {
+			return errors.New("Invalid traffic load cell load: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// GetSnssaiKey - Get S-NSSAI string representation (SST-SD)
+func GetSnssaiKey(snssai *dataModel.Snssai) string {
+	if snssai == nil {
+		return ""
+	}
+	if snssai.Sd == "" {
+		return strconv.Itoa(int(snssai.Sst))
+	}
+	return strconv.Itoa(int(snssai.Sst)) + "-" + strings.ToLower(snssai.Sd)
+}
+
+// Validate the provided Network Location
+func validateNetLoc(nl *dataModel.NetworkLocation) (err error) {
+	// Propagation models
+	if nl.Poa4GConfig != nil {
+		err = validatePropagation(nl.Poa4GConfig.Propagation)
+		if err != nil {
+			return err
+		}
+	}
+	if nl.Poa5GConfig != nil {
+		err = validatePropagation(nl.Poa5GConfig.Propagation)
+		if err != nil {
+			return err
+		}
+	}
+	if nl.PoaWifiConfig != nil {
+		err = validatePropagation(nl.PoaWifiConfig.Propagation)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -641,6 +871,11 @@ func validatePhyLoc(pl *dataModel.PhysicalLocation) (err error) {
 	if err != nil {
 		return err
 	}
+	// Handover policy
+	err = validateHandover(pl.HandoverConfig)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -664,6 +899,15 @@ func validateProc(proc *dataModel.Process) (err error) {
 	if err != nil {
 		return err
 	}
+	// Traffic weight & priority
+	err = validateInt32Range(proc.TrafficWeight, TRAFFIC_WEIGHT_MIN, TRAFFIC_WEIGHT_MAX)
+	if err != nil {
+		return errors.New("Invalid traffic weight: " + err.Error())
+	}
+	err = validateInt32Range(proc.TrafficPriority, TRAFFIC_PRIORITY_MIN, TRAFFIC_PRIORITY_MAX)
+	if err != nil {
+		return errors.New("Invalid traffic priority: " + err.Error())
+	}
 
 	// App deployment type-specific validation
 	if proc.IsExternal {
@@ -778,6 +1022,34 @@ func validateNetChar(nc *dataModel.NetworkCharacteristics) (err error) {
 	if err != nil {
 		return errors.New("Invalid packet loss: " + err.Error())
 	}
+	err = validateStringEnum(nc.PacketLossModel, PACKET_LOSS_MODEL_ENUM)
+	if err != nil {
+		return errors.New("Invalid packet loss model: " + err.Error())
+	}
+	err = validateGilbertElliottLoss(nc.GilbertElliott)
+	if err != nil {
+		return errors.New("Invalid Gilbert-Elliott loss: " + err.Error())
+	}
+	err = validateFloat64Range(nc.LatencyCorrelation, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid latency correlation: " + err.Error())
+	}
+	err = validateFloat64Range(nc.PacketDuplication, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid packet duplication: " + err.Error())
+	}
+	err = validateFloat64Range(nc.PacketCorruption, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid packet corruption: " + err.Error())
+	}
+	err = validateFloat64Range(nc.PacketReordering, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid packet reordering: " + err.Error())
+	}
+	err = validateFloat64Range(nc.PacketReorderingCorrelation, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid packet reordering correlation: " + err.Error())
+	}
 	err = validateInt32Range(nc.ThroughputUl, THROUGHPUT_MIN, THROUGHPUT_MAX)
 	if err != nil {
 		return errors.New("Invalid UL throughput: " + err.Error())
@@ -789,6 +1061,125 @@ func validateNetChar(nc *dataModel.NetworkCharacteristics) (err error) {
 	return nil
 }
 
+func validateNetCharProfile(nc *dataModel.NetworkCharacteristics) (err error) {
+	// Optional field
+	if nc == nil || nc.Profile == nil {
+		return nil
+	}
+	err = validateInt32Range(nc.Profile.Interval, PROFILE_INTERVAL_MIN, PROFILE_INTERVAL_MAX)
+	if err != nil {
+		return errors.New("Invalid profile interval: " + err.Error())
+	}
+	samples, err := GetNetCharProfileSamples(nc.Profile)
+	if err != nil {
+		return errors.New("Invalid profile trace: " + err.Error())
+	}
+	if len(samples) == 0 || len(samples) > PROFILE_SAMPLES_MAX {
+		return errors.New("Invalid profile sample count: " + strconv.Itoa(len(samples)))
+	}
+	for index, sample := range samples {
+		err = validateNetCharProfileSample(&sample)
+		if err != nil {
+			return errors.New("Invalid profile sample[" + strconv.Itoa(index) + "]: " + err.Error())
+		}
+	}
+	return nil
+}
+
+func validateNetCharProfileSample(sample *dataModel.NetCharProfileSample) (err error) {
+	err = validateInt32Range(sample.Latency, LATENCY_MIN, LATENCY_MAX)
+	if err != nil {
+		return errors.New("Invalid latency: " + err.Error())
+	}
+	err = validateInt32Range(sample.LatencyVariation, JITTER_MIN, JITTER_MAX)
+	if err != nil {
+		return errors.New("Invalid jitter: " + err.Error())
+	}
+	err = validateFloat64Range(sample.PacketLoss, PACKET_LOSS_MIN, PACKET_LOSS_MAX)
+	if err != nil {
+		return errors.New("Invalid packet loss: " + err.Error())
+	}
+	// Throughput of 0 uses the default link throughput
+	err = validateInt32Range(sample.ThroughputUl, 0, THROUGHPUT_MAX)
+	if err != nil {
+		return errors.New("Invalid UL throughput: " + err.Error())
+	}
+	err = validateInt32Range(sample.ThroughputDl, 0, THROUGHPUT_MAX)
+	if err != nil {
+		return errors.New("Invalid DL throughput: " + err.Error())
+	}
+	return nil
+}
+
+// GetNetCharProfileSamples - Get profile samples, parsing the CSV trace if no samples are provided
+func GetNetCharProfileSamples(profile *dataModel.NetCharProfile) (samples []dataModel.NetCharProfileSample, err error) {
+	if profile == nil {
+		return nil, nil
+	}
+	if len(profile.Samples) != 0 || profile.Trace == "" {
+		return profile.Samples, nil
+	}
+	return ParseNetCharProfileTrace(profile.Trace)
+}
+
+// ParseNetCharProfileTrace - Parse CSV trace with columns latency,latencyVariation,throughputDl,throughputUl,packetLoss
+// Empty, header & comment lines are ignored
+func ParseNetCharProfileTrace(trace string) (samples []dataModel.NetCharProfileSample, err error) {
+	for index, line := range strings.Split(trace, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "latency") {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) != 5 {
+			return nil, errors.New("Invalid field count on line " + strconv.Itoa(index+1))
+		}
+		var values [4]int64
+		for i := 0; i < 4; i++ {
+			values[i], err = strconv.ParseInt(strings.TrimSpace(fields[i]), 10, 32)
+			if err != nil {
+				return nil, errors.New("Invalid value on line " + strconv.Itoa(index+1) + ": " + err.Error())
+			}
+		}
+		packetLoss, err := strconv.ParseFloat(strings.TrimSpace(fields[4]), 64)
+		if err != nil {
+			return nil, errors.New("Invalid value on line " + strconv.Itoa(index+1) + ": " + err.Error())
+		}
+		sample := dataModel.NetCharProfileSample{
+			Latency:          int32(values[0]),
+			LatencyVariation: int32(values[1]),
+			ThroughputDl:     int32(values[2]),
+			ThroughputUl:     int32(values[3]),
+			PacketLoss:       packetLoss,
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+func validateGilbertElliottLoss(ge *dataModel.GilbertElliottLoss) (err error) {
+	// Optional field
+	if ge == nil {
+		return nil
+	}
+	err = validateFloat64Range(ge.R, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid r: " + err.Error())
+	}
+	err = validateFloat64Range(ge.LossBad, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid bad state loss: " + err.Error())
+	}
+	err = validateFloat64Range(ge.LossGood, PERCENTAGE_MIN, PERCENTAGE_MAX)
+	if err != nil {
+		return errors.New("Invalid good state loss: " + err.Error())
+	}
+	if ge.LossGood > ge.LossBad {
+		return errors.New("Good state loss must not exceed bad state loss")
+	}
+	return nil
+}
+
 func validateDataNetwork(dn *dataModel.DnConfig, typ string) (err error) {
 	// Optional field
 	if dn == nil {
@@ -845,6 +1236,64 @@ func validateGeoData(gd *dataModel.GeoData, typ string) (err error) {
 	return nil
 }
 
+func validatePropagation(prop *dataModel.PropagationConfig) (err error) {
+	// Optional field
+	if prop == nil {
+		return nil
+	}
+	err = validateStringEnum(prop.Model, PROPAGATION_MODEL_ENUM)
+	if err != nil {
+		return errors.New("Invalid propagation model: " + err.Error())
+	}
+	if prop.TxPower != nil {
+		err = validateFloat32Range(*prop.TxPower, TX_POWER_MIN, TX_POWER_MAX)
+		if err != nil {
+			return errors.New("Invalid transmit power: " + err.Error())
+		}
+	}
+	err = validateFloat32Range(prop.Frequency, FREQUENCY_MIN, FREQUENCY_MAX)
+	if err != nil {
+		return errors.New("Invalid frequency: " + err.Error())
+	}
+	err = validateFloat32Range(prop.AntennaHeight, ANTENNA_HEIGHT_MIN, ANTENNA_HEIGHT_MAX)
+	if err != nil {
+		return errors.New("Invalid antenna height: " + err.Error())
+	}
+	err = validateFloat32Range(prop.UeAntennaHeight, ANTENNA_HEIGHT_MIN, ANTENNA_HEIGHT_MAX)
+	if err != nil {
+		return errors.New("Invalid UE antenna height: " + err.Error())
+	}
+	err = validateFloat32Range(prop.PathLossExponent, PATH_LOSS_EXPONENT_MIN, PATH_LOSS_EXPONENT_MAX)
+	if err != nil {
+		return errors.New("Invalid path loss exponent: " + err.Error())
+	}
+	return nil
+}
+
+func validateHandover(ho *dataModel.HandoverConfig) (err error) {
+	// Optional field
+	if ho == nil {
+		return nil
+	}
+	err = validateStringEnum(ho.Policy, HANDOVER_POLICY_ENUM)
+	if err != nil {
+		return errors.New("Invalid handover policy: " + err.Error())
+	}
+	err = validateFloat32Range(ho.A3Offset, HO_OFFSET_MIN, HO_OFFSET_MAX)
+	if err != nil {
+		return errors.New("Invalid A3 offset: " + err.Error())
+	}
+	err = validateFloat32Range(ho.Hysteresis, HO_HYSTERESIS_MIN, HO_HYSTERESIS_MAX)
+	if err != nil {
+		return errors.New("Invalid hysteresis: " + err.Error())
+	}
+	err = validateInt32Range(ho.TimeToTrigger, HO_TIME_TO_TRIGGER_MIN, HO_TIME_TO_TRIGGER_MAX)
+	if err != nil {
+		return errors.New("Invalid time-to-trigger: " + err.Error())
+	}
+	return nil
+}
+
 func validateGpuConfig(cfg *dataModel.GpuConfig) (err error) {
 	// Optional field
 	if cfg == nil {
@@ -643,3 +643,217 @@ func TestValidateProc(t *testing.T) {
 
 	// TODO
 }
+
+func TestValidateNetCharProfile(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// No profile
+	err := validateNetCharProfile(nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	nc := dataModel.NetworkCharacteristics{}
+	err = validateNetCharProfile(&nc)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	// Profile samples
+	nc.Profile = &dataModel.NetCharProfile{Interval: 1000}
+	err = validateNetCharProfile(&nc)
+	if err == nil {
+		t.Fatalf("Profile without samples should be invalid")
+	}
+	nc.Profile.Samples = []dataModel.NetCharProfileSample{{Latency: 10, ThroughputDl: 100, ThroughputUl: 50, PacketLoss: 1}}
+	err = validateNetCharProfile(&nc)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	nc.Profile.Interval = 10
+	err = validateNetCharProfile(&nc)
+	if err == nil {
+		t.Fatalf("Profile interval should be invalid")
+	}
+	nc.Profile.Interval = 1000
+	nc.Profile.Samples[0].PacketLoss = 101
+	err = validateNetCharProfile(&nc)
+	if err == nil {
+		t.Fatalf("Profile sample should be invalid")
+	}
+
+	// Profile trace
+	nc.Profile.Samples = nil
+	nc.Profile.Trace = "latency,latencyVariation,throughputDl,throughputUl,packetLoss\n# drive test\n20,5,100,50,0.5\n\n 30, 10, 80, 40, 1\n"
+	err = validateNetCharProfile(&nc)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	samples, err := GetNetCharProfileSamples(nc.Profile)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(samples) != 2 ||
+		samples[0] != (dataModel.NetCharProfileSample{Latency: 20, LatencyVariation: 5, ThroughputDl: 100, ThroughputUl: 50, PacketLoss: 0.5}) ||
+		samples[1] != (dataModel.NetCharProfileSample{Latency: 30, LatencyVariation: 10, ThroughputDl: 80, ThroughputUl: 40, PacketLoss: 1}) {
+		t.Fatalf("Invalid profile trace samples")
+	}
+	nc.Profile.Trace = "20,5,100,50"
+	err = validateNetCharProfile(&nc)
+	if err == nil {
+		t.Fatalf("Profile trace should be invalid")
+	}
+	nc.Profile.Trace = "20,5,abc,50,1"
+	err = validateNetCharProfile(&nc)
+	if err == nil {
+		t.Fatalf("Profile trace should be invalid")
+	}
+}
+
+func TestValidateNetworkSlices(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Valid slices
+	slices := []dataModel.NetworkSlice{
+		{Name: "embb", Snssai: &dataModel.Snssai{Sst: 1}, CapacityShare: 60},
+		{Name: "urllc", Snssai: &dataModel.Snssai{Sst: 2, Sd: "00A1b2"}, CapacityShare: 30},
+	}
+	sliceMap, err := validateNetworkSlices(slices)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(sliceMap) != 2 || slices[0].Id == "" || slices[1].Id == "" {
+		t.Fatalf("Invalid network slice map")
+	}
+	if GetSnssaiKey(slices[1].Snssai) != "2-00a1b2" {
+		t.Fatalf("Invalid S-NSSAI key")
+	}
+
+	// Slice references
+	err = validateNetworkSliceRefs([]string{"embb", "urllc"}, sliceMap)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	err = validateNetworkSliceRefs([]string{"mmtc"}, sliceMap)
+	if err == nil {
+		t.Fatalf("Unknown network slice should be invalid")
+	}
+
+	// Invalid slices
+	slices[1].CapacityShare = 50
+	_, err = validateNetworkSlices(slices)
+	if err == nil {
+		t.Fatalf("Capacity shares should be invalid")
+	}
+	slices[1].CapacityShare = 30
+	slices[1].Snssai.Sd = "xyz"
+	_, err = validateNetworkSlices(slices)
+	if err == nil {
+		t.Fatalf("SD should be invalid")
+	}
+	slices[1].Snssai = &dataModel.Snssai{Sst: 1}
+	_, err = validateNetworkSlices(slices)
+	if err == nil {
+		t.Fatalf("Duplicate S-NSSAI should be invalid")
+	}
+	slices[1].Snssai = &dataModel.Snssai{Sst: 256}
+	_, err = validateNetworkSlices(slices)
+	if err == nil {
+		t.Fatalf("SST should be invalid")
+	}
+	slices[1].Snssai = &dataModel.Snssai{Sst: 2}
+	slices[1].Name = "embb"
+	_, err = validateNetworkSlices(slices)
+	if err == nil {
+		t.Fatalf("Duplicate name should be invalid")
+	}
+}
+
+func TestValidateSnssai(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Validate S-NSSAI")
+	for _, snssai := range []*dataModel.Snssai{{Sst: 1}, {Sst: 255, Sd: "0a0B0c"}} {
+		if err := dataModel.ValidateSnssai(snssai); err != nil {
+			t.Fatalf("Valid S-NSSAI failed validation: %s", err.Error())
+		}
+	}
+	for _, snssai := range []*dataModel.Snssai{{Sst: -1}, {Sst: 256}, {Sst: 1, Sd: "12345"}, {Sst: 1, Sd: "12345g"}} {
+		if err := dataModel.ValidateSnssai(snssai); err == nil {
+			t.Fatalf("Invalid S-NSSAI passed validation")
+		}
+	}
+}
+
+func TestValidateTrafficLoadModel(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Optional model
+	err := ValidateTrafficLoadModel(nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	// Valid model
+	loads := make([]int32, TRAFFIC_LOAD_HOURS)
+	for i := range loads {
+		loads[i] = int32(10 * i)
+	}
+	area := &dataModel.Polygon{
+		Type_:       "Polygon",
+		Coordinates: [][][]float32{{{7.41, 43.72}, {7.42, 43.72}, {7.42, 43.73}, {7.41, 43.73}, {7.41, 43.72}}},
+	}
+	model := &dataModel.TrafficLoadModel{
+		UeLoad: 5,
+		Cells: []dataModel.TrafficLoadCell{
+			{Name: "cell1", Area: area, Loads: loads},
+			{Name: "cell2", Area: area, Loads: loads},
+		},
+	}
+	err = ValidateTrafficLoadModel(model)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	// Invalid model
+	model.UeLoad = -1
+	err = ValidateTrafficLoadModel(model)
+	if err == nil {
+		t.Fatalf("UE load should be invalid")
+	}
+	model.UeLoad = 5
+	model.Cells[1].Name = "cell1"
+	err = ValidateTrafficLoadModel(model)
+	if err == nil {
+		t.Fatalf("Duplicate name should be invalid")
+	}
+
+	// Invalid cells
+	cell := &dataModel.TrafficLoadCell{Name: "cell3", Area: area, Loads: loads[:23]}
+	err = ValidateTrafficLoadCell(cell)
+	if err == nil {
+		t.Fatalf("Hourly loads should be invalid")
+	}
+	cell.Loads = append([]int32{-1}, loads[1:]...)
+	err = ValidateTrafficLoadCell(cell)
+	if err == nil {
+		t.Fatalf("Negative load should be invalid")
+	}
+	cell.Loads = loads
+	cell.Area = &dataModel.Polygon{
+		Type_:       "Polygon",
+		Coordinates: [][][]float32{{{7.41, 43.72}, {7.42, 43.72}, {7.42, 43.73}, {7.41, 43.73}}},
+	}
+	err = ValidateTrafficLoadCell(cell)
+	if err == nil {
+		t.Fatalf("Open ring should be invalid")
+	}
+	cell.Area = nil
+	err = ValidateTrafficLoadCell(cell)
+	if err == nil {
+		t.Fatalf("Missing area should be invalid")
+	}
+}
@@ -71,8 +71,10 @@ const (
 	MsgMgLbRulesUpdate Message = "MG-LB-RULES-UPDATE"
 
 	// Traffic Control
-	MsgTcLbRulesUpdate  Message = "TC-LB-RULES-UPDATE"
-	MsgTcNetRulesUpdate Message = "TC-NET-RULES-UPDATE"
+	MsgTcLbRulesUpdate      Message = "TC-LB-RULES-UPDATE"
+	MsgTcNetRulesUpdate     Message = "TC-NET-RULES-UPDATE"
+	MsgTcTrafficRulesUpdate Message = "TC-TRAFFIC-RULES-UPDATE"
+	MsgTcDnsRulesUpdate     Message = "TC-DNS-RULES-UPDATE"
 
 	// GIS Engine
 	MsgGeUpdate Message = "GIS-ENGINE-UPDATE"
@@ -83,6 +85,10 @@ const (
 	MsgAppRemoveCnf Message = "APP-REMOVE-CNF"
 	MsgAppFlush     Message = "APP-FLUSH"
 
+	// Application Traffic & DNS Rules
+	MsgTrafficRulesUpdate Message = "TRAFFIC-RULES-UPDATE"
+	MsgDnsRulesUpdate     Message = "DNS-RULES-UPDATE"
+
 	// MEC Services
 	MsgMecSvcUpdate Message = "MEC-SVC-UPDATE"
 
@@ -71,6 +71,7 @@ type SegAlgoSegment struct {
 	InactivityIncrementalStep float64
 	TolerationThreshold       float64
 	ActionUpperThreshold      float64
+	SliceShares               map[string]float64
 	Flows                     []*SegAlgoFlow
 }
 
@@ -84,6 +85,9 @@ type SegAlgoFlow struct {
 	ComputedLatency               float64
 	ComputedJitter                float64
 	ComputedPacketLoss            float64
+	ComputedDuplication           float64
+	ComputedCorruption            float64
+	ComputedReordering            float64
 	AllocatedThroughput           float64 //allocated
 	AllocatedThroughputLowerBound float64 //allocated
 	AllocatedThroughputUpperBound float64 //allocated
@@ -97,6 +101,12 @@ type SegAlgoFlow struct {
 	CurrentThroughputEgress       float64 //measured
 	Path                          *SegAlgoPath
 	UpdateRequired                bool
+	Weight                        float64
+	Priority                      int32
+	GuaranteedThroughput          float64
+	PacketDelayBudget             float64
+	DelayBudgetExceeded           bool
+	Slice                         string
 }
 
 // SegAlgoPath -
@@ -104,6 +114,8 @@ type SegAlgoPath struct {
 	Name         string
 	Segments     []*SegAlgoSegment
 	Disconnected bool
+	PduSession   *dataModel.PduSessionInfo
+	Slice        string
 }
 
 // SegAlgoNetElem -
@@ -115,6 +127,8 @@ type SegAlgoNetElem struct {
 	ZoneName          string
 	DomainName        string
 	ConfiguredNetChar ElemNetChar
+	Weight            int32
+	Priority          int32
 }
 
 // SegmentAlgorithm -
@@ -128,12 +142,20 @@ type SegmentAlgorithm struct {
 	D2DDeviceList         map[string]string
 	D2DMaxDistance        float32
 	D2DViaNetworkDisabled bool
+	BwPolicy              SegAlgoBwPolicy
+	NetworkSlices         map[string]*SegAlgoSlice
+	PoaSlices             map[string][]string
 	Config                SegAlgoConfig
 	rc                    *redis.Connector
 }
 
 // NewSegmentAlgorithm - Create, Initialize and connect
 func NewSegmentAlgorithm(name string, namespace string, redisAddr string) (*SegmentAlgorithm, error) {
+	return NewSegmentAlgorithmWithBwPolicy(name, namespace, redisAddr, &MaxMinFairShareBwPolicy{})
+}
+
+// NewSegmentAlgorithmWithBwPolicy - Create, Initialize and connect using the provided bandwidth sharing policy
+func NewSegmentAlgorithmWithBwPolicy(name string, namespace string, redisAddr string, bwPolicy SegAlgoBwPolicy) (*SegmentAlgorithm, error) {
 	// Create new instance & set default config
 	var err error
 	var algo SegmentAlgorithm
@@ -146,6 +168,9 @@ func NewSegmentAlgorithm(name string, namespace string, redisAddr string) (*Segm
 	algo.D2DDeviceList = map[string]string{}
 	algo.D2DMaxDistance = 100
 	algo.D2DViaNetworkDisabled = false
+	algo.BwPolicy = bwPolicy
+	algo.NetworkSlices = map[string]*SegAlgoSlice{}
+	algo.PoaSlices = map[string][]string{}
 	algo.Config.MaxBwPerInactiveFlow = 20.0
 	algo.Config.MaxBwPerInactiveFlowFloor = 6.0
 	algo.Config.MinActivityThreshold = 0.3
@@ -167,6 +192,14 @@ func NewSegmentAlgorithm(name string, namespace string, redisAddr string) (*Segm
 	return &algo, nil
 }
 
+// Release - Close connection to Metrics redis DB
+func (algo *SegmentAlgorithm) Release() {
+	err := algo.rc.Close()
+	if err != nil {
+		log.Error("Failed to close Metrics redis DB connection. Error: ", err)
+	}
+}
+
 // ProcessScenario -
 func (algo *SegmentAlgorithm) ProcessScenario(model *mod.Model, pduSessions map[string]map[string]*dataModel.PduSessionInfo,
 	d2dSessions map[string]map[string]bool) error {
@@ -198,6 +231,9 @@ func (algo *SegmentAlgorithm) ProcessScenario(model *mod.Model, pduSessions map[
 		}
 	}
 
+	// Get scenario network slices
+	algo.loadNetworkSlices(model, deployment)
+
 	// Get D2D capable device list
 	algo.D2DDeviceList = map[string]string{}
 	phyLocsFilter := &mod.NodeFilter{
@@ -259,6 +295,9 @@ func (algo *SegmentAlgorithm) ProcessScenario(model *mod.Model, pduSessions map[
 		element.ConfiguredNetChar.Latency = float64(proc.NetChar.Latency)
 		element.ConfiguredNetChar.Jitter = float64(proc.NetChar.LatencyVariation)
 		element.ConfiguredNetChar.Distribution = deployment.NetChar.LatencyDistribution //set global value
+		element.ConfiguredNetChar.LatencyCorrelation = deployment.NetChar.LatencyCorrelation
+		element.ConfiguredNetChar.ReorderCorrelation = deployment.NetChar.PacketReorderingCorrelation
+		element.ConfiguredNetChar.LossModel = getLossModel(deployment.NetChar)
 		element.ConfiguredNetChar.ThroughputDl = float64(proc.NetChar.ThroughputUl)
 		element.ConfiguredNetChar.ThroughputUl = float64(proc.NetChar.ThroughputUl)
 		element.ConfiguredNetChar.PacketLoss = float64(proc.NetChar.PacketLoss)
@@ -270,6 +309,10 @@ func (algo *SegmentAlgorithm) ProcessScenario(model *mod.Model, pduSessions map[
 			element.ConfiguredNetChar.ThroughputDl = DEFAULT_THROUGHPUT_LINK
 		}
 
+		// Set traffic weight & priority
+		element.Weight = proc.TrafficWeight
+		element.Priority = proc.TrafficPriority
+
 		// Add element to list
 		netElemList = append(netElemList, *element)
 	}
@@ -336,10 +379,17 @@ func (algo *SegmentAlgorithm) CalculateNetChar() []FlowNetChar {
 			}
 		}
 
+		lossModel := getFlowLossModel(flow.ConfiguredNetChar.LossModel, flow.ComputedPacketLoss)
 		if (flow.ComputedLatency != flow.AppliedNetChar.Latency) ||
 			(flow.ComputedJitter != flow.AppliedNetChar.Jitter) ||
 			(flow.ComputedPacketLoss != flow.AppliedNetChar.PacketLoss) ||
-			(flow.ConfiguredNetChar.Distribution != flow.AppliedNetChar.Distribution) {
+			(flow.ComputedDuplication != flow.AppliedNetChar.Duplication) ||
+			(flow.ComputedCorruption != flow.AppliedNetChar.Corruption) ||
+			(flow.ComputedReordering != flow.AppliedNetChar.Reordering) ||
+			(flow.ConfiguredNetChar.Distribution != flow.AppliedNetChar.Distribution) ||
+			(flow.ConfiguredNetChar.LatencyCorrelation != flow.AppliedNetChar.LatencyCorrelation) ||
+			(flow.ConfiguredNetChar.ReorderCorrelation != flow.AppliedNetChar.ReorderCorrelation) ||
+			(lossModel != flow.AppliedNetChar.LossModel) {
 			if algo.Config.LogVerbose {
 				log.Info("Update other netchars for ", flow.Name, " to ", flow.ComputedLatency, "-", flow.ComputedJitter, "-", flow.ComputedPacketLoss, " from ", flow.AppliedNetChar.Latency, "-", flow.AppliedNetChar.Jitter, "-", flow.AppliedNetChar.PacketLoss, "-", flow.AppliedNetChar.Distribution)
 			}
@@ -347,13 +397,18 @@ func (algo *SegmentAlgorithm) CalculateNetChar() []FlowNetChar {
 			flow.AppliedNetChar.Latency = flow.ComputedLatency
 			flow.AppliedNetChar.Jitter = flow.ComputedJitter
 			flow.AppliedNetChar.PacketLoss = flow.ComputedPacketLoss
+			flow.AppliedNetChar.Duplication = flow.ComputedDuplication
+			flow.AppliedNetChar.Corruption = flow.ComputedCorruption
+			flow.AppliedNetChar.Reordering = flow.ComputedReordering
 			flow.AppliedNetChar.Distribution = flow.ConfiguredNetChar.Distribution
+			flow.AppliedNetChar.LatencyCorrelation = flow.ConfiguredNetChar.LatencyCorrelation
+			flow.AppliedNetChar.ReorderCorrelation = flow.ConfiguredNetChar.ReorderCorrelation
+			flow.AppliedNetChar.LossModel = lossModel
 			updateNeeded = true
 		}
 
 		if updateNeeded {
-			netchar := NetChar{flow.AppliedNetChar.Latency, flow.AppliedNetChar.Jitter, flow.AppliedNetChar.PacketLoss, flow.AppliedNetChar.Throughput, flow.ConfiguredNetChar.Distribution}
-			flowNetChar := FlowNetChar{flow.SrcNetElem, flow.DstNetElem, netchar}
+			flowNetChar := FlowNetChar{flow.SrcNetElem, flow.DstNetElem, flow.AppliedNetChar}
 			updatedNetCharList = append(updatedNetCharList, flowNetChar)
 		}
 	}
@@ -470,13 +525,29 @@ func (algo *SegmentAlgorithm) populateFlow(flowName string, srcElement *SegAlgoN
 	flow.ConfiguredNetChar.Throughput = maxBw
 	//using distribution to pass it down, since it is global, they all have the same data at this point, so use any elements distribution
 	flow.ConfiguredNetChar.Distribution = srcElement.ConfiguredNetChar.Distribution
+	flow.ConfiguredNetChar.LatencyCorrelation = srcElement.ConfiguredNetChar.LatencyCorrelation
+	flow.ConfiguredNetChar.ReorderCorrelation = srcElement.ConfiguredNetChar.ReorderCorrelation
+	flow.ConfiguredNetChar.LossModel = srcElement.ConfiguredNetChar.LossModel
 	flow.ConfiguredNetChar.Latency = 0
 	flow.ConfiguredNetChar.Jitter = 0
 	flow.ConfiguredNetChar.PacketLoss = 0
+	flow.ConfiguredNetChar.Duplication = 0
+	flow.ConfiguredNetChar.Corruption = 0
+	flow.ConfiguredNetChar.Reordering = 0
 	// Create a new path for this flow
 	oldPath := flow.Path
 	flow.Path = algo.createPath(flowName, srcElement, destElement, model, pduSessions, d2dSessions)
 	flow.UpdateRequired = algo.comparePath(oldPath, flow.Path)
+
+	// Set flow traffic weight & priority
+	flow.Weight, flow.Priority = getFlowTrafficClass(srcElement, destElement, flow.Path.PduSession)
+
+	// Apply PDU session QoS profile
+	uplink := srcElement.Type == mod.NodeTypeUE
+	applyFlowQos(flow, getFlowQos(flow.Path.PduSession, uplink), flow.Path.PduSession)
+
+	// Set flow network slice
+	flow.Slice = flow.Path.Slice
 }
 
 func (algo *SegmentAlgorithm) comparePath(oldPath *SegAlgoPath, newPath *SegAlgoPath) bool {
@@ -699,7 +770,8 @@ func (algo *SegmentAlgorithm) createPath(flowName string, srcElement *SegAlgoNet
 				var found bool
 				for _, pdu := range pduMap {
 					if pdu.Dnn == destPhyLoc.DataNetwork.Dnn {
-						found = true
+						path.Slice, found = algo.getPduSessionSlice(pdu, srcElement.PoaName, destPhyLoc.DataNetwork)
+						path.PduSession = pdu
 						break
 					}
 				}
@@ -722,7 +794,8 @@ func (algo *SegmentAlgorithm) createPath(flowName string, srcElement *SegAlgoNet
 				var found bool
 				for _, pdu := range pduMap {
 					if pdu.Dnn == srcPhyLoc.DataNetwork.Dnn {
-						found = true
+						path.Slice, found = algo.getPduSessionSlice(pdu, destElement.PoaName, srcPhyLoc.DataNetwork)
+						path.PduSession = pdu
 						break
 					}
 				}
@@ -822,8 +895,14 @@ func (algo *SegmentAlgorithm) createSegment(elemName string, direction string, f
 		segment.ConfiguredNetChar.Latency = float64(nc.Latency)
 		segment.ConfiguredNetChar.Jitter = float64(nc.LatencyVariation)
 		segment.ConfiguredNetChar.PacketLoss = float64(nc.PacketLoss)
+		segment.ConfiguredNetChar.Duplication = nc.PacketDuplication
+		segment.ConfiguredNetChar.Corruption = nc.PacketCorruption
+		segment.ConfiguredNetChar.Reordering = nc.PacketReordering
 		segment.ConfiguredNetChar.Throughput = float64(ncThroughput)
 
+		// Network slice capacity shares apply to POA segments
+		segment.SliceShares = algo.getPoaSliceShares(elemName)
+
 		maxThroughput := ncThroughput
 		// Initialize segment-specific BW attributes from Algo config
 		if algo.Config.IsPercentage {
@@ -889,31 +968,53 @@ func (algo *SegmentAlgorithm) reCalculateNetChar() {
 	for _, segment := range algo.SegmentMap {
 
 		//throughput specific
-		updateMaxFairShareBwPerFlow(segment)
-		unusedBw, list := needToReevaluate(segment)
-
-		if list != nil {
-			if algo.Config.LogVerbose {
-				log.Info("Segment ", segment.Name, " reevaluation result - BW unused: ", unusedBw, "***Flows to evaluate***: ", printFlowNamesFromList(list))
-			}
-
-			recalculateSegmentBw(segment, list, unusedBw)
+		if len(segment.SliceShares) != 0 {
+			algo.allocateSlicedSegmentBw(segment)
+		} else {
+			algo.BwPolicy.AllocateSegmentBw(segment, &algo.Config)
 		}
 
-		//latency, jitter, packet-loss computation for each flow in each segment
+		//latency, jitter, packet-loss & impairments computation for each flow in each segment
 		for _, flow := range segment.Flows {
 			flow.ComputedLatency += segment.ConfiguredNetChar.Latency
 			flow.ComputedJitter += segment.ConfiguredNetChar.Jitter
-			if flow.ComputedPacketLoss == 0 {
-				flow.ComputedPacketLoss = segment.ConfiguredNetChar.PacketLoss
-			} else if segment.ConfiguredNetChar.PacketLoss != 0 {
-				flow.ComputedPacketLoss += (segment.ConfiguredNetChar.PacketLoss * ((100 - flow.ComputedPacketLoss) / 100))
-			}
+			flow.ComputedPacketLoss = combinePercentage(flow.ComputedPacketLoss, segment.ConfiguredNetChar.PacketLoss)
+			flow.ComputedDuplication = combinePercentage(flow.ComputedDuplication, segment.ConfiguredNetChar.Duplication)
+			flow.ComputedCorruption = combinePercentage(flow.ComputedCorruption, segment.ConfiguredNetChar.Corruption)
+			flow.ComputedReordering = combinePercentage(flow.ComputedReordering, segment.ConfiguredNetChar.Reordering)
 		}
 		if algo.Config.LogVerbose {
 			printFlows(segment)
 		}
 	}
+
+	// Report flows exceeding their QoS packet delay budget
+	for _, flow := range algo.FlowMap {
+		checkPacketDelayBudget(flow)
+	}
+}
+
+// MaxMinFairShareBwPolicy - Max-min fair sharing of segment bandwidth between flows
+type MaxMinFairShareBwPolicy struct{}
+
+// AllocateSegmentBw - Max-min fair share allocation of segment bandwidth
+func (policy *MaxMinFairShareBwPolicy) AllocateSegmentBw(segment *SegAlgoSegment, config *SegAlgoConfig) {
+	// Segments carrying GBR flows require guaranteed bandwidth reservation
+	if hasGuaranteedFlows(segment) {
+		allocateWeightedSegmentBw(segment, false)
+		return
+	}
+
+	updateMaxFairShareBwPerFlow(segment)
+	unusedBw, list := needToReevaluate(segment)
+
+	if list != nil {
+		if config.LogVerbose {
+			log.Info("Segment ", segment.Name, " reevaluation result - BW unused: ", unusedBw, "***Flows to evaluate***: ", printFlowNamesFromList(list))
+		}
+
+		recalculateSegmentBw(segment, list, unusedBw)
+	}
 }
 
 // resetComputedNetChar -
@@ -924,6 +1025,9 @@ func resetComputedNetChar(flow *SegAlgoFlow) {
 	flow.ComputedLatency = 0
 	flow.ComputedJitter = 0
 	flow.ComputedPacketLoss = 0
+	flow.ComputedDuplication = 0
+	flow.ComputedCorruption = 0
+	flow.ComputedReordering = 0
 }
 
 // recalculateSegmentBw -
@@ -18,6 +18,7 @@ package netchar
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 
@@ -800,6 +801,321 @@ func TestSegAlgoPdu(t *testing.T) {
 
 }
 
+func TestSegAlgoWeightedBw(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Flow traffic class
+	fmt.Println("Validate flow traffic class")
+	src := &SegAlgoNetElem{Weight: 2, Priority: 5}
+	dst := &SegAlgoNetElem{Weight: 4}
+	weight, priority := getFlowTrafficClass(src, dst, nil)
+	if weight != 4 || priority != 5 {
+		t.Fatalf("Invalid traffic class: %f %d", weight, priority)
+	}
+	weight, priority = getFlowTrafficClass(src, dst, &dataModel.PduSessionInfo{TrafficWeight: 1, TrafficPriority: 10})
+	if weight != 1 || priority != 10 {
+		t.Fatalf("Invalid PDU session traffic class: %f %d", weight, priority)
+	}
+	weight, priority = getFlowTrafficClass(&SegAlgoNetElem{}, &SegAlgoNetElem{}, nil)
+	if weight != DEFAULT_TRAFFIC_WEIGHT || priority != DEFAULT_TRAFFIC_PRIORITY {
+		t.Fatalf("Invalid default traffic class: %f %d", weight, priority)
+	}
+
+	newSegment := func() *SegAlgoSegment {
+		segment := &SegAlgoSegment{
+			Name:                 "segment",
+			ConfiguredNetChar:    NetChar{Throughput: 90},
+			MaxBwPerInactiveFlow: 2,
+			MinActivityThreshold: 0.3,
+			IncrementalStep:      2,
+		}
+		for i, w := range []float64{1, 2, 3} {
+			flow := &SegAlgoFlow{
+				Name:                 fmt.Sprintf("flow%d", i),
+				ConfiguredNetChar:    NetChar{Throughput: 1000},
+				CurrentThroughput:    100,
+				AllocatedThroughput:  30,
+				MaxPlannedThroughput: MAX_THROUGHPUT,
+				Weight:               w,
+				Priority:             int32(3 - i),
+			}
+			segment.Flows = append(segment.Flows, flow)
+		}
+		return segment
+	}
+
+	// Weighted fair share
+	fmt.Println("Validate weighted fair share")
+	segment := newSegment()
+	(&WeightedFairShareBwPolicy{}).AllocateSegmentBw(segment, &SegAlgoConfig{})
+	for i, expected := range []float64{15, 30, 45} {
+		if segment.Flows[i].MaxPlannedThroughput != expected {
+			t.Fatalf("Invalid throughput for %s: %f", segment.Flows[i].Name, segment.Flows[i].MaxPlannedThroughput)
+		}
+	}
+
+	// Weighted fair share with limited demand
+	fmt.Println("Validate weighted fair share with limited demand")
+	segment = newSegment()
+	segment.Flows[2].CurrentThroughput = 8
+	allocateWeightedSegmentBw(segment, false)
+	for i, expected := range []float64{80.0 / 3, 160.0 / 3, 10} {
+		if math.Abs(segment.Flows[i].MaxPlannedThroughput-expected) > 0.001 {
+			t.Fatalf("Invalid throughput for %s: %f", segment.Flows[i].Name, segment.Flows[i].MaxPlannedThroughput)
+		}
+	}
+
+	// Strict priority
+	fmt.Println("Validate strict priority")
+	segment = newSegment()
+	segment.Flows[2].CurrentThroughput = 50
+	(&StrictPriorityBwPolicy{}).AllocateSegmentBw(segment, &SegAlgoConfig{})
+	for i, expected := range []float64{MIN_FLOW_THROUGHPUT, 38, 52} {
+		if math.Abs(segment.Flows[i].MaxPlannedThroughput-expected) > 0.001 {
+			t.Fatalf("Invalid throughput for %s: %f", segment.Flows[i].Name, segment.Flows[i].MaxPlannedThroughput)
+		}
+	}
+
+	// Inactive flow
+	fmt.Println("Validate inactive flow")
+	segment = newSegment()
+	segment.Flows[0].CurrentThroughput = 0
+	allocateWeightedSegmentBw(segment, false)
+	for i, expected := range []float64{2, 36, 54} {
+		if math.Abs(segment.Flows[i].MaxPlannedThroughput-expected) > 0.001 {
+			t.Fatalf("Invalid throughput for %s: %f", segment.Flows[i].Name, segment.Flows[i].MaxPlannedThroughput)
+		}
+	}
+
+	// Algorithm registry
+	fmt.Println("Validate algorithm registry")
+	for _, algoName := range []string{AlgoSegment, AlgoWeightedFairShare, AlgoStrictPriority} {
+		if !IsSupportedNetCharAlgo(algoName) {
+			t.Fatalf("Algorithm not registered: %s", algoName)
+		}
+	}
+	_, err := NewNetCharAlgo("UNKNOWN", testModuleName, testModuleNamespace, segAlgoRedisAddr)
+	if err == nil {
+		t.Fatalf("Unknown algorithm should fail")
+	}
+
+	// Algorithm selection
+	fmt.Println("Validate algorithm switch")
+	RegisterNetCharAlgo("TEST", func(name string, namespace string, redisAddr string) (NetCharAlgo, error) {
+		return &testNetCharAlgo{}, nil
+	})
+	defer delete(netCharAlgoRegistry, "TEST")
+	oldAlgo := &testNetCharAlgo{}
+	ncm := &NetCharManager{algo: oldAlgo, algoName: AlgoSegment, controls: map[string]string{"logVerbose": "yes"}}
+	ncm.config.Algorithm = "TEST"
+	if !ncm.selectAlgorithm() || ncm.algoName != "TEST" || !oldAlgo.released {
+		t.Fatalf("Replaced algorithm should be released")
+	}
+	newAlgo, ok := ncm.algo.(*testNetCharAlgo)
+	if !ok || newAlgo == oldAlgo || newAlgo.config["logVerbose"] != "yes" {
+		t.Fatalf("Invalid algorithm: %+v", ncm.algo)
+	}
+	if ncm.selectAlgorithm() || ncm.algo != newAlgo {
+		t.Fatalf("Unchanged algorithm should not be updated")
+	}
+}
+
+// testNetCharAlgo - NetCharAlgo stub used to validate algorithm selection
+type testNetCharAlgo struct {
+	config   map[string]string
+	released bool
+}
+
+func (algo *testNetCharAlgo) ProcessScenario(model *mod.Model, pduSessions map[string]map[string]*dataModel.PduSessionInfo,
+	d2dSessions map[string]map[string]bool) error {
+	return nil
+}
+
+func (algo *testNetCharAlgo) CalculateNetChar() []FlowNetChar {
+	return nil
+}
+
+func (algo *testNetCharAlgo) SetConfigAttribute(name string, value string) {
+	if algo.config == nil {
+		algo.config = map[string]string{}
+	}
+	algo.config[name] = value
+}
+
+func (algo *testNetCharAlgo) Release() {
+	algo.released = true
+}
+
+func TestSegAlgoQos(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Standardized 5QI characteristics
+	fmt.Println("Validate standardized 5QI")
+	pdu := &dataModel.PduSessionInfo{Dnn: "dnn", Qos: &dataModel.QosProfile{FiveQi: 1, GbrDl: 5, GbrUl: 1, MbrDl: 10, MbrUl: 2}}
+	qos := getFlowQos(pdu, false)
+	if qos == nil || qos.ResourceType != "GBR" || qos.PriorityLevel != 20 || qos.PacketDelayBudget != 100 ||
+		qos.GuaranteedBitrate != 5 || qos.MaxBitrate != 10 {
+		t.Fatalf("Invalid downlink QoS: %+v", qos)
+	}
+	qos = getFlowQos(pdu, true)
+	if qos.GuaranteedBitrate != 1 || qos.MaxBitrate != 2 {
+		t.Fatalf("Invalid uplink QoS: %+v", qos)
+	}
+
+	// Profile overrides
+	fmt.Println("Validate QoS profile overrides")
+	pdu.Qos = &dataModel.QosProfile{FiveQi: 9, PriorityLevel: 3, PacketDelayBudget: 20, GbrDl: 5}
+	qos = getFlowQos(pdu, false)
+	if qos.ResourceType != "NON_GBR" || qos.PriorityLevel != 3 || qos.PacketDelayBudget != 20 || qos.GuaranteedBitrate != 0 {
+		t.Fatalf("Invalid QoS overrides: %+v", qos)
+	}
+	if getFlowQos(&dataModel.PduSessionInfo{Dnn: "dnn"}, false) != nil {
+		t.Fatalf("QoS should not be set")
+	}
+
+	// Flow QoS configuration
+	fmt.Println("Validate flow QoS configuration")
+	flow := &SegAlgoFlow{Name: "flow", ConfiguredNetChar: NetChar{Throughput: 100}, Priority: DEFAULT_TRAFFIC_PRIORITY}
+	pdu.Qos = &dataModel.QosProfile{FiveQi: 1, GbrDl: 5, MbrDl: 10}
+	applyFlowQos(flow, getFlowQos(pdu, false), pdu)
+	if flow.ConfiguredNetChar.Throughput != 10 || flow.GuaranteedThroughput != 5 || flow.PacketDelayBudget != 100 || flow.Priority != 20 {
+		t.Fatalf("Invalid flow QoS: %+v", flow)
+	}
+
+	// Packet delay budget
+	fmt.Println("Validate packet delay budget")
+	flow.ComputedLatency = 80
+	flow.ComputedJitter = 20
+	if checkPacketDelayBudget(flow) || flow.ComputedLatency != 80 || flow.ComputedJitter != 20 {
+		t.Fatalf("Invalid packet delay budget check: %+v", flow)
+	}
+	flow.ComputedLatency = 150
+	if !checkPacketDelayBudget(flow) || !flow.DelayBudgetExceeded || flow.ComputedLatency != 150 || flow.ComputedJitter != 20 {
+		t.Fatalf("Invalid packet delay budget check: %+v", flow)
+	}
+	flow.PacketDelayBudget = 0
+	if checkPacketDelayBudget(flow) || flow.DelayBudgetExceeded {
+		t.Fatalf("Invalid packet delay budget check: %+v", flow)
+	}
+
+	// Guaranteed bit rate reservation
+	fmt.Println("Validate guaranteed bit rate")
+	segment := &SegAlgoSegment{
+		Name:                 "segment",
+		ConfiguredNetChar:    NetChar{Throughput: 100},
+		MaxBwPerInactiveFlow: 2,
+		MinActivityThreshold: 0.3,
+		IncrementalStep:      2,
+	}
+	for i, gbr := range []float64{40, 0, 0} {
+		segment.Flows = append(segment.Flows, &SegAlgoFlow{
+			Name:                 fmt.Sprintf("flow%d", i),
+			ConfiguredNetChar:    NetChar{Throughput: 1000},
+			CurrentThroughput:    1,
+			AllocatedThroughput:  30,
+			MaxPlannedThroughput: MAX_THROUGHPUT,
+			Weight:               1,
+			GuaranteedThroughput: gbr,
+		})
+	}
+	segment.Flows[1].CurrentThroughput = 100
+	segment.Flows[2].CurrentThroughput = 100
+	if !hasGuaranteedFlows(segment) {
+		t.Fatalf("Segment should have guaranteed flows")
+	}
+	allocateWeightedSegmentBw(segment, false)
+	for i, expected := range []float64{40, 30, 30} {
+		if math.Abs(segment.Flows[i].MaxPlannedThroughput-expected) > 0.001 {
+			t.Fatalf("Invalid throughput for %s: %f", segment.Flows[i].Name, segment.Flows[i].MaxPlannedThroughput)
+		}
+	}
+}
+
+func TestSegAlgoSlices(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	algo := &SegmentAlgorithm{
+		BwPolicy: &WeightedFairShareBwPolicy{},
+		NetworkSlices: map[string]*SegAlgoSlice{
+			"embb":  {Name: "embb", Snssai: "1", CapacityShare: 50},
+			"urllc": {Name: "urllc", Snssai: "2-0000a1", CapacityShare: 20},
+		},
+		PoaSlices: map[string][]string{
+			"poa1": nil,
+			"poa2": {"embb"},
+		},
+	}
+
+	// PDU session network slice
+	fmt.Println("Validate PDU session network slice")
+	dn := &dataModel.DnConfig{Dnn: "dnn"}
+	pdu := &dataModel.PduSessionInfo{Dnn: "dnn", Snssai: &dataModel.Snssai{Sst: 2, Sd: "0000A1"}}
+	slice, ok := algo.getPduSessionSlice(pdu, "poa1", dn)
+	if !ok || slice != "urllc" {
+		t.Fatalf("Invalid PDU session slice: %s", slice)
+	}
+	_, ok = algo.getPduSessionSlice(pdu, "poa2", dn)
+	if ok {
+		t.Fatalf("Network slice should not be served by POA")
+	}
+	dn.NetworkSlices = []string{"embb"}
+	_, ok = algo.getPduSessionSlice(pdu, "poa1", dn)
+	if ok {
+		t.Fatalf("Network slice should not be served by DN")
+	}
+	_, ok = algo.getPduSessionSlice(&dataModel.PduSessionInfo{Dnn: "dnn"}, "poa1", dn)
+	if ok {
+		t.Fatalf("DN should require a network slice")
+	}
+	_, ok = algo.getPduSessionSlice(&dataModel.PduSessionInfo{Dnn: "dnn", Snssai: &dataModel.Snssai{Sst: 3}}, "poa1", nil)
+	if ok {
+		t.Fatalf("Unknown network slice should not be available")
+	}
+
+	// POA slice shares
+	fmt.Println("Validate POA network slice shares")
+	if shares := algo.getPoaSliceShares("poa1"); len(shares) != 2 || shares["embb"] != 50 || shares["urllc"] != 20 {
+		t.Fatalf("Invalid POA slice shares: %v", shares)
+	}
+	if shares := algo.getPoaSliceShares("poa2"); len(shares) != 1 || shares["embb"] != 50 {
+		t.Fatalf("Invalid POA slice shares: %v", shares)
+	}
+	if shares := algo.getPoaSliceShares("zone1"); shares != nil {
+		t.Fatalf("Non-POA segment should not have slice shares")
+	}
+
+	// Sliced bandwidth allocation
+	fmt.Println("Validate network slice bandwidth allocation")
+	segment := &SegAlgoSegment{
+		Name:                 "poa1" + DirDL,
+		ConfiguredNetChar:    NetChar{Throughput: 100},
+		MaxBwPerInactiveFlow: 2,
+		MinActivityThreshold: 0.3,
+		IncrementalStep:      2,
+		SliceShares:          algo.getPoaSliceShares("poa1"),
+	}
+	for i, sliceName := range []string{"embb", "embb", "urllc", ""} {
+		segment.Flows = append(segment.Flows, &SegAlgoFlow{
+			Name:                 fmt.Sprintf("flow%d", i),
+			ConfiguredNetChar:    NetChar{Throughput: 1000},
+			CurrentThroughput:    100,
+			AllocatedThroughput:  30,
+			MaxPlannedThroughput: MAX_THROUGHPUT,
+			Weight:               1,
+			Slice:                sliceName,
+		})
+	}
+	algo.allocateSlicedSegmentBw(segment)
+	for i, expected := range []float64{25, 25, 20, 30} {
+		if math.Abs(segment.Flows[i].MaxPlannedThroughput-expected) > 0.001 {
+			t.Fatalf("Invalid throughput for %s: %f", segment.Flows[i].Name, segment.Flows[i].MaxPlannedThroughput)
+		}
+	}
+}
+
 func setMetrics(rc *redis.Connector, src string, dst string, throughput float64) bool {
 	key := dkm.GetKeyRoot(testModuleNamespace) + metricsKey + dst + ":throughput"
 	throughputStats := make(map[string]interface{})
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package netchar
+
+import (
+	"math"
+	"sort"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+)
+
+// SegAlgoBwPolicy - Segment bandwidth sharing policy used by the segment algorithm
+type SegAlgoBwPolicy interface {
+	AllocateSegmentBw(segment *SegAlgoSegment, config *SegAlgoConfig)
+}
+
+// WeightedFairShareBwPolicy - Share segment bandwidth between flows in proportion to their weight
+type WeightedFairShareBwPolicy struct{}
+
+// AllocateSegmentBw - Weighted fair share allocation of segment bandwidth
+func (policy *WeightedFairShareBwPolicy) AllocateSegmentBw(segment *SegAlgoSegment, config *SegAlgoConfig) {
+	allocateWeightedSegmentBw(segment, false)
+}
+
+// StrictPriorityBwPolicy - Serve flows in priority order; flows of the same priority share bandwidth by weight
+type StrictPriorityBwPolicy struct{}
+
+// AllocateSegmentBw - Strict priority allocation of segment bandwidth
+func (policy *StrictPriorityBwPolicy) AllocateSegmentBw(segment *SegAlgoSegment, config *SegAlgoConfig) {
+	allocateWeightedSegmentBw(segment, true)
+}
+
+const DEFAULT_TRAFFIC_WEIGHT = 1
+const DEFAULT_TRAFFIC_PRIORITY = 128 // lower than lowest configurable priority
+
+// Throughput allocated to starved flows; must remain above 0 as 0 means unlimited
+const MIN_FLOW_THROUGHPUT = 0.1
+
+// getFlowTrafficClass - Determine flow weight & priority level
+// Flow uses highest weight & priority of its end-points; PDU session configuration takes precedence
+// Priority levels are ordered from highest (1) to lowest
+func getFlowTrafficClass(src *SegAlgoNetElem, dst *SegAlgoNetElem, pdu *dataModel.PduSessionInfo) (weight float64, priority int32) {
+	var w int32
+	var p int32
+	for _, elem := range []*SegAlgoNetElem{src, dst} {
+		if elem == nil {
+			continue
+		}
+		if elem.Weight > w {
+			w = elem.Weight
+		}
+		if elem.Priority > 0 && (p == 0 || elem.Priority < p) {
+			p = elem.Priority
+		}
+	}
+	if pdu != nil {
+		if pdu.TrafficWeight > 0 {
+			w = pdu.TrafficWeight
+		}
+		if pdu.TrafficPriority > 0 {
+			p = pdu.TrafficPriority
+		}
+	}
+	if w <= 0 {
+		w = DEFAULT_TRAFFIC_WEIGHT
+	}
+	if p <= 0 {
+		p = DEFAULT_TRAFFIC_PRIORITY
+	}
+	return float64(w), p
+}
+
+// allocateWeightedSegmentBw - Share segment bandwidth between active flows in proportion to their weight
+// Guaranteed bit rates are reserved first; flows then share the remaining bandwidth for the rest of their demand
+// With strict priority, higher priority flows are served first and lower priority flows share what is left
+func allocateWeightedSegmentBw(segment *SegAlgoSegment, strictPriority bool) {
+	reserved := reserveGuaranteedBw(segment)
+	unusedBw := segment.ConfiguredNetChar.Throughput
+	for _, bw := range reserved {
+		unusedBw -= bw
+	}
+
+	// Inactive flows get a limited allocation to allow detecting new activity
+	var activeFlows []*SegAlgoFlow
+	for _, flow := range segment.Flows {
+		flow.PlannedLowerBound = 0
+		flow.PlannedUpperBound = 0
+		if isWeightedFlowActive(segment, flow) {
+			activeFlows = append(activeFlows, flow)
+		} else {
+			flow.PlannedThroughput = math.Max(math.Min(segment.MaxBwPerInactiveFlow, flow.ConfiguredNetChar.Throughput), reserved[flow])
+		}
+	}
+
+	// Group active flows per priority level; a single level is used when priority is not enforced
+	levels := map[int32][]*SegAlgoFlow{}
+	for _, flow := range activeFlows {
+		var level int32
+		if strictPriority {
+			level = flow.Priority
+		}
+		levels[level] = append(levels[level], flow)
+	}
+	var priorities []int
+	for level := range levels {
+		priorities = append(priorities, int(level))
+	}
+	sort.Ints(priorities)
+
+	for _, level := range priorities {
+		unusedBw = shareWeightedBw(segment, levels[int32(level)], unusedBw, reserved)
+	}
+
+	// Distribute residual bandwidth to active flows in proportion to their weight
+	if unusedBw >= 1 {
+		totalWeight := 0.0
+		for _, flow := range activeFlows {
+			totalWeight += flow.Weight
+		}
+		for _, flow := range activeFlows {
+			flow.PlannedThroughput = math.Min(flow.PlannedThroughput+unusedBw*flow.Weight/totalWeight, flow.ConfiguredNetChar.Throughput)
+		}
+	}
+
+	//update or not the throughput
+	for _, flow := range segment.Flows {
+		if flow.PlannedThroughput < flow.MaxPlannedThroughput {
+			flow.MaxPlannedThroughput = flow.PlannedThroughput
+			flow.MaxPlannedLowerBound = flow.PlannedLowerBound
+			flow.MaxPlannedUpperBound = flow.PlannedUpperBound
+		}
+	}
+}
+
+// reserveGuaranteedBw - Reserve segment bandwidth for flows with a guaranteed bit rate
+// Reservations are scaled down proportionally if guaranteed bit rates exceed segment capacity
+func reserveGuaranteedBw(segment *SegAlgoSegment) map[*SegAlgoFlow]float64 {
+	reserved := map[*SegAlgoFlow]float64{}
+	totalBw := 0.0
+	for _, flow := range segment.Flows {
+		if flow.GuaranteedThroughput > 0 {
+			reserved[flow] = flow.GuaranteedThroughput
+			totalBw += flow.GuaranteedThroughput
+		}
+	}
+	if totalBw > segment.ConfiguredNetChar.Throughput {
+		for flow, bw := range reserved {
+			reserved[flow] = bw * segment.ConfiguredNetChar.Throughput / totalBw
+		}
+	}
+	return reserved
+}
+
+// shareWeightedBw - Weighted max-min fair sharing of available bandwidth; returns unused bandwidth
+// Flow demand covered by reserved bandwidth is not taken from available bandwidth
+func shareWeightedBw(segment *SegAlgoSegment, flows []*SegAlgoFlow, availableBw float64, reserved map[*SegAlgoFlow]float64) float64 {
+	remaining := flows
+	for len(remaining) > 0 {
+		totalWeight := 0.0
+		for _, flow := range remaining {
+			totalWeight += flow.Weight
+		}
+
+		// Satisfy flows with a demand below their weighted share
+		var satisfied []*SegAlgoFlow
+		var unsatisfied []*SegAlgoFlow
+		for _, flow := range remaining {
+			if getWeightedFlowDemand(segment, flow)-reserved[flow] <= math.Max(availableBw, 0)*flow.Weight/totalWeight {
+				satisfied = append(satisfied, flow)
+			} else {
+				unsatisfied = append(unsatisfied, flow)
+			}
+		}
+
+		// Share remaining bandwidth between flows that cannot be satisfied
+		if len(satisfied) == 0 {
+			for _, flow := range unsatisfied {
+				flow.PlannedThroughput = math.Max(reserved[flow]+math.Max(availableBw, 0)*flow.Weight/totalWeight, MIN_FLOW_THROUGHPUT)
+			}
+			return 0
+		}
+		for _, flow := range satisfied {
+			flow.PlannedThroughput = math.Max(getWeightedFlowDemand(segment, flow), reserved[flow])
+			availableBw -= flow.PlannedThroughput - reserved[flow]
+		}
+		remaining = unsatisfied
+	}
+	return availableBw
+}
+
+// Flow is active if it uses bandwidth or if its allocation is below the activity threshold
+func isWeightedFlowActive(segment *SegAlgoSegment, flow *SegAlgoFlow) bool {
+	return flow.CurrentThroughput > segment.MinActivityThreshold || flow.AllocatedThroughput <= segment.MinActivityThreshold
+}
+
+// Flow demand is its current throughput plus an increment, up to its configured maximum
+func getWeightedFlowDemand(segment *SegAlgoSegment, flow *SegAlgoFlow) float64 {
+	return math.Min(flow.CurrentThroughput+segment.IncrementalStep, flow.ConfiguredNetChar.Throughput)
+}
@@ -39,7 +39,7 @@ const NetCharControls string = "net-char-controls"
 const NetCharControlChannel string = NetCharControls
 
 // Callback function types
-type NetCharUpdateCb func(string, string, float64, float64, float64, string, float64)
+type NetCharUpdateCb func(string, string, NetChar)
 type UpdateCompleteCb func()
 
 // NetChar Interface
@@ -57,23 +57,70 @@ type NetCharAlgo interface {
 	SetConfigAttribute(string, string)
 }
 
+// NetCharAlgoReleaser - Optional NetCharAlgo interface used to release algorithm resources when replaced
+type NetCharAlgoReleaser interface {
+	Release()
+}
+
+// Net char algorithms
+const (
+	AlgoSegment           = "SEGMENT"
+	AlgoWeightedFairShare = "WEIGHTED_FAIR_SHARE"
+	AlgoStrictPriority    = "STRICT_PRIORITY"
+)
+
+const DefaultNetCharAlgo = AlgoSegment
+
+// NetCharAlgoCreator - NetCharAlgo factory function
+type NetCharAlgoCreator func(name string, namespace string, redisAddr string) (NetCharAlgo, error)
+
+var netCharAlgoRegistry = map[string]NetCharAlgoCreator{
+	AlgoSegment:           newSegmentAlgoCreator(func() SegAlgoBwPolicy { return &MaxMinFairShareBwPolicy{} }),
+	AlgoWeightedFairShare: newSegmentAlgoCreator(func() SegAlgoBwPolicy { return &WeightedFairShareBwPolicy{} }),
+	AlgoStrictPriority:    newSegmentAlgoCreator(func() SegAlgoBwPolicy { return &StrictPriorityBwPolicy{} }),
+}
+
+// Packet loss models
+const (
+	LossModelRandom         = "Random"
+	LossModelGilbertElliott = "GilbertElliott"
+)
+
 // NetChar
 type NetChar struct {
-	Latency      float64
-	Jitter       float64
-	PacketLoss   float64
-	Throughput   float64
-	Distribution string
+	Latency            float64
+	Jitter             float64
+	PacketLoss         float64
+	Throughput         float64
+	Distribution       string
+	LatencyCorrelation float64
+	Duplication        float64
+	Corruption         float64
+	Reordering         float64
+	ReorderCorrelation float64
+	LossModel          LossModel
+}
+
+// LossModel - Packet loss model; Gilbert-Elliott parameters are percentages
+type LossModel struct {
+	Model    string
+	P        float64
+	R        float64
+	LossBad  float64
+	LossGood float64
 }
 
 // NetChar
 type ElemNetChar struct {
-	Latency      float64
-	Jitter       float64
-	Distribution string
-	PacketLoss   float64
-	ThroughputUl float64
-	ThroughputDl float64
+	Latency            float64
+	Jitter             float64
+	Distribution       string
+	PacketLoss         float64
+	ThroughputUl       float64
+	ThroughputDl       float64
+	LatencyCorrelation float64
+	ReorderCorrelation float64
+	LossModel          LossModel
 }
 
 // FlowNetChar
@@ -88,6 +135,7 @@ type NetCharConfig struct {
 	Action              string
 	RecalculationPeriod int
 	LogVerbose          bool
+	Algorithm           string
 }
 
 // NetCharManager Object
@@ -110,6 +158,12 @@ type NetCharManager struct {
 	updateCompleteCb UpdateCompleteCb
 	algo             NetCharAlgo
 	handlerId        int
+	redisAddr        string
+	algoName         string
+	scenarioAlgo     string
+	controls         map[string]string
+	profiles         map[string]*NetCharProfile
+	profileScenario  string
 }
 
 // NewNetChar - Create, Initialize and connect
@@ -129,6 +183,9 @@ func NewNetChar(name string, namespace string, redisAddr string) (*NetCharManage
 	ncm.config.RecalculationPeriod = defaultTickerPeriod
 	ncm.pduSessions = map[string]map[string]*dataModel.PduSessionInfo{}
 	ncm.d2dSessions = map[string]map[string]bool{}
+	ncm.profiles = map[string]*NetCharProfile{}
+	ncm.redisAddr = redisAddr
+	ncm.controls = map[string]string{}
 
 	// Create message queue
 	ncm.mqLocal, err = mq.NewMsgQueue(mq.GetLocalName(namespace), name, namespace, redisAddr)
@@ -139,11 +196,12 @@ func NewNetChar(name string, namespace string, redisAddr string) (*NetCharManage
 	log.Info("Message Queue created")
 
 	// Create new NetCharAlgo
-	ncm.algo, err = NewSegmentAlgorithm(ncm.name, ncm.namespace, redisAddr)
+	ncm.algo, err = NewNetCharAlgo(DefaultNetCharAlgo, ncm.name, ncm.namespace, redisAddr)
 	if err != nil {
 		log.Error("Failed to create NetCharAlgo with error: ", err)
 		return nil, err
 	}
+	ncm.algoName = DefaultNetCharAlgo
 
 	// Create new Model
 	modelCfg := mod.ModelCfg{
@@ -234,6 +292,7 @@ func (ncm *NetCharManager) Start() error {
 			for range ncm.ticker.C {
 				ncm.mutex.Lock()
 				if ncm.isStarted {
+					ncm.updateProfiles()
 					ncm.updateNetChars()
 				}
 				ncm.mutex.Unlock()
@@ -301,6 +360,17 @@ func (ncm *NetCharManager) processActiveScenarioUpdate() {
 	ncm.mutex.Lock()
 	defer ncm.mutex.Unlock()
 
+	// Load network characteristic profiles
+	ncm.loadProfiles()
+
+	// Select scenario net char algorithm
+	ncm.scenarioAlgo = ""
+	deployment := ncm.activeModel.GetDeployment(&mod.NodeFilter{ExcludeChildren: true})
+	if deployment != nil {
+		ncm.scenarioAlgo = deployment.NetCharAlgorithm
+	}
+	_ = ncm.selectAlgorithm()
+
 	if ncm.isStarted {
 		// Process updated scenario using algorithm
 		err := ncm.algo.ProcessScenario(ncm.activeModel, ncm.pduSessions, ncm.d2dSessions)
@@ -389,7 +459,7 @@ func (ncm *NetCharManager) updateNetChars() {
 	if len(updatedNetCharList) != 0 {
 		for _, flowNetChar := range updatedNetCharList {
 			if ncm.netCharUpdateCb != nil {
-				ncm.netCharUpdateCb(flowNetChar.DstElemName, flowNetChar.SrcElemName, flowNetChar.MyNetChar.Throughput, flowNetChar.MyNetChar.Latency, flowNetChar.MyNetChar.Jitter, flowNetChar.MyNetChar.Distribution /*flowNetChar.MyNetChar.Distribution,*/, flowNetChar.MyNetChar.PacketLoss)
+				ncm.netCharUpdateCb(flowNetChar.DstElemName, flowNetChar.SrcElemName, flowNetChar.MyNetChar)
 			}
 		}
 		if ncm.updateCompleteCb != nil {
@@ -417,6 +487,11 @@ func (ncm *NetCharManager) getControlsEntryHandler(key string, fields map[string
 	tickerPeriod := defaultTickerPeriod
 	logVerbose := false
 
+	// Select net char algorithm before applying algorithm configuration
+	ncm.config.Algorithm = fields["algorithm"]
+	ncm.controls = fields
+	algoUpdated := ncm.selectAlgorithm()
+
 	for fieldName, fieldValue := range fields {
 		switch fieldName {
 		case "action":
@@ -439,10 +514,84 @@ func (ncm *NetCharManager) getControlsEntryHandler(key string, fields map[string
 	ncm.config.RecalculationPeriod = tickerPeriod
 	ncm.config.LogVerbose = logVerbose
 
+	// Reprocess scenario using new algorithm
+	if algoUpdated && ncm.isStarted {
+		err = ncm.algo.ProcessScenario(ncm.activeModel, ncm.pduSessions, ncm.d2dSessions)
+		if err != nil {
+			log.Error("Failed to process active model with error: ", err)
+		} else {
+			ncm.updateNetChars()
+		}
+	}
+
 	ncm.applyAction()
 	return nil
 }
 
+// RegisterNetCharAlgo - Register a NetCharAlgo factory function under the provided algorithm name
+func RegisterNetCharAlgo(algoName string, creator NetCharAlgoCreator) {
+	netCharAlgoRegistry[algoName] = creator
+}
+
+// IsSupportedNetCharAlgo - Validate net char algorithm name
+func IsSupportedNetCharAlgo(algoName string) bool {
+	_, found := netCharAlgoRegistry[algoName]
+	return found
+}
+
+// NewNetCharAlgo - Create registered NetCharAlgo
+func NewNetCharAlgo(algoName string, name string, namespace string, redisAddr string) (NetCharAlgo, error) {
+	creator, found := netCharAlgoRegistry[algoName]
+	if !found {
+		err := errors.New("Unsupported net char algorithm: " + algoName)
+		log.Error(err.Error())
+		return nil, err
+	}
+	return creator(name, namespace, redisAddr)
+}
+
+// newSegmentAlgoCreator - Segment algorithm factory using the provided bandwidth sharing policy
+func newSegmentAlgoCreator(newBwPolicy func() SegAlgoBwPolicy) NetCharAlgoCreator {
+	return func(name string, namespace string, redisAddr string) (NetCharAlgo, error) {
+		return NewSegmentAlgorithmWithBwPolicy(name, namespace, redisAddr, newBwPolicy())
+	}
+}
+
+// selectAlgorithm - Switch to configured algorithm; returns true if a new algorithm is in use
+// Algorithm set in controls takes precedence over scenario deployment algorithm
+func (ncm *NetCharManager) selectAlgorithm() bool {
+	algoName := ncm.config.Algorithm
+	if algoName == "" {
+		algoName = ncm.scenarioAlgo
+	}
+	if algoName == "" {
+		algoName = DefaultNetCharAlgo
+	}
+	if algoName == ncm.algoName {
+		return false
+	}
+
+	algo, err := NewNetCharAlgo(algoName, ncm.name, ncm.namespace, ncm.redisAddr)
+	if err != nil {
+		log.Error("Failed to create NetCharAlgo with error: ", err)
+		return false
+	}
+
+	// Apply current algorithm configuration
+	for fieldName, fieldValue := range ncm.controls {
+		algo.SetConfigAttribute(fieldName, fieldValue)
+	}
+
+	// Release replaced algorithm
+	if releaser, ok := ncm.algo.(NetCharAlgoReleaser); ok {
+		releaser.Release()
+	}
+	ncm.algo = algo
+	ncm.algoName = algoName
+	log.Info("Using net char algorithm: ", algoName)
+	return true
+}
+
 // applyAction - Execute the action in the configuration parameters for controls on the NetChar object
 func (ncm *NetCharManager) applyAction() {
 	switch ncm.config.Action {
@@ -457,3 +606,42 @@ func (ncm *NetCharManager) applyAction() {
 	default:
 	}
 }
+
+// getLossModel - Retrieve packet loss model configuration from scenario network characteristics
+func getLossModel(nc *dataModel.NetworkCharacteristics) (lossModel LossModel) {
+	lossModel.Model = LossModelRandom
+	if nc != nil && nc.PacketLossModel == LossModelGilbertElliott && nc.GilbertElliott != nil {
+		lossModel.Model = LossModelGilbertElliott
+		lossModel.R = nc.GilbertElliott.R
+		lossModel.LossBad = nc.GilbertElliott.LossBad
+		lossModel.LossGood = nc.GilbertElliott.LossGood
+	}
+	return lossModel
+}
+
+// getFlowLossModel - Derive flow loss model from configured loss model & flow packet loss
+// Gilbert-Elliott good to bad state transition probability p is chosen so that the average
+// loss (p*(1-h) + r*(1-k)) / (p + r) matches the flow packet loss. Random loss is used when
+// the flow packet loss cannot be reached with the configured good & bad state losses.
+func getFlowLossModel(lossModel LossModel, packetLoss float64) LossModel {
+	if lossModel.Model != LossModelGilbertElliott || packetLoss == 0 || lossModel.R <= 0 ||
+		packetLoss <= lossModel.LossGood || packetLoss >= lossModel.LossBad {
+		return LossModel{Model: LossModelRandom}
+	}
+	flowLossModel := lossModel
+	flowLossModel.P = lossModel.R * (packetLoss - lossModel.LossGood) / (lossModel.LossBad - packetLoss)
+	if flowLossModel.P > 100 {
+		flowLossModel.P = 100
+	}
+	return flowLossModel
+}
+
+// combinePercentage - Combine independent per-segment event probabilities (in %) along a flow path
+func combinePercentage(current float64, added float64) float64 {
+	if current == 0 {
+		return added
+	} else if added != 0 {
+		return current + (added * ((100 - current) / 100))
+	}
+	return current
+}
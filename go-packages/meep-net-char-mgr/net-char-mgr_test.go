@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 )
 
@@ -74,3 +75,126 @@ func TestNetCharBasic(t *testing.T) {
 		t.Fatalf("NetChar should not be running")
 	}
 }
+
+func TestNetCharImpairments(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Verify impairment combination along path")
+	if combinePercentage(0, 10) != 10 {
+		t.Fatalf("Invalid combined percentage")
+	}
+	if combinePercentage(10, 0) != 10 {
+		t.Fatalf("Invalid combined percentage")
+	}
+	if combinePercentage(50, 50) != 75 {
+		t.Fatalf("Invalid combined percentage")
+	}
+	if combinePercentage(100, 20) != 100 {
+		t.Fatalf("Invalid combined percentage")
+	}
+
+	fmt.Println("Verify random loss model")
+	lossModel := getFlowLossModel(LossModel{Model: LossModelRandom}, 10)
+	if lossModel.Model != LossModelRandom || lossModel.P != 0 {
+		t.Fatalf("Invalid random loss model")
+	}
+
+	fmt.Println("Verify Gilbert-Elliott loss model")
+	geModel := LossModel{Model: LossModelGilbertElliott, R: 25, LossBad: 50, LossGood: 0}
+	lossModel = getFlowLossModel(geModel, 10)
+	if lossModel.Model != LossModelGilbertElliott || lossModel.R != 25 || lossModel.LossBad != 50 || lossModel.LossGood != 0 {
+		t.Fatalf("Invalid Gilbert-Elliott loss model")
+	}
+	// Average loss: p*(1-h) / (p+r) = 6.25*50 / 31.25 = 10%
+	if lossModel.P != 6.25 {
+		t.Fatalf("Invalid Gilbert-Elliott p: %f", lossModel.P)
+	}
+	lossModel = getFlowLossModel(geModel, 0)
+	if lossModel.Model != LossModelRandom {
+		t.Fatalf("Gilbert-Elliott should not apply without packet loss")
+	}
+	lossModel = getFlowLossModel(geModel, 60)
+	if lossModel.Model != LossModelRandom {
+		t.Fatalf("Gilbert-Elliott should not apply when loss exceeds bad state loss")
+	}
+	lossModel = getFlowLossModel(LossModel{Model: LossModelGilbertElliott, R: 25, LossBad: 50, LossGood: 20}, 10)
+	if lossModel.Model != LossModelRandom {
+		t.Fatalf("Gilbert-Elliott should not apply when loss is below good state loss")
+	}
+	lossModel = getFlowLossModel(LossModel{Model: LossModelGilbertElliott, R: 25, LossBad: 50, LossGood: 10}, 10)
+	if lossModel.Model != LossModelRandom {
+		t.Fatalf("Gilbert-Elliott should not apply when loss equals good state loss")
+	}
+	lossModel = getFlowLossModel(LossModel{Model: LossModelGilbertElliott, R: 90, LossBad: 50, LossGood: 0}, 40)
+	if lossModel.P != 100 {
+		t.Fatalf("Gilbert-Elliott p should be capped")
+	}
+}
+
+func TestNetCharProfile(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	startTime := time.Now()
+	config := dataModel.NetCharProfile{
+		Interval: 1000,
+		Trace:    "latency,latencyVariation,throughputDl,throughputUl,packetLoss\n10,1,100,100,0\n20,2,50,50,1\n30,3,10,10,5\n",
+	}
+
+	fmt.Println("Create net char profile")
+	profile, err := NewNetCharProfile("zone1-poa1", &config, startTime)
+	if err != nil || profile == nil {
+		t.Fatalf("Failed to create net char profile")
+	}
+	if len(profile.Samples) != 3 || profile.Samples[1].Latency != 20 || profile.Samples[2].PacketLoss != 5 {
+		t.Fatalf("Invalid net char profile samples")
+	}
+
+	fmt.Println("Verify sample playback")
+	if profile.GetSampleIndex(startTime) != 0 {
+		t.Fatalf("Invalid sample index")
+	}
+	if profile.GetSampleIndex(startTime.Add(1500*time.Millisecond)) != 1 {
+		t.Fatalf("Invalid sample index")
+	}
+	if profile.GetSampleIndex(startTime.Add(10*time.Second)) != 2 {
+		t.Fatalf("Last sample should be kept")
+	}
+
+	fmt.Println("Verify looped sample playback")
+	profile.Config.Loop = true
+	if profile.GetSampleIndex(startTime.Add(3500*time.Millisecond)) != 0 {
+		t.Fatalf("Invalid looped sample index")
+	}
+	if profile.GetSampleIndex(startTime.Add(10*time.Second)) != 1 {
+		t.Fatalf("Invalid looped sample index")
+	}
+
+	fmt.Println("Verify sample throughput of 0 uses configured throughput")
+	profile, _ = NewNetCharProfile("zone1-poa1", &dataModel.NetCharProfile{
+		Interval: 1000,
+		Trace:    "latency,latencyVariation,throughputDl,throughputUl,packetLoss\n10,1,100,50,0\n20,2,0,0,1\n",
+	}, startTime)
+	profile.ThroughputDl = 1000
+	profile.ThroughputUl = 500
+	nc := dataModel.NetworkCharacteristics{ThroughputDl: 1000, ThroughputUl: 500}
+	profile.ApplySample(&nc, 0)
+	if nc.Latency != 10 || nc.ThroughputDl != 100 || nc.ThroughputUl != 50 || profile.Index != 0 {
+		t.Fatalf("Invalid applied sample")
+	}
+	profile.ApplySample(&nc, 1)
+	if nc.Latency != 20 || nc.ThroughputDl != 1000 || nc.ThroughputUl != 500 || profile.Index != 1 {
+		t.Fatalf("Configured throughput should be used")
+	}
+
+	fmt.Println("Verify invalid profiles")
+	profile, err = NewNetCharProfile("zone1-poa1", &dataModel.NetCharProfile{Interval: 1000}, startTime)
+	if err != nil || profile != nil {
+		t.Fatalf("Empty profile should be ignored")
+	}
+	profile, err = NewNetCharProfile("zone1-poa1", &dataModel.NetCharProfile{Interval: 1000, Trace: "1,2,3"}, startTime)
+	if err == nil || profile != nil {
+		t.Fatalf("Invalid profile trace should fail")
+	}
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package netchar
+
+import (
+	"reflect"
+	"time"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+)
+
+// NetCharProfile - Network characteristics profile playback state for a scenario element
+type NetCharProfile struct {
+	ElemName  string
+	Config    dataModel.NetCharProfile
+	Samples   []dataModel.NetCharProfileSample
+	Interval  time.Duration
+	StartTime time.Time
+	Index     int
+	// Configured element throughput, used when a sample throughput is 0
+	ThroughputDl int32
+	ThroughputUl int32
+}
+
+// NewNetCharProfile - Create profile playback state from scenario element profile configuration
+func NewNetCharProfile(elemName string, profile *dataModel.NetCharProfile, startTime time.Time) (*NetCharProfile, error) {
+	samples, err := mod.GetNetCharProfileSamples(profile)
+	if err != nil {
+		return nil, err
+	}
+	if len(samples) == 0 || profile.Interval <= 0 {
+		return nil, nil
+	}
+	p := new(NetCharProfile)
+	p.ElemName = elemName
+	p.Config = *profile
+	p.Samples = samples
+	p.Interval = time.Duration(profile.Interval) * time.Millisecond
+	p.StartTime = startTime
+	p.Index = -1
+	return p, nil
+}
+
+// GetSampleIndex - Get index of profile sample to apply at the provided time
+func (p *NetCharProfile) GetSampleIndex(now time.Time) int {
+	index := 0
+	if now.After(p.StartTime) {
+		index = int(now.Sub(p.StartTime) / p.Interval)
+	}
+	if index >= len(p.Samples) {
+		if p.Config.Loop {
+			index %= len(p.Samples)
+		} else {
+			index = len(p.Samples) - 1
+		}
+	}
+	return index
+}
+
+// ApplySample - Apply profile sample with provided index to element network characteristics
+func (p *NetCharProfile) ApplySample(nc *dataModel.NetworkCharacteristics, index int) {
+	sample := p.Samples[index]
+	nc.Latency = sample.Latency
+	nc.LatencyVariation = sample.LatencyVariation
+	nc.ThroughputDl = p.ThroughputDl
+	if sample.ThroughputDl != 0 {
+		nc.ThroughputDl = sample.ThroughputDl
+	}
+	nc.ThroughputUl = p.ThroughputUl
+	if sample.ThroughputUl != 0 {
+		nc.ThroughputUl = sample.ThroughputUl
+	}
+	nc.PacketLoss = sample.PacketLoss
+	p.Index = index
+}
+
+// loadProfiles - Load network characteristic profiles from active scenario & apply current samples
+// Playback of unchanged profiles continues across scenario updates
+func (ncm *NetCharManager) loadProfiles() {
+	now := time.Now()
+	scenarioName := ncm.activeModel.GetScenarioName()
+
+	profiles := make(map[string]*NetCharProfile)
+	for _, name := range ncm.activeModel.GetNodeNames("ANY") {
+		nc := getNodeNetChar(ncm.activeModel.GetNode(name))
+		if nc == nil || nc.Profile == nil {
+			continue
+		}
+		profile, err := NewNetCharProfile(name, nc.Profile, now)
+		if err != nil {
+			log.Error("Failed to load net char profile for ", name, " with error: ", err.Error())
+			continue
+		}
+		if profile == nil {
+			continue
+		}
+		profile.ThroughputDl = nc.ThroughputDl
+		profile.ThroughputUl = nc.ThroughputUl
+		if prevProfile, found := ncm.profiles[name]; found && scenarioName == ncm.profileScenario &&
+			reflect.DeepEqual(prevProfile.Config, profile.Config) {
+			profile.StartTime = prevProfile.StartTime
+		}
+		profiles[name] = profile
+	}
+	ncm.profiles = profiles
+	ncm.profileScenario = scenarioName
+
+	_ = ncm.applyProfiles(now)
+}
+
+// applyProfiles - Apply current profile samples to active scenario elements
+// Returns true if element network characteristics were updated
+func (ncm *NetCharManager) applyProfiles(now time.Time) bool {
+	updated := false
+	for _, profile := range ncm.profiles {
+		index := profile.GetSampleIndex(now)
+		if index == profile.Index {
+			continue
+		}
+		nc := getNodeNetChar(ncm.activeModel.GetNode(profile.ElemName))
+		if nc == nil {
+			log.Error("Failed to find net char profile element: ", profile.ElemName)
+			continue
+		}
+
+		// Apply sample to a copy & update the model element through the model API
+		netChar := *nc
+		profile.ApplySample(&netChar, index)
+		ncUpdate := &dataModel.EventNetworkCharacteristicsUpdate{
+			ElementName: profile.ElemName,
+			ElementType: ncm.activeModel.GetNodeType(profile.ElemName),
+			NetChar:     &netChar,
+		}
+		err := ncm.activeModel.UpdateNetChar(ncUpdate, nil)
+		if err != nil {
+			log.Error("Failed to apply net char profile for ", profile.ElemName, " with error: ", err.Error())
+			delete(ncm.profiles, profile.ElemName)
+			continue
+		}
+		updated = true
+	}
+	return updated
+}
+
+// updateProfiles - Reprocess scenario if profile samples changed element network characteristics
+func (ncm *NetCharManager) updateProfiles() {
+	if ncm.applyProfiles(time.Now()) {
+		err := ncm.algo.ProcessScenario(ncm.activeModel, ncm.pduSessions, ncm.d2dSessions)
+		if err != nil {
+			log.Error("Failed to process active model with error: ", err)
+		}
+	}
+}
+
+// getNodeNetChar - Retrieve network characteristics of a scenario element node
+func getNodeNetChar(node interface{}) *dataModel.NetworkCharacteristics {
+	switch n := node.(type) {
+	case *dataModel.Deployment:
+		return n.NetChar
+	case *dataModel.Domain:
+		return n.NetChar
+	case *dataModel.Zone:
+		return n.NetChar
+	case *dataModel.NetworkLocation:
+		return n.NetChar
+	case *dataModel.PhysicalLocation:
+		return n.NetChar
+	case *dataModel.Process:
+		return n.NetChar
+	}
+	return nil
+}
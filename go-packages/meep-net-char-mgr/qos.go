@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package netchar
+
+import (
+	"math"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	pss "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-pdu-session-store"
+)
+
+// QosCharacteristics - Standardized 5QI QoS characteristics
+type QosCharacteristics struct {
+	ResourceType      string
+	PriorityLevel     int32
+	PacketDelayBudget int32 // ms
+}
+
+// Standardized 5QI to QoS characteristics mapping (3GPP TS 23.501 Table 5.7.4-1)
+var standardQosCharacteristics = map[int32]QosCharacteristics{
+	1:  {pss.QosResourceTypeGbr, 20, 100},
+	2:  {pss.QosResourceTypeGbr, 40, 150},
+	3:  {pss.QosResourceTypeGbr, 30, 50},
+	4:  {pss.QosResourceTypeGbr, 50, 300},
+	65: {pss.QosResourceTypeGbr, 7, 75},
+	66: {pss.QosResourceTypeGbr, 20, 100},
+	67: {pss.QosResourceTypeGbr, 15, 100},
+	71: {pss.QosResourceTypeGbr, 56, 150},
+	72: {pss.QosResourceTypeGbr, 56, 300},
+	73: {pss.QosResourceTypeGbr, 56, 300},
+	74: {pss.QosResourceTypeGbr, 56, 500},
+	76: {pss.QosResourceTypeGbr, 56, 500},
+	5:  {pss.QosResourceTypeNonGbr, 10, 100},
+	6:  {pss.QosResourceTypeNonGbr, 60, 300},
+	7:  {pss.QosResourceTypeNonGbr, 70, 100},
+	8:  {pss.QosResourceTypeNonGbr, 80, 300},
+	9:  {pss.QosResourceTypeNonGbr, 90, 300},
+	69: {pss.QosResourceTypeNonGbr, 5, 60},
+	70: {pss.QosResourceTypeNonGbr, 55, 200},
+	79: {pss.QosResourceTypeNonGbr, 65, 50},
+	80: {pss.QosResourceTypeNonGbr, 68, 10},
+	82: {pss.QosResourceTypeDelayCriticalGbr, 19, 10},
+	83: {pss.QosResourceTypeDelayCriticalGbr, 22, 10},
+	84: {pss.QosResourceTypeDelayCriticalGbr, 24, 30},
+	85: {pss.QosResourceTypeDelayCriticalGbr, 21, 5},
+	86: {pss.QosResourceTypeDelayCriticalGbr, 18, 5},
+	87: {pss.QosResourceTypeDelayCriticalGbr, 25, 5},
+	88: {pss.QosResourceTypeDelayCriticalGbr, 25, 10},
+	89: {pss.QosResourceTypeDelayCriticalGbr, 25, 15},
+	90: {pss.QosResourceTypeDelayCriticalGbr, 25, 20},
+}
+
+// FlowQos - QoS applied to a flow using a PDU session
+type FlowQos struct {
+	ResourceType      string
+	PriorityLevel     int32
+	GuaranteedBitrate float64 // Mbps
+	MaxBitrate        float64 // Mbps
+	PacketDelayBudget float64 // ms
+}
+
+// getFlowQos - Resolve PDU session QoS profile for the flow direction; returns nil if no QoS profile
+// Profile values override the standardized 5QI characteristics
+func getFlowQos(pdu *dataModel.PduSessionInfo, uplink bool) *FlowQos {
+	if pdu == nil || pdu.Qos == nil {
+		return nil
+	}
+	qos := pdu.Qos
+	flowQos := new(FlowQos)
+	flowQos.ResourceType = pss.QosResourceTypeNonGbr
+	if std, found := standardQosCharacteristics[qos.FiveQi]; found {
+		flowQos.ResourceType = std.ResourceType
+		flowQos.PriorityLevel = std.PriorityLevel
+		flowQos.PacketDelayBudget = float64(std.PacketDelayBudget)
+	}
+	if qos.ResourceType != "" {
+		flowQos.ResourceType = qos.ResourceType
+	}
+	if qos.PriorityLevel > 0 {
+		flowQos.PriorityLevel = qos.PriorityLevel
+	}
+	if qos.PacketDelayBudget > 0 {
+		flowQos.PacketDelayBudget = float64(qos.PacketDelayBudget)
+	}
+	if uplink {
+		flowQos.GuaranteedBitrate = qos.GbrUl
+		flowQos.MaxBitrate = qos.MbrUl
+	} else {
+		flowQos.GuaranteedBitrate = qos.GbrDl
+		flowQos.MaxBitrate = qos.MbrDl
+	}
+
+	// Guaranteed bit rate only applies to GBR resource types
+	if flowQos.ResourceType == pss.QosResourceTypeNonGbr {
+		flowQos.GuaranteedBitrate = 0
+	}
+	return flowQos
+}
+
+// applyFlowQos - Apply PDU session QoS profile to flow configuration
+func applyFlowQos(flow *SegAlgoFlow, qos *FlowQos, pdu *dataModel.PduSessionInfo) {
+	flow.GuaranteedThroughput = 0
+	flow.PacketDelayBudget = 0
+	if qos == nil {
+		return
+	}
+
+	// Maximum bit rate limits flow throughput
+	if qos.MaxBitrate > 0 && qos.MaxBitrate < flow.ConfiguredNetChar.Throughput {
+		flow.ConfiguredNetChar.Throughput = qos.MaxBitrate
+	}
+	flow.GuaranteedThroughput = math.Min(qos.GuaranteedBitrate, flow.ConfiguredNetChar.Throughput)
+	flow.PacketDelayBudget = qos.PacketDelayBudget
+
+	// QoS priority level applies when PDU session traffic priority is not set
+	if pdu.TrafficPriority == 0 && qos.PriorityLevel > 0 {
+		flow.Priority = qos.PriorityLevel
+	}
+}
+
+// checkPacketDelayBudget - Check flow latency & jitter against the QoS packet delay budget
+// The packet delay budget is a QoS target; emulated latency is not modified & violations are logged
+func checkPacketDelayBudget(flow *SegAlgoFlow) bool {
+	exceeded := flow.PacketDelayBudget > 0 && flow.ComputedLatency+flow.ComputedJitter > flow.PacketDelayBudget
+	if exceeded != flow.DelayBudgetExceeded {
+		if exceeded {
+			log.Warn("Flow ", flow.Name, " exceeds packet delay budget: latency ", flow.ComputedLatency,
+				" ms, jitter ", flow.ComputedJitter, " ms, budget ", flow.PacketDelayBudget, " ms")
+		} else {
+			log.Info("Flow ", flow.Name, " within packet delay budget")
+		}
+		flow.DelayBudgetExceeded = exceeded
+	}
+	return exceeded
+}
+
+// hasGuaranteedFlows - Check if segment carries flows with a guaranteed bit rate
+func hasGuaranteedFlows(segment *SegAlgoSegment) bool {
+	for _, flow := range segment.Flows {
+		if flow.GuaranteedThroughput > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package netchar
+
+import (
+	"math"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+)
+
+// SegAlgoSlice - Network slice
+type SegAlgoSlice struct {
+	Name          string
+	Snssai        string
+	CapacityShare float64
+}
+
+// loadNetworkSlices - Load scenario network slices & slices served by each POA
+// Network slices only apply to PDU sessions in PDU connectivity mode
+func (algo *SegmentAlgorithm) loadNetworkSlices(model *mod.Model, deployment *dataModel.Deployment) {
+	algo.NetworkSlices = map[string]*SegAlgoSlice{}
+	algo.PoaSlices = map[string][]string{}
+	if deployment == nil || len(deployment.NetworkSlices) == 0 || algo.ConnectivityModel != mod.ConnectivityModelPdu {
+		return
+	}
+	for _, slice := range deployment.NetworkSlices {
+		algo.NetworkSlices[slice.Name] = &SegAlgoSlice{
+			Name:          slice.Name,
+			Snssai:        mod.GetSnssaiKey(slice.Snssai),
+			CapacityShare: slice.CapacityShare,
+		}
+	}
+	netLocs := model.GetNetworkLocations(&mod.NodeFilter{ExcludeChildren: true, Minimize: true})
+	for _, nl := range netLocs.NetworkLocations {
+		algo.PoaSlices[nl.Name] = nl.NetworkSlices
+	}
+}
+
+// getPduSessionSlice - Get network slice used by PDU session between POA & data network
+// Returns false if the PDU session network slice is not available
+func (algo *SegmentAlgorithm) getPduSessionSlice(pdu *dataModel.PduSessionInfo, poaName string, dn *dataModel.DnConfig) (string, bool) {
+	// PDU sessions without S-NSSAI can only reach data networks that are not restricted to network slices
+	if pdu.Snssai == nil {
+		return "", dn == nil || len(dn.NetworkSlices) == 0
+	}
+
+	// Find network slice matching PDU session S-NSSAI
+	snssai := mod.GetSnssaiKey(pdu.Snssai)
+	var slice *SegAlgoSlice
+	for _, s := range algo.NetworkSlices {
+		if s.Snssai == snssai {
+			slice = s
+			break
+		}
+	}
+	if slice == nil {
+		return "", false
+	}
+
+	// Network slice must be served by POA & data network
+	if !isSliceServed(algo.PoaSlices[poaName], slice.Name) {
+		return "", false
+	}
+	if dn != nil && !isSliceServed(dn.NetworkSlices, slice.Name) {
+		return "", false
+	}
+	return slice.Name, true
+}
+
+// getPoaSliceShares - Get capacity shares of network slices served by POA; nil if not a POA
+func (algo *SegmentAlgorithm) getPoaSliceShares(poaName string) map[string]float64 {
+	servedSlices, isPoa := algo.PoaSlices[poaName]
+	if !isPoa {
+		return nil
+	}
+	var shares map[string]float64
+	for name, slice := range algo.NetworkSlices {
+		if slice.CapacityShare > 0 && isSliceServed(servedSlices, name) {
+			if shares == nil {
+				shares = map[string]float64{}
+			}
+			shares[name] = slice.CapacityShare
+		}
+	}
+	return shares
+}
+
+// allocateSlicedSegmentBw - Allocate bandwidth to flows within their network slice capacity share
+// Flows outside a network slice with a capacity share on this segment share the remaining capacity
+func (algo *SegmentAlgorithm) allocateSlicedSegmentBw(segment *SegAlgoSegment) {
+	// Group flows by network slice
+	sliceFlows := map[string][]*SegAlgoFlow{}
+	for _, flow := range segment.Flows {
+		sliceName := flow.Slice
+		if _, found := segment.SliceShares[sliceName]; !found {
+			sliceName = ""
+		}
+		sliceFlows[sliceName] = append(sliceFlows[sliceName], flow)
+	}
+
+	unslicedShare := 100.0
+	for _, share := range segment.SliceShares {
+		unslicedShare -= share
+	}
+
+	// Allocate slice capacity using a segment restricted to slice flows
+	for sliceName, flows := range sliceFlows {
+		share := unslicedShare
+		if sliceName != "" {
+			share = segment.SliceShares[sliceName]
+		}
+		sliceSegment := *segment
+		sliceSegment.Name = segment.Name + ":" + sliceName
+		sliceSegment.ConfiguredNetChar.Throughput = math.Max(segment.ConfiguredNetChar.Throughput*share/100, MIN_FLOW_THROUGHPUT)
+		sliceSegment.Flows = flows
+		sliceSegment.SliceShares = nil
+		algo.BwPolicy.AllocateSegmentBw(&sliceSegment, &algo.Config)
+	}
+}
+
+// Network slice is served if it is in the list of served slices or if no list is provided
+func isSliceServed(servedSlices []string, sliceName string) bool {
+	if len(servedSlices) == 0 {
+		return true
+	}
+	for _, name := range servedSlices {
+		if name == sliceName {
+			return true
+		}
+	}
+	return false
+}
@@ -17,7 +17,9 @@
 package pdusessionstore
 
 import (
+	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
 
 	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
@@ -32,10 +34,29 @@ const redisTable = 0
 const pduSessionsRootKey = "pdu-sessions:"
 
 // Key format: /pdu-sessions/[ue.name]/[pdu-session-uid]
-// Value(s): dnn=[dnn], (others TBD)
+// Value(s): dnn=[dnn], trafficWeight=[weight], trafficPriority=[priority], qos=[qos profile json], snssai=[s-nssai json]
 
 // DB Fields
 const fieldDnn = "dnn"
+const fieldTrafficWeight = "trafficWeight"
+const fieldTrafficPriority = "trafficPriority"
+const fieldQos = "qos"
+const fieldSnssai = "snssai"
+
+// Traffic weight & priority limits
+const maxTrafficWeight = 1000
+const maxTrafficPriority = 127
+
+// QoS profile limits
+const maxFiveQi = 255
+const maxQosPriorityLevel = 127
+
+// QoS resource types
+const (
+	QosResourceTypeGbr              = "GBR"
+	QosResourceTypeNonGbr           = "NON_GBR"
+	QosResourceTypeDelayCriticalGbr = "DELAY_CRITICAL_GBR"
+)
 
 type PduSessionStore struct {
 	rc      *redis.Connector
@@ -82,6 +103,24 @@ func (pss *PduSessionStore) CreatePduSession(ueName string, pduId string, info *
 	if info.Dnn == "" {
 		return errors.New("Invalid DNN")
 	}
+	if info.TrafficWeight < 0 || info.TrafficWeight > maxTrafficWeight {
+		return errors.New("Invalid traffic weight")
+	}
+	if info.TrafficPriority < 0 || info.TrafficPriority > maxTrafficPriority {
+		return errors.New("Invalid traffic priority")
+	}
+	if info.Qos != nil {
+		err := validateQosProfile(info.Qos)
+		if err != nil {
+			return err
+		}
+	}
+	if info.Snssai != nil {
+		err := dataModel.ValidateSnssai(info.Snssai)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Prepare key
 	key := pss.keyRoot + ueName + ":" + pduId
@@ -96,6 +135,24 @@ func (pss *PduSessionStore) CreatePduSession(ueName string, pduId string, info *
 	// Prepare data
 	fields := make(map[string]interface{})
 	fields[fieldDnn] = info.Dnn
+	fields[fieldTrafficWeight] = info.TrafficWeight
+	fields[fieldTrafficPriority] = info.TrafficPriority
+	if info.Qos != nil {
+		qosJson, err := json.Marshal(info.Qos)
+		if err != nil {
+			log.Error(err.Error())
+			return err
+		}
+		fields[fieldQos] = string(qosJson)
+	}
+	if info.Snssai != nil {
+		snssaiJson, err := json.Marshal(info.Snssai)
+		if err != nil {
+			log.Error(err.Error())
+			return err
+		}
+		fields[fieldSnssai] = string(snssaiJson)
+	}
 
 	// Update entry in DB
 	err := pss.rc.SetEntry(key, fields)
@@ -200,8 +257,7 @@ func (pss *PduSessionStore) GetPduSession(ueName string, pduId string) (*dataMod
 	}
 
 	// Prepare PDU Session
-	pdu := new(dataModel.PduSessionInfo)
-	pdu.Dnn = fields[fieldDnn]
+	pdu := parsePduSessionInfo(fields)
 	return pdu, nil
 }
 
@@ -238,8 +294,7 @@ func getAllPduSessions(key string, fields map[string]string, userData interface{
 	allPduMap := *(userData.(*map[string]map[string]*dataModel.PduSessionInfo))
 
 	// Prepare PDU Session
-	pdu := new(dataModel.PduSessionInfo)
-	pdu.Dnn = fields[fieldDnn]
+	pdu := parsePduSessionInfo(fields)
 
 	// Extract PDU id & UE name
 	kk := strings.Split(key, ":")
@@ -262,8 +317,7 @@ func getPduSessions(key string, fields map[string]string, userData interface{})
 	pduMap := *(userData.(*map[string]*dataModel.PduSessionInfo))
 
 	// Prepare PDU Session
-	pdu := new(dataModel.PduSessionInfo)
-	pdu.Dnn = fields[fieldDnn]
+	pdu := parsePduSessionInfo(fields)
 
 	// Extract PDU id
 	kk := strings.Split(key, ":")
@@ -273,3 +327,56 @@ func getPduSessions(key string, fields map[string]string, userData interface{})
 	pduMap[pduId] = pdu
 	return nil
 }
+
+// parsePduSessionInfo - Create PDU session info from DB fields
+func parsePduSessionInfo(fields map[string]string) *dataModel.PduSessionInfo {
+	pdu := new(dataModel.PduSessionInfo)
+	pdu.Dnn = fields[fieldDnn]
+	if weight, err := strconv.ParseInt(fields[fieldTrafficWeight], 10, 32); err == nil {
+		pdu.TrafficWeight = int32(weight)
+	}
+	if priority, err := strconv.ParseInt(fields[fieldTrafficPriority], 10, 32); err == nil {
+		pdu.TrafficPriority = int32(priority)
+	}
+	if qosJson, found := fields[fieldQos]; found && qosJson != "" {
+		var qos dataModel.QosProfile
+		if err := json.Unmarshal([]byte(qosJson), &qos); err == nil {
+			pdu.Qos = &qos
+		}
+	}
+	if snssaiJson, found := fields[fieldSnssai]; found && snssaiJson != "" {
+		var snssai dataModel.Snssai
+		if err := json.Unmarshal([]byte(snssaiJson), &snssai); err == nil {
+			pdu.Snssai = &snssai
+		}
+	}
+	return pdu
+}
+
+// validateQosProfile - Validate PDU session QoS profile
+func validateQosProfile(qos *dataModel.QosProfile) error {
+	if qos.FiveQi < 0 || qos.FiveQi > maxFiveQi {
+		return errors.New("Invalid 5QI")
+	}
+	switch qos.ResourceType {
+	case "", QosResourceTypeGbr, QosResourceTypeNonGbr, QosResourceTypeDelayCriticalGbr:
+	default:
+		return errors.New("Invalid QoS resource type")
+	}
+	if qos.PriorityLevel < 0 || qos.PriorityLevel > maxQosPriorityLevel {
+		return errors.New("Invalid QoS priority level")
+	}
+	if qos.GbrDl < 0 || qos.GbrUl < 0 || qos.MbrDl < 0 || qos.MbrUl < 0 {
+		return errors.New("Invalid QoS bit rate")
+	}
+	if (qos.MbrDl != 0 && qos.GbrDl > qos.MbrDl) || (qos.MbrUl != 0 && qos.GbrUl > qos.MbrUl) {
+		return errors.New("Guaranteed bit rate exceeds maximum bit rate")
+	}
+	if qos.ResourceType == QosResourceTypeNonGbr && (qos.GbrDl != 0 || qos.GbrUl != 0) {
+		return errors.New("Guaranteed bit rate not supported for non-GBR resource type")
+	}
+	if qos.PacketDelayBudget < 0 {
+		return errors.New("Invalid packet delay budget")
+	}
+	return nil
+}
@@ -433,3 +433,36 @@ func prepareData() {
 		fmt.Println("Error creating sbox2-ue2-pdu3 PDU Session")
 	}
 }
+
+func TestValidateQosProfile(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Validate QoS profiles")
+	validProfiles := []*dataModel.QosProfile{
+		{},
+		{FiveQi: 9},
+		{FiveQi: 1, GbrDl: 0.064, GbrUl: 0.064, MbrDl: 0.128, MbrUl: 0.128},
+		{FiveQi: 200, ResourceType: QosResourceTypeDelayCriticalGbr, PriorityLevel: 10, PacketDelayBudget: 5},
+	}
+	for _, qos := range validProfiles {
+		if err := validateQosProfile(qos); err != nil {
+			t.Fatalf("Valid QoS profile failed validation: %s", err.Error())
+		}
+	}
+
+	invalidProfiles := []*dataModel.QosProfile{
+		{FiveQi: 256},
+		{ResourceType: "INVALID"},
+		{PriorityLevel: 128},
+		{GbrDl: -1},
+		{GbrUl: 10, MbrUl: 5},
+		{ResourceType: QosResourceTypeNonGbr, GbrDl: 1},
+		{PacketDelayBudget: -1},
+	}
+	for _, qos := range invalidProfiles {
+		if err := validateQosProfile(qos); err == nil {
+			t.Fatalf("Invalid QoS profile passed validation")
+		}
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Platform Controller REST API
+ *
+ * This API is the main Platform Controller API for scenario configuration & sandbox management <p>**Micro-service**<br>[meep-pfm-ctrl](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-platform-ctrl) <p>**Type & Usage**<br>Platform main interface used by controller software to configure scenarios and manage sandboxes in the AdvantEDGE platform <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package client
+
+// Network characteristics profile. Samples are played back while the scenario is running, each sample replacing the element latency, latency variation, throughput & packet loss for the profile interval.
+type NetCharProfile struct {
+	// Time between profile samples in ms
+	Interval int32 `json:"interval,omitempty"`
+	// Restart profile playback from the first sample after the last sample; last sample values are kept otherwise
+	Loop bool `json:"loop,omitempty"`
+	// Profile samples played back in order
+	Samples []NetCharProfileSample `json:"samples,omitempty"`
+	// CSV trace used when no samples are provided; one sample per line with columns latency,latencyVariation,throughputDl,throughputUl,packetLoss. Header & comment (#) lines are ignored.
+	Trace string `json:"trace,omitempty"`
+}
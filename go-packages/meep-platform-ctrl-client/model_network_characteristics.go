@@ -40,4 +40,18 @@ type NetworkCharacteristics struct {
 	ThroughputUl int32 `json:"throughputUl,omitempty"`
 	// Packet loss percentage
 	PacketLoss float64 `json:"packetLoss,omitempty"`
+	// Latency correlation percentage. Can only be set in the Scenario Deployment network characteristics, ignored otherwise. Default value is 50%.
+	LatencyCorrelation float64 `json:"latencyCorrelation,omitempty"`
+	// Packet duplication percentage
+	PacketDuplication float64 `json:"packetDuplication,omitempty"`
+	// Packet corruption percentage
+	PacketCorruption float64 `json:"packetCorruption,omitempty"`
+	// Packet reordering percentage. Reordered packets are sent immediately while other packets are delayed; only applied to flows with latency.
+	PacketReordering float64 `json:"packetReordering,omitempty"`
+	// Packet reordering correlation percentage. Can only be set in the Scenario Deployment network characteristics, ignored otherwise.
+	PacketReorderingCorrelation float64 `json:"packetReorderingCorrelation,omitempty"`
+	// Packet loss model. Can only be set in the Scenario Deployment network characteristics, ignored otherwise. Default value is 'Random' loss; 'GilbertElliott' applies correlated burst loss using the gilbertElliott parameters.
+	PacketLossModel string              `json:"packetLossModel,omitempty"`
+	GilbertElliott  *GilbertElliottLoss `json:"gilbertElliott,omitempty"`
+	Profile         *NetCharProfile     `json:"profile,omitempty"`
 }
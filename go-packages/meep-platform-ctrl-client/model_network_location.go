@@ -51,4 +51,6 @@ type NetworkLocation struct {
 	PoaWifiConfig     *PoaWifiConfig     `json:"poaWifiConfig,omitempty"`
 	GeoData           *GeoData           `json:"geoData,omitempty"`
 	PhysicalLocations []PhysicalLocation `json:"physicalLocations,omitempty"`
+	// Names of the network slices served by the POA; POA serves all network slices if not set
+	NetworkSlices []string `json:"networkSlices,omitempty"`
 }
@@ -58,5 +58,6 @@ type PhysicalLocation struct {
 	// **DEPRECATED** As of release 1.5.0, replaced by netChar packetLoss
 	LinkPacketLoss float64 `json:"linkPacketLoss,omitempty"`
 	// Physical location MAC Address
-	MacId string `json:"macId,omitempty"`
+	MacId          string          `json:"macId,omitempty"`
+	HandoverConfig *HandoverConfig `json:"handoverConfig,omitempty"`
 }
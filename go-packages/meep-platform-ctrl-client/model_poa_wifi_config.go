@@ -27,5 +27,6 @@ package client
 // WIFI POA configuration information
 type PoaWifiConfig struct {
 	// WIFI POA MAC Address
-	MacId string `json:"macId,omitempty"`
+	MacId       string             `json:"macId,omitempty"`
+	Propagation *PropagationConfig `json:"propagation,omitempty"`
 }
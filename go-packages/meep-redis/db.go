@@ -86,6 +86,12 @@ func (rc *Connector) connectDB(addr string, table int) error {
 	return nil
 }
 
+// Close - Close DB connection
+func (rc *Connector) Close() error {
+	rc.connected = false
+	return rc.client.Close()
+}
+
 // DBFlush - Empty DB
 func (rc *Connector) DBFlush(module string) error {
 	var cursor uint64
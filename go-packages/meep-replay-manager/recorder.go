@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+const maxRecordedEvents = 100000
+
+// ErrNotRecording - Requested replay file is not being recorded
+var ErrNotRecording = errors.New("Replay file not being recorded")
+
+// Recorder - Records events into a replay file as they are processed
+type Recorder struct {
+	fileName    string
+	description string
+	isStarted   bool
+	timeStarted time.Time
+	events      []dataModel.ReplayEvent
+	mutex       sync.Mutex
+}
+
+// NewRecorder - Create a new replay recorder
+func NewRecorder() *Recorder {
+	return new(Recorder)
+}
+
+// Start - Start recording events into the provided replay file
+func (r *Recorder) Start(fileName string, description string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if fileName == "" {
+		return errors.New("Missing replay file name")
+	}
+	if r.isStarted {
+		return errors.New("Replay file already being recorded: " + r.fileName)
+	}
+
+	r.fileName = fileName
+	r.description = description
+	r.timeStarted = time.Now()
+	r.isStarted = true
+
+	// Start marker; INIT event keeps the delay until the first recorded event
+	r.events = []dataModel.ReplayEvent{{
+		Index: 0,
+		Time:  0,
+		Event: &dataModel.Event{Name: "Init", Type_: "OTHER"},
+	}}
+	log.Info("Replay recording started: ", fileName)
+	return nil
+}
+
+// Record - Add event to the replay file being recorded, if any
+func (r *Recorder) Record(event *dataModel.Event) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.isStarted || event == nil {
+		return
+	}
+	if len(r.events) > maxRecordedEvents {
+		log.Warn("Maximum number of recorded events reached; dropping event")
+		return
+	}
+
+	// Store a copy as the event may be modified once processed
+	j, err := json.Marshal(event)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	e := new(dataModel.Event)
+	err = json.Unmarshal(j, e)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	var replayEvent dataModel.ReplayEvent
+	replayEvent.Index = int32(len(r.events))
+	replayEvent.Time = int32(time.Since(r.timeStarted) / time.Millisecond)
+	replayEvent.Event = e
+	r.events = append(r.events, replayEvent)
+}
+
+// Stop - Stop recording and return the recorded replay file
+func (r *Recorder) Stop(fileName string) (dataModel.Replay, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.isStarted || r.fileName != fileName {
+		return dataModel.Replay{}, ErrNotRecording
+	}
+	return r.stop(), nil
+}
+
+// ForceStop - Stop recording regardless of the replay file name
+func (r *Recorder) ForceStop() (string, dataModel.Replay, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.isStarted {
+		return "", dataModel.Replay{}, false
+	}
+	fileName := r.fileName
+	return fileName, r.stop(), true
+}
+
+func (r *Recorder) stop() dataModel.Replay {
+	var replay dataModel.Replay
+	replay.Description = r.description
+	replay.Events = r.events
+	log.Info("Replay recording stopped: ", r.fileName, " (", len(r.events)-1, " events)")
+
+	r.fileName = ""
+	r.description = ""
+	r.events = nil
+	r.isStarted = false
+	return replay
+}
+
+// IsStarted - Indicates if a replay file is being recorded
+func (r *Recorder) IsStarted() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.isStarted
+}
+
+// GetStatus - Get status of the replay file being recorded
+func (r *Recorder) GetStatus() (dataModel.RecordingStatus, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var status dataModel.RecordingStatus
+	if !r.isStarted {
+		return status, ErrNotRecording
+	}
+	status.ReplayFileName = r.fileName
+	status.EventCount = int32(len(r.events) - 1)
+	status.Duration = int32(time.Since(r.timeStarted) / time.Millisecond)
+	return status, nil
+}
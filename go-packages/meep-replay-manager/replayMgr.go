@@ -20,31 +20,51 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
 	"time"
 
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	gc "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-gis-cache"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	sandbox "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
 )
 
-const defaultLoopInterval = 5000 //in ms
+const defaultLoopInterval = 5000  //in ms
+const conditionPollInterval = 500 //in ms
 const basepath = "http://meep-sandbox-ctrl/sandbox-ctrl/v1"
 
+// Playback speed factor limits
+const (
+	MinSpeed = 0.1
+	MaxSpeed = 10.0
+)
+
+// ErrNotRunning - Requested replay file is not running
+var ErrNotRunning = errors.New("Replay file not running")
+
 type ReplayMgr struct {
-	name             string
-	currentFileName  string
-	isStarted        bool
-	ticker           *time.Ticker
-	nextEventIndex   int
-	eventIndexMax    int
-	replayEventsList dataModel.Replay
-	loop             bool
-	client           *sandbox.APIClient
-	timeToNextEvent  int
-	timeRemaining    int
-	timeStarted      time.Time
-	loopStarted      time.Time
-	ignoreInitEvent  bool
+	name            string
+	currentFileName string
+	isStarted       bool
+	timer           *time.Timer
+	nextEventIndex  int
+	eventIndexMax   int
+	steps           []*replayStep
+	loop            bool
+	client          *sandbox.APIClient
+	gisCache        *gc.GisCache
+	checkCondition  func(condition *dataModel.ReplayCondition) (bool, error)
+	rand            *rand.Rand
+	speed           float64
+	paused          bool
+	replayTime      int       // replay time (ms) at refTime
+	refTime         time.Time // wall time of last replay time update
+	nextTime        int       // replay time (ms) at which next step is played
+	waitStarted     time.Time
+	mutex           sync.Mutex
 }
 
 func createClient(path string) (*sandbox.APIClient, error) {
@@ -60,11 +80,13 @@ func createClient(path string) (*sandbox.APIClient, error) {
 }
 
 func (r *ReplayMgr) IsStarted() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 	return r.isStarted
 }
 
 // NewReplayMgr - Create, Initialize and connect the replay manager
-func NewReplayMgr(name string) (r *ReplayMgr, err error) {
+func NewReplayMgr(name string, sandboxName string, redisAddr string) (r *ReplayMgr, err error) {
 	if name == "" {
 		err = errors.New("Missing replay manager name")
 		log.Error(err)
@@ -74,6 +96,8 @@ func NewReplayMgr(name string) (r *ReplayMgr, err error) {
 	r = new(ReplayMgr)
 	r.name = name
 	r.isStarted = false
+	r.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	r.checkCondition = r.checkGisCondition
 
 	client, err := createClient(basepath)
 	if err != nil {
@@ -82,97 +106,157 @@ func NewReplayMgr(name string) (r *ReplayMgr, err error) {
 	}
 	r.client = client
 
+	// Connect to GIS cache, used to evaluate replay conditions
+	r.gisCache, err = gc.NewGisCache(sandboxName, redisAddr)
+	if err != nil {
+		log.Error("Failed to connect to GIS Cache: ", err.Error())
+		return nil, err
+	}
+
 	log.Debug("ReplayMgr created ", r.name)
 	return r, nil
 }
 
-func (r *ReplayMgr) playEventByIndex() error {
+// startTimer - Play next step once provided duration has expired
+func (r *ReplayMgr) startTimer(duration time.Duration) {
+	var timer *time.Timer
+	timer = time.AfterFunc(duration, func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
 
-	// Retrieve & Marshal next event
-	index := r.nextEventIndex
-	replayEvent := r.replayEventsList.Events[index]
-	j, err := json.Marshal(&replayEvent.Event)
-	if err != nil {
-		log.Error(err)
-		return err
+		// Ignore expiry if replay was stopped or restarted in the meantime
+		if !r.isStarted || r.timer != timer {
+			return
+		}
+		_ = r.playStep()
+	})
+	r.timer = timer
+}
+
+// stopTimer - Cancel pending step, if any
+func (r *ReplayMgr) stopTimer() {
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
 	}
+}
 
-	// If first event, take timestamp for reference
-	if index == 0 {
-		r.loopStarted = time.Now()
+// currentTime - Returns current replay time (ms), taking speed factor & pause into account
+func (r *ReplayMgr) currentTime() int {
+	if r.paused {
+		return r.replayTime
 	}
+	elapsed := float64(time.Since(r.refTime)) / float64(time.Millisecond)
+	return r.replayTime + int(elapsed*r.speed)
+}
 
-	// Process INIT event
-	isInitEvent := false
-	if replayEvent.Event.Type_ == "OTHER" && replayEvent.Event.Name == "Init" {
+// setTime - Set current replay time (ms)
+func (r *ReplayMgr) setTime(t int) {
+	r.replayTime = t
+	r.refTime = time.Now()
+}
 
-		// Skip to next event if INIT event should be ignored
-		if r.ignoreInitEvent {
-			index = index + 1
-			replayEvent = r.replayEventsList.Events[index]
+// toWallTime - Convert replay duration (ms) to wall time using the speed factor
+func (r *ReplayMgr) toWallTime(duration int) time.Duration {
+	if duration <= 0 {
+		return 0
+	}
+	return time.Duration(float64(duration) * float64(time.Millisecond) / r.speed)
+}
 
-			j, err = json.Marshal(&replayEvent.Event)
-			if err != nil {
-				log.Error(err)
+// schedule - Start timer to play next step, unless replay is paused
+func (r *ReplayMgr) schedule() {
+	if !r.isStarted || r.paused {
+		return
+	}
+	r.startTimer(r.toWallTime(r.nextTime - r.currentTime()))
+}
+
+// playStep - Play next replay step & schedule the following one
+// NOTE: Must be called with mutex locked
+func (r *ReplayMgr) playStep() error {
+	index := r.nextEventIndex
+	step := r.steps[index]
+
+	// Wait until condition is met
+	if step.waitUntil != nil {
+		met, err := r.checkCondition(step.waitUntil)
+		if err != nil {
+			log.Error(err.Error())
+		}
+		if !met {
+			if r.waitStarted.IsZero() {
+				r.waitStarted = time.Now()
+			}
+			timeout := time.Duration(step.waitUntil.Timeout) * time.Millisecond
+			if timeout > 0 && time.Since(r.waitStarted) >= timeout {
+				err = errors.New("Replay aborted: wait-until condition timeout on step " + step.name)
+				log.Error(err.Error())
+				r.Completed()
 				return err
 			}
-		} else {
-			isInitEvent = true
+			if !r.paused {
+				r.startTimer(conditionPollInterval * time.Millisecond)
+			}
+			return errors.New("Waiting for condition on replay step " + step.name)
 		}
+		r.waitStarted = time.Time{}
 	}
 
-	// Send event (except INIT event)
-	if !isInitEvent {
-		vars := make(map[string]string)
-		vars["type"] = replayEvent.Event.Type_
-		var validEvent sandbox.Event
+	// Synchronize replay time with played step
+	r.setTime(step.time)
 
-		err = json.Unmarshal(j, &validEvent)
+	// Skip step (and section steps) if condition is not met
+	nextIndex := index + 1
+	play := true
+	if step.condition != nil {
+		met, err := r.checkCondition(step.condition)
 		if err != nil {
-			log.Error(err)
-			return err
+			log.Error(err.Error())
+		}
+		if !met {
+			log.Debug("Replay step ", step.name, " condition not met; skipping ", step.skip+1, " step(s)")
+			nextIndex += step.skip
+			play = false
 		}
+	}
 
-		_, err = r.client.EventsApi.SendEvent(context.TODO(), replayEvent.Event.Type_, validEvent)
+	// Send event
+	if play && step.event != nil {
+		err := r.sendEvent(step.event)
 		if err != nil {
 			log.Error(err)
 		}
 	}
 
-	// Retrieve index of next event
-	nextIndex := 0
-	if index != r.eventIndexMax {
-		nextIndex = index + 1
-	} else if r.loop {
-		nextIndex = 0
-	} else {
-		nextIndex = -1
+	// Retrieve index of next step
+	if nextIndex > r.eventIndexMax {
+		if r.loop {
+			nextIndex = 0
+		} else {
+			nextIndex = -1
+		}
 	}
 
-	// If necessary, create timer to wait before sending next event
+	// If necessary, create timer to wait before playing next step
 	if nextIndex != -1 {
-		nextReplayEvent := r.replayEventsList.Events[nextIndex]
-
-		// Calculate time until next event
-		var diff int32
+		// Calculate time until next step
+		var diff int
 		if nextIndex == 0 {
 			diff = defaultLoopInterval
 		} else {
-			diff = nextReplayEvent.Time - replayEvent.Time
+			nextStep := r.steps[nextIndex]
+			diff = nextStep.delay
+			if nextStep.jitter > 0 {
+				diff += r.rand.Intn(nextStep.jitter + 1)
+			}
 		}
-		tickerExpiry := time.Duration(diff) * time.Millisecond
-		log.Debug("next replay event (index ", nextReplayEvent.Index, ") in ", tickerExpiry)
-		r.ticker = time.NewTicker(tickerExpiry)
-		r.timeToNextEvent, r.timeRemaining = r.getTimesRemaining()
+		log.Debug("next replay step (index ", nextIndex, ") in ", diff, "ms")
 		r.nextEventIndex = nextIndex
+		r.nextTime = step.time + diff
 
 		// Start timer
-		go func() {
-			for range r.ticker.C {
-				r.ticker.Stop()
-				_ = r.playEventByIndex()
-			}
-		}()
+		r.schedule()
 	} else {
 		r.Completed()
 	}
@@ -180,35 +264,90 @@ func (r *ReplayMgr) playEventByIndex() error {
 	return nil
 }
 
+func (r *ReplayMgr) sendEvent(event *dataModel.Event) error {
+	j, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	var validEvent sandbox.Event
+	err = json.Unmarshal(j, &validEvent)
+	if err != nil {
+		return err
+	}
+	_, err = r.client.EventsApi.SendEvent(context.TODO(), event.Type_, validEvent)
+	return err
+}
+
+// checkGisCondition - Evaluate replay condition using the GIS cache
+func (r *ReplayMgr) checkGisCondition(condition *dataModel.ReplayCondition) (bool, error) {
+	measurementMap, err := r.gisCache.GetAllPoaMeasurements()
+	if err != nil {
+		return false, err
+	}
+	ueMeas, found := measurementMap[condition.Ue]
+	if !found {
+		return false, nil
+	}
+	meas, found := ueMeas.Measurements[condition.Poa]
+	if !found {
+		return false, nil
+	}
+
+	switch condition.Type_ {
+	case ConditionUeAttached:
+		return meas.Connected, nil
+	case ConditionUeInRange:
+		return meas.InRange, nil
+	default:
+		return false, errors.New("Unsupported replay condition type: " + condition.Type_)
+	}
+}
+
 // Start - starts replay execution
 func (r *ReplayMgr) Start(fileName string, replay dataModel.Replay, loop bool, ignoreInitEvent bool) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
 	// Verify replay file can be started
 	if r.isStarted {
 		return errors.New("Replay already running, filename: " + r.currentFileName)
-	} else if len(replay.Events) <= 1 {
-		return errors.New("Replay has no events, filename: " + r.currentFileName)
+	}
+	steps, err := buildReplaySteps(replay, ignoreInitEvent)
+	if err != nil {
+		return errors.New("Invalid replay file: " + fileName + ", error: " + err.Error())
+	} else if len(steps) == 0 {
+		return errors.New("Replay has no events, filename: " + fileName)
 	}
 
 	// Initialize replay execution
-	r.timeStarted = time.Now()
+	r.speed = 1
+	r.paused = false
+	r.setTime(0)
+	r.waitStarted = time.Time{}
 	r.isStarted = true
 	r.nextEventIndex = 0
-	r.replayEventsList = replay
-	r.eventIndexMax = len(replay.Events) - 1
+	r.steps = steps
+	r.eventIndexMax = len(steps) - 1
 	r.loop = loop
 	r.currentFileName = fileName
-	r.ignoreInitEvent = ignoreInitEvent
+	log.Debug("Starting replay ", fileName, " with ", len(steps), " steps")
 
-	// Start playing events
-	_ = r.playEventByIndex()
+	// Schedule first step using its delay & jitter
+	r.nextTime = steps[0].time
+	if steps[0].jitter > 0 {
+		r.nextTime += r.rand.Intn(steps[0].jitter + 1)
+	}
+	r.schedule()
 
 	return nil
 }
 
 // ForceStop - forced stop on the current replay file
 func (r *ReplayMgr) ForceStop() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 	if r.isStarted {
-		r.ticker.Stop()
+		r.stopTimer()
 		r.Completed()
 		return true
 	}
@@ -217,15 +356,128 @@ func (r *ReplayMgr) ForceStop() bool {
 
 // Stop - stops replay file
 func (r *ReplayMgr) Stop(replayFileName string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 	if r.isStarted && r.currentFileName == replayFileName {
-		r.ticker.Stop()
+		r.stopTimer()
 		r.Completed()
 		return true
 	}
 	return false
 }
 
+// Pause - pauses replay file execution
+func (r *ReplayMgr) Pause(replayFileName string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.isStarted || r.currentFileName != replayFileName {
+		return ErrNotRunning
+	}
+	r.pause()
+	return nil
+}
+
+func (r *ReplayMgr) pause() {
+	if !r.paused {
+		r.stopTimer()
+		r.setTime(r.currentTime())
+		r.paused = true
+		log.Debug("replay paused at ", r.replayTime, "ms")
+	}
+}
+
+// Resume - resumes paused replay file execution
+func (r *ReplayMgr) Resume(replayFileName string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.isStarted || r.currentFileName != replayFileName {
+		return ErrNotRunning
+	}
+	if r.paused {
+		r.paused = false
+		r.setTime(r.replayTime)
+		r.schedule()
+		log.Debug("replay resumed at ", r.replayTime, "ms")
+	}
+	return nil
+}
+
+// Step - pauses replay file execution & plays the next step only
+func (r *ReplayMgr) Step(replayFileName string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.isStarted || r.currentFileName != replayFileName {
+		return ErrNotRunning
+	}
+	r.pause()
+	return r.playStep()
+}
+
+// SetSpeed - sets replay playback speed factor
+func (r *ReplayMgr) SetSpeed(replayFileName string, speed float64) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.isStarted || r.currentFileName != replayFileName {
+		return ErrNotRunning
+	}
+	if speed < MinSpeed || speed > MaxSpeed {
+		return fmt.Errorf("Invalid replay speed: %v (supported range: %v-%v)", speed, MinSpeed, MaxSpeed)
+	}
+
+	// Reschedule next step using new speed factor
+	r.setTime(r.currentTime())
+	r.speed = speed
+	if !r.paused {
+		r.stopTimer()
+		r.schedule()
+	}
+	log.Debug("replay speed set to ", speed)
+	return nil
+}
+
+// SeekIndex - moves replay execution to the step with provided index
+func (r *ReplayMgr) SeekIndex(replayFileName string, index int) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.isStarted || r.currentFileName != replayFileName {
+		return ErrNotRunning
+	}
+	if index < 0 || index > r.eventIndexMax {
+		return fmt.Errorf("Invalid replay index: %d (max index: %d)", index, r.eventIndexMax)
+	}
+	r.seek(index, r.steps[index].time)
+	return nil
+}
+
+// SeekTime - moves replay execution to the provided replay time (ms)
+func (r *ReplayMgr) SeekTime(replayFileName string, t int) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.isStarted || r.currentFileName != replayFileName {
+		return ErrNotRunning
+	}
+	if t < 0 || t > r.steps[r.eventIndexMax].time {
+		return fmt.Errorf("Invalid replay time: %dms (max time: %dms)", t, r.steps[r.eventIndexMax].time)
+	}
+
+	// Next step is the first one scheduled at or after requested time
+	index := sort.Search(len(r.steps), func(i int) bool { return r.steps[i].time >= t })
+	r.seek(index, t)
+	return nil
+}
+
+func (r *ReplayMgr) seek(index int, t int) {
+	r.stopTimer()
+	r.nextEventIndex = index
+	r.nextTime = r.steps[index].time
+	r.waitStarted = time.Time{}
+	r.setTime(t)
+	r.schedule()
+	log.Debug("replay moved to index ", index, " at ", t, "ms")
+}
+
 // Completed - successfully terminates replay file
+// NOTE: Must be called with mutex locked
 func (r *ReplayMgr) Completed() {
 	r.isStarted = false
 	log.Debug("replay completed execution")
@@ -233,7 +485,9 @@ func (r *ReplayMgr) Completed() {
 
 // GetStatus - Returns the Replay Execution status
 func (r *ReplayMgr) GetStatus() (status dataModel.ReplayStatus, err error) {
-	if !r.IsStarted() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.isStarted {
 		err = errors.New("No replay file running")
 		return
 	}
@@ -263,34 +517,12 @@ func (r *ReplayMgr) GetStatus() (status dataModel.ReplayStatus, err error) {
 	status.MaxIndex = int32(maxIndex)
 
 	status.LoopMode = r.loop
-	timeToNextEvent, timeRemaining := r.getTimesRemaining()
-	status.TimeToNextEvent = int32(timeToNextEvent)
-	status.TimeRemaining = int32(timeRemaining)
+	status.Paused = r.paused
+	status.Speed = float32(r.speed)
+	currentTime := r.currentTime()
+	status.Time = int32(currentTime)
+	status.TimeToNextEvent = int32(r.toWallTime(r.nextTime-currentTime) / time.Millisecond)
+	status.TimeRemaining = int32(r.toWallTime(r.steps[maxIndex].time-currentTime) / time.Millisecond)
 
 	return status, nil
 }
-
-// getTimesRemaining - returns time left to execute next event and the rest of the replay file
-func (r *ReplayMgr) getTimesRemaining() (int, int) {
-	var elapsedDuration time.Duration
-	if r.loop {
-		elapsedDuration = time.Since(r.loopStarted)
-	} else {
-		elapsedDuration = time.Since(r.timeStarted)
-	}
-	elapsed := int(elapsedDuration / time.Millisecond)
-
-	if r.ignoreInitEvent {
-		elapsed += int(r.replayEventsList.Events[1].Time)
-	}
-
-	nextEventTimeRemaining := int(r.replayEventsList.Events[r.nextEventIndex].Time) - elapsed
-	totalTimeRemaining := int(r.replayEventsList.Events[r.eventIndexMax].Time) - elapsed
-	if nextEventTimeRemaining < 0 {
-		nextEventTimeRemaining = 0
-	}
-	if totalTimeRemaining < 0 {
-		totalTimeRemaining = 0
-	}
-	return nextEventTimeRemaining, totalTimeRemaining
-}
@@ -0,0 +1,433 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func mobilityEvent(ue string, poa string) *dataModel.Event {
+	return &dataModel.Event{
+		Name:  "mobility",
+		Type_: "MOBILITY",
+		EventMobility: &dataModel.EventMobility{
+			ElementName: ue,
+			Dest:        poa,
+		},
+	}
+}
+
+func TestReplayEventSteps(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	replay := dataModel.Replay{
+		Events: []dataModel.ReplayEvent{
+			{Index: 0, Time: 0, Event: &dataModel.Event{Name: "Init", Type_: "OTHER"}},
+			{Index: 1, Time: 1000, Event: mobilityEvent("ue1", "poa1")},
+			{Index: 2, Time: 2500, Event: mobilityEvent("ue1", "poa2")},
+		},
+	}
+
+	fmt.Println("Build steps with INIT event")
+	steps, err := buildReplaySteps(replay, false)
+	if err != nil {
+		t.Fatalf("Failed to build steps: " + err.Error())
+	}
+	if len(steps) != 3 {
+		t.Fatalf("Invalid step count")
+	}
+	if steps[0].event != nil || steps[1].delay != 1000 || steps[2].delay != 1500 || steps[2].time != 2500 {
+		t.Fatalf("Invalid steps")
+	}
+
+	fmt.Println("Build steps ignoring INIT event")
+	steps, err = buildReplaySteps(replay, true)
+	if err != nil {
+		t.Fatalf("Failed to build steps: " + err.Error())
+	}
+	if len(steps) != 2 {
+		t.Fatalf("Invalid step count")
+	}
+	if steps[0].delay != 0 || steps[0].time != 0 || steps[1].delay != 1500 || steps[1].time != 1500 {
+		t.Fatalf("Invalid steps")
+	}
+	if steps[1].event.EventMobility.Dest != "poa2" {
+		t.Fatalf("Invalid step event")
+	}
+}
+
+func TestReplayScriptSteps(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	replay := dataModel.Replay{
+		Variables: map[string]string{"ue": "ue1", "src": "poa1"},
+		Sections: []dataModel.ReplaySection{
+			{
+				Name: "handover",
+				Steps: []dataModel.ReplayStep{
+					{Name: "attach-src", Delay: 100, Event: mobilityEvent("${ue}", "${src}")},
+					{
+						Name:      "attach-dst",
+						Delay:     200,
+						Jitter:    50,
+						Event:     mobilityEvent("${ue}", "${dst}"),
+						WaitUntil: &dataModel.ReplayCondition{Type_: ConditionUeInRange, Ue: "${ue}", Poa: "${dst}", Timeout: 1000},
+					},
+				},
+			},
+		},
+		Steps: []dataModel.ReplayStep{
+			{
+				Name:      "handovers",
+				Section:   "handover",
+				Repeat:    3,
+				Variables: map[string]string{"dst": "poa-${iteration}"},
+				Condition: &dataModel.ReplayCondition{Type_: ConditionUeAttached, Ue: "${ue}", Poa: "${src}"},
+			},
+			{Name: "done", Delay: 1000, Event: mobilityEvent("${ue}", "DISCONNECTED")},
+		},
+	}
+
+	fmt.Println("Build steps")
+	steps, err := buildReplaySteps(replay, true)
+	if err != nil {
+		t.Fatalf("Failed to build steps: " + err.Error())
+	}
+	if len(steps) != 10 {
+		t.Fatalf("Invalid step count: %d", len(steps))
+	}
+	for i := 0; i < 3; i++ {
+		gate := steps[i*3]
+		if gate.event != nil || gate.skip != 2 || gate.condition == nil || gate.condition.Poa != "poa1" {
+			t.Fatalf("Invalid section step")
+		}
+		dst := fmt.Sprintf("poa-%d", i+1)
+		if steps[i*3+1].event.EventMobility.ElementName != "ue1" || steps[i*3+1].event.EventMobility.Dest != "poa1" {
+			t.Fatalf("Invalid variable substitution")
+		}
+		if steps[i*3+2].event.EventMobility.Dest != dst || steps[i*3+2].waitUntil.Poa != dst || steps[i*3+2].jitter != 50 {
+			t.Fatalf("Invalid variable substitution")
+		}
+	}
+	if steps[9].time != 3*300+1000 {
+		t.Fatalf("Invalid step time: %d", steps[9].time)
+	}
+	if replay.Sections[0].Steps[1].Event.EventMobility.Dest != "${dst}" {
+		t.Fatalf("Replay file should not be modified")
+	}
+
+	fmt.Println("Build steps with step variable referencing parent variable")
+	replay.Steps[0].Variables = map[string]string{"dst": "poa-${iteration}", "src": "${src}-${ue}"}
+	steps, err = buildReplaySteps(replay, true)
+	if err != nil {
+		t.Fatalf("Failed to build steps: " + err.Error())
+	}
+	if steps[1].event.EventMobility.Dest != "poa1-ue1" || steps[2].event.EventMobility.Dest != "poa-1" {
+		t.Fatalf("Invalid variable substitution")
+	}
+
+	fmt.Println("Build steps with step variable referencing same step variable")
+	replay.Steps[0].Variables = map[string]string{"dst": "poa-${iteration}", "src": "${dst}"}
+	_, err = buildReplaySteps(replay, true)
+	if err == nil {
+		t.Fatalf("Step variable referencing same step variable should fail")
+	}
+
+	fmt.Println("Build steps with unknown variable")
+	replay.Steps[0].Variables = nil
+	_, err = buildReplaySteps(replay, true)
+	if err == nil {
+		t.Fatalf("Unknown variable should fail")
+	}
+
+	fmt.Println("Build steps with unknown section")
+	replay.Steps[0].Section = "unknown"
+	_, err = buildReplaySteps(replay, true)
+	if err == nil {
+		t.Fatalf("Unknown section should fail")
+	}
+
+	fmt.Println("Build steps with recursive section")
+	replay.Sections[0].Steps = []dataModel.ReplayStep{{Section: "handover"}}
+	replay.Steps = []dataModel.ReplayStep{{Section: "handover"}}
+	_, err = buildReplaySteps(replay, true)
+	if err == nil {
+		t.Fatalf("Recursive section should fail")
+	}
+}
+
+func TestReplayConditions(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	attached := map[string]bool{}
+	r := new(ReplayMgr)
+	r.checkCondition = func(condition *dataModel.ReplayCondition) (bool, error) {
+		return attached[condition.Ue+":"+condition.Poa], nil
+	}
+
+	replay := dataModel.Replay{
+		Sections: []dataModel.ReplaySection{
+			{Name: "section", Steps: []dataModel.ReplayStep{{Delay: 10000}, {Delay: 10000}}},
+		},
+		Steps: []dataModel.ReplayStep{
+			{Name: "skipped", Section: "section", Condition: &dataModel.ReplayCondition{Type_: ConditionUeAttached, Ue: "ue1", Poa: "poa1"}},
+			{Name: "wait", Delay: 10000, WaitUntil: &dataModel.ReplayCondition{Type_: ConditionUeInRange, Ue: "ue1", Poa: "poa2"}},
+			{Name: "last", Delay: 10000},
+		},
+	}
+	steps, err := buildReplaySteps(replay, true)
+	if err != nil {
+		t.Fatalf("Failed to build steps: " + err.Error())
+	}
+	r.steps = steps
+	r.eventIndexMax = len(steps) - 1
+	r.speed = 1
+	r.isStarted = true
+
+	fmt.Println("Skip section when condition is not met")
+	r.mutex.Lock()
+	_ = r.playStep()
+	if r.nextEventIndex != 3 {
+		t.Fatalf("Section should be skipped")
+	}
+
+	fmt.Println("Wait until condition is met")
+	_ = r.playStep()
+	if r.nextEventIndex != 3 || r.waitStarted.IsZero() {
+		t.Fatalf("Step should wait for condition")
+	}
+	attached["ue1:poa2"] = true
+	_ = r.playStep()
+	if r.nextEventIndex != 4 || !r.waitStarted.IsZero() {
+		t.Fatalf("Step should be played once condition is met")
+	}
+	r.stopTimer()
+	r.isStarted = false
+	r.mutex.Unlock()
+}
+
+func TestReplayControl(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	r := new(ReplayMgr)
+	r.rand = rand.New(rand.NewSource(0))
+
+	replay := dataModel.Replay{
+		Steps: []dataModel.ReplayStep{
+			{Name: "step0"},
+			{Name: "step1", Delay: 10000},
+			{Name: "step2", Delay: 10000},
+			{Name: "step3", Delay: 10000},
+		},
+	}
+
+	fmt.Println("Control replay that is not running")
+	if r.Pause("replay") != ErrNotRunning || r.Resume("replay") != ErrNotRunning || r.Step("replay") != ErrNotRunning {
+		t.Fatalf("Should report replay not running")
+	}
+
+	fmt.Println("Start replay")
+	err := r.Start("replay", replay, false, true)
+	if err != nil {
+		t.Fatalf("Failed to start replay: " + err.Error())
+	}
+	if r.Pause("other") != ErrNotRunning {
+		t.Fatalf("Should report replay not running")
+	}
+
+	fmt.Println("Pause replay")
+	err = r.Pause("replay")
+	if err != nil {
+		t.Fatalf("Failed to pause replay: " + err.Error())
+	}
+	status, _ := r.GetStatus()
+	if !status.Paused || status.Speed != 1 || status.Index != 0 {
+		t.Fatalf("Invalid status")
+	}
+
+	fmt.Println("Set replay speed")
+	if r.SetSpeed("replay", 20) == nil || r.SetSpeed("replay", 0.01) == nil {
+		t.Fatalf("Invalid speed should fail")
+	}
+	err = r.SetSpeed("replay", 2)
+	if err != nil {
+		t.Fatalf("Failed to set replay speed: " + err.Error())
+	}
+	status, _ = r.GetStatus()
+	if status.Speed != 2 || status.TimeToNextEvent > 5000 {
+		t.Fatalf("Invalid status")
+	}
+
+	fmt.Println("Seek replay")
+	if r.SeekIndex("replay", 4) == nil || r.SeekTime("replay", 40000) == nil {
+		t.Fatalf("Invalid seek should fail")
+	}
+	err = r.SeekIndex("replay", 2)
+	if err != nil {
+		t.Fatalf("Failed to seek replay: " + err.Error())
+	}
+	status, _ = r.GetStatus()
+	if status.Index != 1 || status.Time != 20000 {
+		t.Fatalf("Invalid status")
+	}
+	err = r.SeekTime("replay", 15000)
+	if err != nil {
+		t.Fatalf("Failed to seek replay: " + err.Error())
+	}
+	status, _ = r.GetStatus()
+	if status.Index != 1 || status.Time != 15000 || status.TimeToNextEvent != 2500 {
+		t.Fatalf("Invalid status")
+	}
+
+	fmt.Println("Step replay")
+	err = r.Step("replay")
+	if err != nil {
+		t.Fatalf("Failed to step replay: " + err.Error())
+	}
+	status, _ = r.GetStatus()
+	if !status.Paused || status.Index != 2 || status.Time != 20000 {
+		t.Fatalf("Invalid status")
+	}
+
+	fmt.Println("Resume replay")
+	err = r.Resume("replay")
+	if err != nil {
+		t.Fatalf("Failed to resume replay: " + err.Error())
+	}
+	status, _ = r.GetStatus()
+	if status.Paused || r.timer == nil {
+		t.Fatalf("Invalid status")
+	}
+
+	fmt.Println("Stop replay")
+	if !r.ForceStop() || r.IsStarted() {
+		t.Fatalf("Failed to stop replay")
+	}
+}
+
+func TestReplayStartDelay(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	r := new(ReplayMgr)
+	r.rand = rand.New(rand.NewSource(0))
+
+	replay := dataModel.Replay{
+		Steps: []dataModel.ReplayStep{
+			{Name: "step0", Delay: 10000, Jitter: 1000},
+			{Name: "step1", Delay: 10000},
+		},
+	}
+
+	fmt.Println("Start replay with first step delay")
+	err := r.Start("replay", replay, false, true)
+	if err != nil {
+		t.Fatalf("Failed to start replay: " + err.Error())
+	}
+	status, _ := r.GetStatus()
+	if r.nextEventIndex != 0 || r.timer == nil {
+		t.Fatalf("First step should be scheduled")
+	}
+	if r.nextTime < 10000 || r.nextTime > 11000 || status.TimeToNextEvent < 9000 || status.TimeToNextEvent > 11000 {
+		t.Fatalf("First step should wait for its delay & jitter: %d", status.TimeToNextEvent)
+	}
+
+	fmt.Println("Step replay")
+	err = r.Step("replay")
+	if err != nil {
+		t.Fatalf("Failed to step replay: " + err.Error())
+	}
+	status, _ = r.GetStatus()
+	if status.Index != 0 || r.nextEventIndex != 1 || status.Time != 10000 {
+		t.Fatalf("Invalid status")
+	}
+
+	fmt.Println("Stop replay")
+	if !r.ForceStop() || r.IsStarted() {
+		t.Fatalf("Failed to stop replay")
+	}
+}
+
+func TestReplayRecorder(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	rec := NewRecorder()
+
+	fmt.Println("Record without recording started")
+	rec.Record(mobilityEvent("ue1", "poa1"))
+	if _, err := rec.GetStatus(); err != ErrNotRecording {
+		t.Fatalf("Should report not recording")
+	}
+	if _, err := rec.Stop("replay"); err != ErrNotRecording {
+		t.Fatalf("Should report not recording")
+	}
+
+	fmt.Println("Start recording")
+	err := rec.Start("replay", "description")
+	if err != nil {
+		t.Fatalf("Failed to start recording: " + err.Error())
+	}
+	if rec.Start("other", "") == nil {
+		t.Fatalf("Recording should already be started")
+	}
+
+	fmt.Println("Record events")
+	event := mobilityEvent("ue1", "poa1")
+	rec.Record(event)
+	event.EventMobility.Dest = "poa2"
+	rec.Record(event)
+	status, err := rec.GetStatus()
+	if err != nil || status.ReplayFileName != "replay" || status.EventCount != 2 {
+		t.Fatalf("Invalid status")
+	}
+
+	fmt.Println("Stop recording")
+	if _, err = rec.Stop("other"); err != ErrNotRecording {
+		t.Fatalf("Should report not recording")
+	}
+	replay, err := rec.Stop("replay")
+	if err != nil {
+		t.Fatalf("Failed to stop recording: " + err.Error())
+	}
+	if rec.IsStarted() || replay.Description != "description" || len(replay.Events) != 3 {
+		t.Fatalf("Invalid recorded replay")
+	}
+	if replay.Events[1].Index != 1 || replay.Events[1].Event.EventMobility.Dest != "poa1" || replay.Events[2].Event.EventMobility.Dest != "poa2" {
+		t.Fatalf("Invalid recorded events")
+	}
+	steps, err := buildReplaySteps(replay, false)
+	if err != nil || len(steps) != 3 || steps[0].event != nil {
+		t.Fatalf("Recorded replay should be playable")
+	}
+
+	fmt.Println("Force stop recording")
+	_ = rec.Start("replay2", "")
+	name, _, stopped := rec.ForceStop()
+	if !stopped || name != "replay2" || rec.IsStarted() {
+		t.Fatalf("Failed to force stop recording")
+	}
+}
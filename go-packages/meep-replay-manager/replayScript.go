@@ -0,0 +1,296 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+)
+
+// Replay condition types
+const (
+	ConditionUeAttached = "UE_ATTACHED"
+	ConditionUeInRange  = "UE_IN_RANGE"
+)
+
+const iterationVariable = "iteration"
+const maxSectionDepth = 16
+const maxReplaySteps = 100000
+
+var variableRegex = regexp.MustCompile(`\$\{([A-Za-z0-9_.\-]+)\}`)
+
+// replayStep - Replay step ready for playback
+type replayStep struct {
+	name      string
+	time      int // scheduled time (ms) since start of replay, excluding jitter & wait-until conditions
+	delay     int
+	jitter    int
+	event     *dataModel.Event
+	condition *dataModel.ReplayCondition
+	waitUntil *dataModel.ReplayCondition
+	skip      int // number of following steps to skip when condition is not met
+}
+
+type stepBuilder struct {
+	sections map[string]*dataModel.ReplaySection
+	steps    []*replayStep
+	time     int
+}
+
+// buildReplaySteps - Flatten replay file into the list of steps to play
+func buildReplaySteps(replay dataModel.Replay, ignoreInitEvent bool) ([]*replayStep, error) {
+	// Legacy replay files only provide a list of events with absolute times
+	if len(replay.Steps) == 0 {
+		return buildEventSteps(replay.Events, ignoreInitEvent), nil
+	}
+
+	b := new(stepBuilder)
+	b.sections = make(map[string]*dataModel.ReplaySection)
+	for i := range replay.Sections {
+		section := &replay.Sections[i]
+		if section.Name == "" {
+			return nil, errors.New("Missing replay section name")
+		}
+		if _, found := b.sections[section.Name]; found {
+			return nil, errors.New("Duplicate replay section: " + section.Name)
+		}
+		b.sections[section.Name] = section
+	}
+
+	err := b.addSteps(replay.Steps, replay.Variables, 0)
+	if err != nil {
+		return nil, err
+	}
+	return b.steps, nil
+}
+
+func buildEventSteps(events []dataModel.ReplayEvent, ignoreInitEvent bool) []*replayStep {
+	var steps []*replayStep
+	var firstTime int
+	var prevTime int
+
+	for i := range events {
+		replayEvent := &events[i]
+		if replayEvent.Event == nil {
+			continue
+		}
+
+		// INIT event is never sent; keep it as a timing reference only if it is not ignored
+		step := new(replayStep)
+		step.event = replayEvent.Event
+		if replayEvent.Event.Type_ == "OTHER" && replayEvent.Event.Name == "Init" {
+			if ignoreInitEvent {
+				continue
+			}
+			step.event = nil
+		}
+
+		// Convert absolute event times to relative step delays
+		eventTime := int(replayEvent.Time)
+		if len(steps) == 0 {
+			firstTime = eventTime
+			prevTime = eventTime
+		}
+		step.name = strconv.Itoa(int(replayEvent.Index))
+		step.delay = eventTime - prevTime
+		step.time = eventTime - firstTime
+		prevTime = eventTime
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func (b *stepBuilder) addSteps(steps []dataModel.ReplayStep, vars map[string]string, depth int) error {
+	if depth > maxSectionDepth {
+		return errors.New("Maximum replay section depth exceeded")
+	}
+
+	for i := range steps {
+		step := &steps[i]
+		if step.Event != nil && step.Section != "" {
+			return errors.New("Replay step cannot have both an event and a section: " + step.Name)
+		}
+
+		err := validateStepVariables(step)
+		if err != nil {
+			return err
+		}
+
+		repeat := 1
+		if step.Repeat > 1 {
+			repeat = int(step.Repeat)
+		}
+
+		for iteration := 1; iteration <= repeat; iteration++ {
+			// Resolve step variables using parent variables & current iteration only
+			parentVars := make(map[string]string)
+			for name, value := range vars {
+				parentVars[name] = value
+			}
+			parentVars[iterationVariable] = strconv.Itoa(iteration)
+			stepVars := make(map[string]string)
+			for name, value := range parentVars {
+				stepVars[name] = value
+			}
+			for name, value := range step.Variables {
+				resolved, err := substituteVariables(value, parentVars)
+				if err != nil {
+					return err
+				}
+				stepVars[name] = resolved
+			}
+
+			err := b.addStep(step, stepVars, depth)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateStepVariables - Reject step variables referencing other variables of the same step
+// Step variables are resolved independently of each other, using parent variables only
+func validateStepVariables(step *dataModel.ReplayStep) error {
+	for name, value := range step.Variables {
+		for _, match := range variableRegex.FindAllStringSubmatch(value, -1) {
+			ref := match[1]
+			if _, found := step.Variables[ref]; found && ref != name {
+				return errors.New("Replay variable " + name + " cannot reference variable " + ref + " of the same step: " + step.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *stepBuilder) addStep(step *dataModel.ReplayStep, vars map[string]string, depth int) error {
+	if len(b.steps) >= maxReplaySteps {
+		return errors.New("Maximum number of replay steps exceeded")
+	}
+
+	// Create step
+	s := new(replayStep)
+	s.name = step.Name
+	s.delay = int(step.Delay)
+	s.jitter = int(step.Jitter)
+	if s.delay < 0 || s.jitter < 0 {
+		return errors.New("Invalid replay step delay or jitter: " + step.Name)
+	}
+	b.time += s.delay
+	s.time = b.time
+
+	var err error
+	if step.Condition != nil {
+		s.condition, err = substituteCondition(step.Condition, vars)
+		if err != nil {
+			return err
+		}
+	}
+	if step.WaitUntil != nil {
+		s.waitUntil, err = substituteCondition(step.WaitUntil, vars)
+		if err != nil {
+			return err
+		}
+	}
+	if step.Event != nil {
+		s.event, err = substituteEvent(step.Event, vars)
+		if err != nil {
+			return err
+		}
+	}
+	b.steps = append(b.steps, s)
+
+	// Add section steps; step conditions apply to the whole section
+	if step.Section != "" {
+		section, found := b.sections[step.Section]
+		if !found {
+			return errors.New("Unknown replay section: " + step.Section)
+		}
+		index := len(b.steps) - 1
+		err = b.addSteps(section.Steps, vars, depth+1)
+		if err != nil {
+			return err
+		}
+		s.skip = len(b.steps) - index - 1
+	}
+	return nil
+}
+
+func substituteCondition(condition *dataModel.ReplayCondition, vars map[string]string) (*dataModel.ReplayCondition, error) {
+	if condition.Type_ != ConditionUeAttached && condition.Type_ != ConditionUeInRange {
+		return nil, errors.New("Unsupported replay condition type: " + condition.Type_)
+	}
+
+	c := *condition
+	var err error
+	c.Ue, err = substituteVariables(condition.Ue, vars)
+	if err != nil {
+		return nil, err
+	}
+	c.Poa, err = substituteVariables(condition.Poa, vars)
+	if err != nil {
+		return nil, err
+	}
+	if c.Ue == "" || c.Poa == "" {
+		return nil, errors.New("Missing UE or POA name in replay condition")
+	}
+	return &c, nil
+}
+
+func substituteEvent(event *dataModel.Event, vars map[string]string) (*dataModel.Event, error) {
+	j, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	// Variables are substituted in JSON string values; escape values accordingly
+	escapedVars := make(map[string]string)
+	for name, value := range vars {
+		escaped, _ := json.Marshal(value)
+		escapedVars[name] = string(escaped[1 : len(escaped)-1])
+	}
+	str, err := substituteVariables(string(j), escapedVars)
+	if err != nil {
+		return nil, err
+	}
+
+	e := new(dataModel.Event)
+	err = json.Unmarshal([]byte(str), e)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// substituteVariables - Replace ${name} variable references with their value
+func substituteVariables(str string, vars map[string]string) (string, error) {
+	var err error
+	result := variableRegex.ReplaceAllStringFunc(str, func(match string) string {
+		name := variableRegex.FindStringSubmatch(match)[1]
+		value, found := vars[name]
+		if !found {
+			err = errors.New("Unknown replay variable: " + name)
+			return match
+		}
+		return value
+	})
+	return result, err
+}
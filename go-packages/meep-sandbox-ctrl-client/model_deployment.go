@@ -42,4 +42,8 @@ type Deployment struct {
 	// Key/Value Pair Map (string, string)
 	UserMeta map[string]string `json:"userMeta,omitempty"`
 	Domains  []Domain          `json:"domains,omitempty"`
+	// Network characteristics algorithm used to share segment bandwidth between flows. Default value is 'SEGMENT' max-min fair share algorithm.
+	NetCharAlgorithm string            `json:"netCharAlgorithm,omitempty"`
+	NetworkSlices    []NetworkSlice    `json:"networkSlices,omitempty"`
+	TrafficLoadModel *TrafficLoadModel `json:"trafficLoadModel,omitempty"`
 }
@@ -27,4 +27,10 @@ package client
 type PduSessionInfo struct {
 	// Data Network Name as defined in the scenario
 	Dnn string `json:"dnn,omitempty"`
+	// Traffic weight applied to flows using this PDU session; overrides process traffic weight
+	TrafficWeight int32 `json:"trafficWeight,omitempty"`
+	// Traffic priority level applied to flows using this PDU session; overrides process traffic priority
+	TrafficPriority int32       `json:"trafficPriority,omitempty"`
+	Qos             *QosProfile `json:"qos,omitempty"`
+	Snssai          *Snssai     `json:"snssai,omitempty"`
 }
@@ -27,5 +27,6 @@ package client
 // Cellular 4G POA configuration information
 type Poa4GConfig struct {
 	// The E-UTRAN Cell Identity as defined in ETSI TS 136 413 including the ID of the eNB serving the cell
-	CellId string `json:"cellId,omitempty"`
+	CellId      string             `json:"cellId,omitempty"`
+	Propagation *PropagationConfig `json:"propagation,omitempty"`
 }
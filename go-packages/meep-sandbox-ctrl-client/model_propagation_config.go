@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Sandbox Controller REST API
+ *
+ * This API is the main Sandbox Controller API for scenario deployment & event injection <p>**Micro-service**<br>[meep-sandbox-ctrl](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-sandbox-ctrl) <p>**Type & Usage**<br>Platform runtime interface to manage active scenarios and inject events in AdvantEDGE platform <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package client
+
+// POA radio propagation model configuration
+type PropagationConfig struct {
+	// Radio propagation model used to compute POA signal strength; defaults to LINEAR
+	Model string `json:"model,omitempty"`
+	// Transmit power (in dBm)
+	TxPower *float32 `json:"txPower,omitempty"`
+	// Carrier frequency (in MHz)
+	Frequency float32 `json:"frequency,omitempty"`
+	// POA antenna height (in meters)
+	AntennaHeight float32 `json:"antennaHeight,omitempty"`
+	// UE antenna height (in meters)
+	UeAntennaHeight float32 `json:"ueAntennaHeight,omitempty"`
+	// Path loss exponent (LOG_DISTANCE model only)
+	PathLossExponent float32 `json:"pathLossExponent,omitempty"`
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Sandbox Controller REST API
+ *
+ * This API is the main Sandbox Controller API for scenario deployment & event injection <p>**Micro-service**<br>[meep-sandbox-ctrl](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-sandbox-ctrl) <p>**Type & Usage**<br>Platform runtime interface to manage active scenarios and inject events in AdvantEDGE platform <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package client
+
+// QosProfile - PDU session QoS profile as defined in 3GPP TS 23.501
+type QosProfile struct {
+	// 5G QoS Identifier (5QI); standardized 5QI values provide default resource type, priority level & packet delay budget
+	FiveQi int32 `json:"fiveQi,omitempty"`
+	// QoS flow resource type; overrides 5QI default
+	ResourceType string `json:"resourceType,omitempty"`
+	// QoS priority level (1 = highest); overrides 5QI default
+	PriorityLevel int32 `json:"priorityLevel,omitempty"`
+	// Guaranteed downlink bit rate (Mbps); GBR resource types only
+	GbrDl float64 `json:"gbrDl,omitempty"`
+	// Guaranteed uplink bit rate (Mbps); GBR resource types only
+	GbrUl float64 `json:"gbrUl,omitempty"`
+	// Maximum downlink bit rate (Mbps)
+	MbrDl float64 `json:"mbrDl,omitempty"`
+	// Maximum uplink bit rate (Mbps)
+	MbrUl float64 `json:"mbrUl,omitempty"`
+	// Packet delay budget (ms); target flow latency; overrides 5QI default
+	PacketDelayBudget int32 `json:"packetDelayBudget,omitempty"`
+}
@@ -29,4 +29,10 @@ type Replay struct {
 	// User description of the content of the replay file.
 	Description string        `json:"description,omitempty"`
 	Events      []ReplayEvent `json:"events,omitempty"`
+	// Default variable values (name, value); variables are referenced as ${name} in replay step events & conditions
+	Variables map[string]string `json:"variables,omitempty"`
+	// Replay sequence; takes precedence over events when present
+	Steps []ReplayStep `json:"steps,omitempty"`
+	// Named sections of replay steps that can be played from a replay step
+	Sections []ReplaySection `json:"sections,omitempty"`
 }
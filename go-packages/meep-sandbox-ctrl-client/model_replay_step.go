@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2022  The AdvantEDGE Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Sandbox Controller REST API
+ *
+ * This API is the main Sandbox Controller API for scenario deployment & event injection <p>**Micro-service**<br>[meep-sandbox-ctrl](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-sandbox-ctrl) <p>**Type & Usage**<br>Platform runtime interface to manage active scenarios and inject events in AdvantEDGE platform <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package client
+
+// Replay step object
+type ReplayStep struct {
+	// Step name
+	Name string `json:"name,omitempty"`
+	// Time (ms) to wait after the previous step before playing this step
+	Delay int32 `json:"delay,omitempty"`
+	// Maximum random time (ms) added to the step delay
+	Jitter int32 `json:"jitter,omitempty"`
+	// Number of times the step is played (default 1); current iteration is available in variable ${iteration}
+	Repeat int32 `json:"repeat,omitempty"`
+	// Name of the replay section to play
+	Section string `json:"section,omitempty"`
+	Event   *Event `json:"event,omitempty"`
+	// Variable values (name, value) used by the step event or section; values may reference parent variables but not other variables of the same step
+	Variables map[string]string `json:"variables,omitempty"`
+	Condition *ReplayCondition  `json:"condition,omitempty"`
+	WaitUntil *ReplayCondition  `json:"waitUntil,omitempty"`
+}
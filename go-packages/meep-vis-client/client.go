@@ -60,8 +60,6 @@ type APIClient struct {
 
 	// API Services
 
-	UnsupportedApi *UnsupportedApiService
-
 	V2xiApi *V2xiApiService
 }
 
@@ -81,7 +79,6 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
-	c.UnsupportedApi = (*UnsupportedApiService)(&c.common)
 	c.V2xiApi = (*V2xiApiService)(&c.common)
 
 	return c
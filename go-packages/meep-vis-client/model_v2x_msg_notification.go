@@ -26,6 +26,8 @@ package client
 
 type V2xMsgNotification struct {
 	Links *V2xMsgNotificationLinks `json:"_links"`
+
+	LocationInfo *LocationInfo `json:"locationInfo,omitempty"`
 	// Published V2X message content. The format of the string is defined by the standardization organization indicated by the attribute stdOrganization.
 	MsgContent string `json:"msgContent"`
 	// The encode format of the V2X message, for example base64.
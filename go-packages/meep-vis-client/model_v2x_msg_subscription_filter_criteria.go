@@ -26,6 +26,8 @@ package client
 
 // List of filtering criteria for the subscription. Any filtering criteria from below, which is included in the request, shall also be included in the response.
 type V2xMsgSubscriptionFilterCriteria struct {
+	// Locations of interest for the subscription, as defined in ETSI GS MEC 030 V3.1.1. Only messages published from a location served by the same cell as one of these locations are notified.
+	LocationInfo []LocationInfo `json:"locationInfo,omitempty"`
 	// Subscribed V2X message type. Its value is defined by the standardization organization indicated by the attribute stdOrganization. See note 3.
 	MsgType []string `json:"msgType,omitempty"`
 	// Standardization organization which defines the subscribed V2X message type:  ETSI: European Telecommunications Standards Institute.  See note 2.
@@ -26,7 +26,7 @@ import (
 	"time"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/roymx/viper"
 )
 
@@ -44,16 +44,28 @@ type TrafficMgr struct {
 	GridFileExists bool
 	mutex          sync.Mutex
 	poaLoadMap     map[string]*PoaLoads
+	poaUeCountMap  map[string]int32
+	ueLoad         float32
 	// updateCb  func(string, string)
 }
 
 type PoaLoads struct {
 	PoaName     string
 	Category    string
+	Cell        string
 	Loads       map[string]int32
+	HourlyLoads []int32
 	AverageLoad int32
 }
 
+// TrafficLoadCell - Traffic load grid cell with hourly load curve
+// Area is a GeoJSON Polygon geometry
+type TrafficLoadCell struct {
+	Name  string
+	Area  string
+	Loads []int32
+}
+
 type CategoryLoads struct {
 	Category string
 	Loads    map[string]int32
@@ -101,7 +113,14 @@ const (
 
 // DB Table Names
 const (
-	GridTable = "grid_map"
+	GridTable            = "grid_map"
+	TrafficLoadCellTable = "traffic_load_cell"
+)
+
+// Traffic load model
+const (
+	HoursPerDay   = 24
+	DefaultUeLoad = 1
 )
 
 // Grid Map data
@@ -204,6 +223,8 @@ func NewTrafficMgr(name, namespace, user, pwd, host, port string) (tm *TrafficMg
 	tm.host = host
 	tm.port = port
 	tm.poaLoadMap = map[string]*PoaLoads{}
+	tm.poaUeCountMap = map[string]int32{}
+	tm.ueLoad = DefaultUeLoad
 
 	// Connect to Postgis DB
 	for retry := 0; retry <= DbMaxRetryCount; retry++ {
@@ -237,10 +258,11 @@ func NewTrafficMgr(name, namespace, user, pwd, host, port string) (tm *TrafficMg
 	}
 
 	// Open grid map file
-	gridMapData, tm.GridFileExists, err = getGridMapConfig()
-	if err != nil {
-		log.Error("Failed to open grid map file with err: ", err.Error())
-		return tm, err
+	// Grid map file is optional; traffic load cells may be provided by the scenario instead
+	var gridErr error
+	gridMapData, tm.GridFileExists, gridErr = getGridMapConfig()
+	if gridErr != nil {
+		log.Info("Grid map file not available: ", gridErr.Error())
 	}
 
 	log.Info("Postgis Connector successfully created")
@@ -380,12 +402,26 @@ func (tm *TrafficMgr) CreateTables() (err error) {
 	}
 	log.Info("Created Grids table: ", GridTable)
 
+	// Traffic Load Cell Table
+	_, err = tm.db.Exec(`CREATE TABLE IF NOT EXISTS ` + TrafficLoadCellTable + ` (
+		name            varchar(100)            NOT NULL,
+		loads           integer[]               NOT NULL,
+		area            geometry(POLYGON,4326),
+		PRIMARY KEY (name)
+	)`)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	log.Info("Created Traffic Load Cells table: ", TrafficLoadCellTable)
+
 	return nil
 }
 
 // DeleteTables - Delete all postgis traffic tables
 func (tm *TrafficMgr) DeleteTables() (err error) {
 	_ = tm.DeleteTable(GridTable)
+	_ = tm.DeleteTable(TrafficLoadCellTable)
 	return nil
 }
 
@@ -458,19 +494,19 @@ func (tm *TrafficMgr) CreatePoaLoad(poaName string, category string) (err error)
 
 	// Create POA loads entry
 	poaLoads := &PoaLoads{
-		PoaName:  poaName,
-		Category: category,
-		Loads:    map[string]int32{},
-	}
-	// Copy category loads & calculate average load
-	if len(categoryLoads.Loads) > 0 {
-		var loadSum int32 = 0
-		for k, v := range categoryLoads.Loads {
-			poaLoads.Loads[k] = v
-			loadSum += v
-		}
-		poaLoads.AverageLoad = loadSum / int32(len(poaLoads.Loads))
+		PoaName:     poaName,
+		Category:    category,
+		Loads:       map[string]int32{},
+		HourlyLoads: make([]int32, HoursPerDay),
 	}
+	// Copy category loads & expand time windows to hourly loads
+	for k, v := range categoryLoads.Loads {
+		poaLoads.Loads[k] = v
+	}
+	for hour := range poaLoads.HourlyLoads {
+		poaLoads.HourlyLoads[hour] = poaLoads.Loads[timeWindows[int32(hour)]]
+	}
+	poaLoads.AverageLoad = getAverageLoad(poaLoads.HourlyLoads)
 	log.Info("Created loads table for ", poaName, " (", category, "): ", poaLoads.Loads, ", Average: ", poaLoads.AverageLoad)
 
 	// Add POA loads to map
@@ -478,6 +514,48 @@ func (tm *TrafficMgr) CreatePoaLoad(poaName string, category string) (err error)
 	return nil
 }
 
+// CreatePoaCellLoad - Create new POA Load from traffic load cell
+func (tm *TrafficMgr) CreatePoaCellLoad(poaName string, cell *TrafficLoadCell) (err error) {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	// Validate input
+	if poaName == "" {
+		return errors.New("Missing POA Name")
+	}
+	if cell == nil {
+		return errors.New("Missing traffic load cell")
+	}
+	if len(cell.Loads) != HoursPerDay {
+		return errors.New("Invalid hourly loads for traffic load cell: " + cell.Name)
+	}
+
+	// Create POA loads entry
+	poaLoads := &PoaLoads{
+		PoaName:     poaName,
+		Cell:        cell.Name,
+		HourlyLoads: make([]int32, HoursPerDay),
+	}
+	copy(poaLoads.HourlyLoads, cell.Loads)
+	poaLoads.AverageLoad = getAverageLoad(poaLoads.HourlyLoads)
+	log.Info("Created loads table for ", poaName, " (cell ", cell.Name, "): ", poaLoads.HourlyLoads, ", Average: ", poaLoads.AverageLoad)
+
+	// Add POA loads to map
+	tm.poaLoadMap[poaName] = poaLoads
+	return nil
+}
+
+func getAverageLoad(loads []int32) int32 {
+	if len(loads) == 0 {
+		return 0
+	}
+	var loadSum int32 = 0
+	for _, load := range loads {
+		loadSum += load
+	}
+	return loadSum / int32(len(loads))
+}
+
 // GetPoaLoad - Get POA Load information
 func (tm *TrafficMgr) GetPoaLoad(poaName string) (poaLoads *PoaLoads, err error) {
 	tm.mutex.Lock()
@@ -513,6 +591,191 @@ func (tm *TrafficMgr) DeleteAllPoaLoad() (err error) {
 	return nil
 }
 
+// SetUeLoad - Set traffic load added for each UE connected to a POA
+func (tm *TrafficMgr) SetUeLoad(ueLoad float32) (err error) {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	// Validate input
+	if ueLoad < 0 {
+		return errors.New("Invalid UE load")
+	}
+	tm.ueLoad = ueLoad
+	return nil
+}
+
+// GetUeLoad - Get traffic load added for each UE connected to a POA
+func (tm *TrafficMgr) GetUeLoad() float32 {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	return tm.ueLoad
+}
+
+// SetPoaUeCounts - Set number of UEs connected to each POA
+func (tm *TrafficMgr) SetPoaUeCounts(poaUeCountMap map[string]int32) {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	tm.poaUeCountMap = make(map[string]int32, len(poaUeCountMap))
+	for poaName, ueCount := range poaUeCountMap {
+		tm.poaUeCountMap[poaName] = ueCount
+	}
+}
+
+// GetPoaUeCount - Get number of UEs connected to POA
+func (tm *TrafficMgr) GetPoaUeCount(poaName string) int32 {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	return tm.poaUeCountMap[poaName]
+}
+
+// SetTrafficLoadCell - Create or update Traffic Load Cell
+func (tm *TrafficMgr) SetTrafficLoadCell(cell *TrafficLoadCell) (err error) {
+	if profiling {
+		profilingTimers["SetTrafficLoadCell"] = time.Now()
+	}
+
+	// Validate input
+	if cell == nil {
+		return errors.New("Missing traffic load cell")
+	}
+	if cell.Name == "" {
+		return errors.New("Missing cell name")
+	}
+	if cell.Area == "" {
+		return errors.New("Missing cell area")
+	}
+	if len(cell.Loads) != HoursPerDay {
+		return errors.New("Invalid hourly loads for traffic load cell: " + cell.Name)
+	}
+
+	// Create or update Traffic Load Cell entry
+	query := `INSERT INTO ` + TrafficLoadCellTable +
+		` (name, loads, area)
+			VALUES ($1, $2, ST_SetSRID(ST_GeomFromGeoJSON($3), 4326))
+			ON CONFLICT (name)
+			DO UPDATE SET loads = EXCLUDED.loads, area = EXCLUDED.area`
+	_, err = tm.db.Exec(query, cell.Name, pq.Array(cell.Loads), cell.Area)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+
+	if profiling {
+		now := time.Now()
+		log.Debug("SetTrafficLoadCell: ", now.Sub(profilingTimers["SetTrafficLoadCell"]))
+	}
+	return nil
+}
+
+// GetTrafficLoadCell - Get Traffic Load Cell information
+func (tm *TrafficMgr) GetTrafficLoadCell(name string) (cell *TrafficLoadCell, err error) {
+	// Validate input
+	if name == "" {
+		err = errors.New("Missing cell name")
+		return nil, err
+	}
+
+	// Get Traffic Load Cell entry
+	var rows *sql.Rows
+	rows, err = tm.db.Query(`
+		SELECT name, loads, ST_AsGeoJSON(area)
+		FROM `+TrafficLoadCellTable+`
+		WHERE name = ($1)`, name)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan result
+	for rows.Next() {
+		cell, err = scanTrafficLoadCell(rows)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		log.Error(err)
+	}
+
+	// Return error if not found
+	if cell == nil {
+		err = errors.New("Traffic load cell not found: " + name)
+		return nil, err
+	}
+	return cell, nil
+}
+
+// GetAllTrafficLoadCells - Get all Traffic Load Cells
+func (tm *TrafficMgr) GetAllTrafficLoadCells() (cells map[string]*TrafficLoadCell, err error) {
+	// Create Traffic Load Cell map
+	cells = make(map[string]*TrafficLoadCell)
+
+	// Get Traffic Load Cell entries
+	var rows *sql.Rows
+	rows, err = tm.db.Query(`SELECT name, loads, ST_AsGeoJSON(area) FROM ` + TrafficLoadCellTable)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan results
+	for rows.Next() {
+		cell, err := scanTrafficLoadCell(rows)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, err
+		}
+		cells[cell.Name] = cell
+	}
+	err = rows.Err()
+	if err != nil {
+		log.Error(err)
+	}
+	return cells, nil
+}
+
+// DeleteTrafficLoadCell - Delete Traffic Load Cell entry
+func (tm *TrafficMgr) DeleteTrafficLoadCell(name string) (err error) {
+	// Validate input
+	if name == "" {
+		return errors.New("Missing cell name")
+	}
+
+	result, err := tm.db.Exec(`DELETE FROM `+TrafficLoadCellTable+` WHERE name = ($1)`, name)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	if count, _ := result.RowsAffected(); count == 0 {
+		return errors.New("Traffic load cell not found: " + name)
+	}
+	return nil
+}
+
+// DeleteAllTrafficLoadCells - Delete all Traffic Load Cell entries
+func (tm *TrafficMgr) DeleteAllTrafficLoadCells() (err error) {
+	_, err = tm.db.Exec(`DELETE FROM ` + TrafficLoadCellTable)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	return nil
+}
+
+func scanTrafficLoadCell(rows *sql.Rows) (cell *TrafficLoadCell, err error) {
+	cell = new(TrafficLoadCell)
+	err = rows.Scan(&cell.Name, pq.Array(&cell.Loads), &cell.Area)
+	if err != nil {
+		return nil, err
+	}
+	return cell, nil
+}
+
 // GetGridMap - Get GridMap information
 func (tm *TrafficMgr) GetGridMap(area string) (gridMaps *GridMapTable, err error) {
 	if profiling {
@@ -698,7 +961,46 @@ func (tm *TrafficMgr) GetPoaCategory(longitude float32, latitude float32) (categ
 	return category, err
 }
 
+// GetPoaCell - Get the traffic load cell containing a PoA
+// Returns nil if PoA is not located in any traffic load cell
+func (tm *TrafficMgr) GetPoaCell(longitude float32, latitude float32) (cell *TrafficLoadCell, err error) {
+	if profiling {
+		profilingTimers["GetPoaCell"] = time.Now()
+	}
+
+	var rows *sql.Rows
+	rows, err = tm.db.Query(`
+		SELECT name, loads, ST_AsGeoJSON(area)
+		FROM `+TrafficLoadCellTable+`
+		WHERE ST_Contains(area, ST_SetSRID(ST_MakePoint($1, $2), 4326))
+		ORDER BY name`, longitude, latitude)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		cell, err = scanTrafficLoadCell(rows)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		log.Error(err)
+	}
+
+	if profiling {
+		now := time.Now()
+		log.Debug("GetPoaCell: ", now.Sub(profilingTimers["GetPoaCell"]))
+	}
+	return cell, err
+}
+
 // PopulatePoaLoad - Populate the Traffic Load table
+// PoA loads come from the traffic load cell containing the PoA, or from the grid map area category otherwise
 func (tm *TrafficMgr) PopulatePoaLoad(poaNameList []string, gpsCoordinates [][]float32) (err error) {
 	// Validate input
 	if poaNameList == nil {
@@ -710,16 +1012,45 @@ func (tm *TrafficMgr) PopulatePoaLoad(poaNameList []string, gpsCoordinates [][]f
 		return err
 	}
 
+	if len(poaNameList) != len(gpsCoordinates) {
+		err = errors.New("Mismatch between POA Name List & GPS coordinates")
+		return err
+	}
+
 	// Get POA loads for each POA
 	for i, poaName := range poaNameList {
-		// Get POA category from locaion & grid map
+		if len(gpsCoordinates[i]) != 2 {
+			err = errors.New("Invalid GPS coordinates for POA: " + poaName)
+			return err
+		}
 		poaLongitude := gpsCoordinates[i][0]
 		poaLatitude := gpsCoordinates[i][1]
+
+		// Get POA traffic load cell from location
+		cell, err := tm.GetPoaCell(poaLongitude, poaLatitude)
+		if err != nil {
+			log.Error(err.Error())
+			return err
+		}
+		if cell != nil {
+			err = tm.CreatePoaCellLoad(poaName, cell)
+			if err != nil {
+				log.Error(err.Error())
+				return err
+			}
+			continue
+		}
+
+		// Get POA category from location & grid map
 		category, err := tm.GetPoaCategory(poaLongitude, poaLatitude)
 		if err != nil {
 			log.Error(err.Error())
 			return err
 		}
+		if category == "" {
+			log.Debug("No traffic load pattern found for POA ", poaName)
+			continue
+		}
 
 		// Set POA load
 		err = tm.CreatePoaLoad(poaName, category)
@@ -734,7 +1065,7 @@ func (tm *TrafficMgr) PopulatePoaLoad(poaNameList []string, gpsCoordinates [][]f
 // Returns Predicted QoS in terms of RSRQ and RSRP values based on Traffic Load patterns
 func (tm *TrafficMgr) PredictQosPerTrafficLoad(hour int32, inRsrp int32, inRsrq int32, poaName string) (outRsrp int32, outRsrq int32, err error) {
 	// Validate input
-	if hour > 24 {
+	if hour < 0 || hour >= HoursPerDay {
 		err = errors.New("Invalid hour value")
 		return 0, 0, err
 	}
@@ -743,26 +1074,23 @@ func (tm *TrafficMgr) PredictQosPerTrafficLoad(hour int32, inRsrp int32, inRsrq
 		return 0, 0, err
 	}
 
-	// Get time range for DB query
-	timeRange, found := timeWindows[hour]
-	if !found {
-		err = errors.New("Invalid hour value")
-		return 0, 0, err
-	}
-
-	// Get predicted load for a given PoA in a desired time slot from the traffic patterns table
-	log.Debug("Obtaining traffic load pattern of POA " + poaName + " for the time range: " + timeRange)
+	// Get predicted load for a given PoA in a desired hour from the traffic patterns table
+	log.Debug("Obtaining traffic load pattern of POA " + poaName + " for hour: " + strconv.Itoa(int(hour)))
 	poaLoads, err := tm.GetPoaLoad(poaName)
 	if err != nil {
 		return 0, 0, err
 	}
-	var predictedUserTraffic int32
-	predictedUserTraffic, found = poaLoads.Loads[timeRange]
-	if !found {
+	if len(poaLoads.HourlyLoads) != HoursPerDay {
 		err = errors.New("Could not find estimated user load")
 		return 0, 0, err
 	}
 
+	// Add load from UEs currently connected to the POA
+	tm.mutex.Lock()
+	ueTraffic := int32(math.Round(float64(tm.ueLoad) * float64(tm.poaUeCountMap[poaName])))
+	tm.mutex.Unlock()
+	predictedUserTraffic := poaLoads.HourlyLoads[hour] + ueTraffic
+
 	// Find reduced signal strength as a function of number of users in the area
 	outRsrp, outRsrq, err = findReducedSignalStrength(inRsrp, inRsrq, predictedUserTraffic, poaLoads.AverageLoad)
 	return outRsrp, outRsrq, err
@@ -47,6 +47,17 @@ const ( // FIXME To be update with correct values at the end
 	grid1                  = "(7.422504565000003 43.72723219, 7.422214272000005 43.72747621000001, 7.421491549999999 43.72803665999997, 7.421329629999991 43.72830198999998, 7.421163718000012 43.72867443000003, 7.419276724999997 43.72859253000001, 7.419206675999996 43.72905120999999, 7.418583629000004 43.72901666000001, 7.418475553000015 43.73002454999999, 7.417616122000008 43.72994506000001, 7.417434616999978 43.73032852999999, 7.418496582999996 43.73105429000002, 7.418994657919904 43.73100689871077, 7.419449086174669 43.7308514985659, 7.420256165533474 43.73023629378087, 7.420497749571428 43.72995749603205, 7.420850294302137 43.73005520723608, 7.4215757625905 43.73035387586872, 7.421803319220919 43.7301342399201, 7.422212030034432 43.72965249553761, 7.423253358018433 43.72951012971406, 7.423973082002464 43.72925388225838, 7.42410389544898 43.72788678068092, 7.422504565000003 43.72723219)" // port-de-fontvieille
 	expectedGrid1          = "0103000020E61000000100000018000000C7576409A5B01D400153C4F115DD4540F09328F058B01D40B748C1F01DDD454050291B7B9BAF1D40967E264E30DD454033BED60871AF1D40B054E6FF38DD45408B49AC8A45AF1D400A24273445DD45405965B7E056AD1D40DF3A208542DD45404ABDCE8344AD1D409307D08C51DD4540B8C8D42FA1AC1D403878FC6A50DD4540083EF9DA84AC1D4077C3C77171DD4540EB16898FA3AB1D40D549F8D66EDD4540CAEEF0FA73AB1D40C5D7BF677BDD454014E4455E8AAC1D404693DD2F93DD4540D9DB83EF0CAD1D407B8E51A291DD454044D4AD0F84AD1D405C49BA8A8CDD454071A2DBA157AE1D400F12046278DD4540B4CD49F696AE1D40BF764A3F6FDD45409B282A61F3AE1D403EADF37272DD45402873848EB1AF1D4077AE5D3C7CDD4540A6979535EDAF1D40C512ED0975DD454012E7B35958B01D40B0E3C24065DD454005720A5469B11D407E9C829660DD4540F256E6FF25B21D40F063F33058DD4540013DA44A48B21D408E1BDF642BDD4540C7576409A5B01D400153C4F115DD4540"
 	area1                  = "poa1-area" // port-de-fontvieille
+
+	cellName1      = "cell1"
+	cellArea1      = `{"type":"Polygon","coordinates":[[[7.41,43.72],[7.43,43.72],[7.43,43.74],[7.41,43.74],[7.41,43.72]]]}`
+	cellLongitude1 = 7.42
+	cellLatitude1  = 43.73
+	cellLoad1      = 10
+	ueCount1       = 10
+	inRsrp2        = 100
+	inRsrq2        = 20
+	outRsrp2       = 50
+	outRsrq2       = 10
 )
 
 func TestNewTrafficMgr(t *testing.T) {
@@ -344,6 +355,31 @@ func TestPredictQosPerTrafficLoad(t *testing.T) {
 		t.Fatalf("Category validation failed")
 	}
 
+	// Traffic load cell with connected UEs
+	fmt.Println("Predict QoS with connected UEs")
+	cellLoads := make([]int32, HoursPerDay)
+	for i := range cellLoads {
+		cellLoads[i] = cellLoad1
+	}
+	err = tm.CreatePoaCellLoad(poaName1, &TrafficLoadCell{Name: cellName1, Area: cellArea1, Loads: cellLoads})
+	if err != nil {
+		t.Fatal("Failed to create cell load:", err.Error())
+	}
+	rsrp, rsrq, err = tm.PredictQosPerTrafficLoad(hour1, inRsrp2, inRsrq2, poaName1)
+	if err != nil || !validatePredictQosPerTrafficLoad(rsrp, rsrq, inRsrp2, inRsrq2) {
+		t.Fatalf("QoS should not be reduced at average load")
+	}
+	tm.SetPoaUeCounts(map[string]int32{poaName1: ueCount1})
+	rsrp, rsrq, err = tm.PredictQosPerTrafficLoad(hour1, inRsrp2, inRsrq2, poaName1)
+	if err != nil || !validatePredictQosPerTrafficLoad(rsrp, rsrq, outRsrp2, outRsrq2) {
+		t.Fatalf("QoS should be reduced by connected UEs")
+	}
+	_ = tm.SetUeLoad(0)
+	rsrp, rsrq, err = tm.PredictQosPerTrafficLoad(hour1, inRsrp2, inRsrq2, poaName1)
+	if err != nil || !validatePredictQosPerTrafficLoad(rsrp, rsrq, inRsrp2, inRsrq2) {
+		t.Fatalf("QoS should not be reduced when UE load is disabled")
+	}
+
 	// Delete all & validate updatespoaMap
 	fmt.Println("Delete all & validate updates")
 	_ = tm.DeleteAllPoaLoad()
@@ -352,6 +388,114 @@ func TestPredictQosPerTrafficLoad(t *testing.T) {
 	// t.Fatalf("DONE")
 }
 
+func TestTrafficMgrTrafficLoadCell(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Create Connector
+	fmt.Println("Create valid VIS Asset Manager")
+	tm, err := NewTrafficMgr(tmName, tmNamespace, tmDBUser, tmDBPwd, tmDBHost, tmDBPort)
+	if err != nil || tm == nil {
+		t.Fatalf("Failed to create VIS Asset Manager")
+	}
+
+	// Cleanup
+	_ = tm.DeleteTables()
+
+	// Create tables
+	fmt.Println("Create Tables")
+	err = tm.CreateTables()
+	if err != nil {
+		t.Fatalf("Failed to create tables")
+	}
+
+	// Add Invalid cells
+	fmt.Println("Create Invalid Traffic Load Cell")
+	cellLoads := make([]int32, HoursPerDay)
+	for i := range cellLoads {
+		cellLoads[i] = int32(i)
+	}
+	err = tm.SetTrafficLoadCell(&TrafficLoadCell{Name: "", Area: cellArea1, Loads: cellLoads})
+	if err == nil {
+		t.Fatalf("Cell creation should have failed")
+	}
+	err = tm.SetTrafficLoadCell(&TrafficLoadCell{Name: cellName1, Area: "", Loads: cellLoads})
+	if err == nil {
+		t.Fatalf("Cell creation should have failed")
+	}
+	err = tm.SetTrafficLoadCell(&TrafficLoadCell{Name: cellName1, Area: cellArea1, Loads: cellLoads[:12]})
+	if err == nil {
+		t.Fatalf("Cell creation should have failed")
+	}
+
+	// Add cell & validate
+	fmt.Println("Create Traffic Load Cell")
+	err = tm.SetTrafficLoadCell(&TrafficLoadCell{Name: cellName1, Area: cellArea1, Loads: cellLoads})
+	if err != nil {
+		t.Fatalf("Failed to create cell: " + err.Error())
+	}
+	cell, err := tm.GetTrafficLoadCell(cellName1)
+	if err != nil || !validateTrafficLoadCell(cell, cellName1, cellLoads) {
+		t.Fatalf("Cell validation failed")
+	}
+	cell, err = tm.GetPoaCell(cellLongitude1, cellLatitude1)
+	if err != nil || !validateTrafficLoadCell(cell, cellName1, cellLoads) {
+		t.Fatalf("POA cell validation failed")
+	}
+	cell, err = tm.GetPoaCell(0, 0)
+	if err != nil || cell != nil {
+		t.Fatalf("POA should not be in any cell")
+	}
+
+	// Update cell & validate
+	fmt.Println("Update Traffic Load Cell")
+	cellLoads[hour1] = 1000
+	err = tm.SetTrafficLoadCell(&TrafficLoadCell{Name: cellName1, Area: cellArea1, Loads: cellLoads})
+	if err != nil {
+		t.Fatalf("Failed to update cell: " + err.Error())
+	}
+	cells, err := tm.GetAllTrafficLoadCells()
+	if err != nil || len(cells) != 1 || !validateTrafficLoadCell(cells[cellName1], cellName1, cellLoads) {
+		t.Fatalf("Cell update validation failed")
+	}
+
+	// Populate POA loads from cell
+	fmt.Println("Populate POA loads")
+	err = tm.PopulatePoaLoad([]string{poaName1}, [][]float32{{cellLongitude1, cellLatitude1}})
+	if err != nil {
+		t.Fatalf("Failed to populate POA loads: " + err.Error())
+	}
+	poaLoads, err := tm.GetPoaLoad(poaName1)
+	if err != nil || poaLoads.Cell != cellName1 || poaLoads.HourlyLoads[hour1] != 1000 {
+		t.Fatalf("POA loads validation failed")
+	}
+
+	// Delete & validate
+	fmt.Println("Delete Traffic Load Cells")
+	err = tm.DeleteTrafficLoadCell(cellName1)
+	if err != nil {
+		t.Fatalf("Failed to delete cell")
+	}
+	err = tm.DeleteTrafficLoadCell(cellName1)
+	if err == nil {
+		t.Fatalf("Cell deletion should have failed")
+	}
+	err = tm.DeleteAllTrafficLoadCells()
+	if err != nil {
+		t.Fatalf("Failed to delete all cells")
+	}
+	cells, err = tm.GetAllTrafficLoadCells()
+	if err != nil || len(cells) != 0 {
+		t.Fatalf("Cells should no longer exist")
+	}
+
+	// Cleanup
+	_ = tm.DeleteAllPoaLoad()
+	_ = tm.DeleteTables()
+
+	// t.Fatalf("DONE")
+}
+
 func validatePoaLoads(poaLoads *PoaLoads, poaName string, category string, loads map[string]int32) bool {
 	if poaLoads == nil {
 		fmt.Println("poaLoads == nil")
@@ -406,3 +550,25 @@ func validateGridMap(gridMap *GridMapTable, area string, category string, grid s
 	}
 	return true
 }
+
+func validateTrafficLoadCell(cell *TrafficLoadCell, name string, loads []int32) bool {
+	if cell == nil {
+		fmt.Println("cell == nil")
+		return false
+	}
+	if cell.Name != name {
+		fmt.Println("cell.Name != name")
+		return false
+	}
+	if len(cell.Loads) != len(loads) {
+		fmt.Println("len(cell.Loads) != len(loads)")
+		return false
+	}
+	for i, load := range loads {
+		if cell.Loads[i] != load {
+			fmt.Println("cell.Loads[", i, "] != load")
+			return false
+		}
+	}
+	return true
+}
@@ -60,8 +60,6 @@ type APIClient struct {
 
 	// API Services
 
-	UnsupportedApi *UnsupportedApiService
-
 	WaiApi *WaiApiService
 }
 
@@ -81,7 +79,6 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
-	c.UnsupportedApi = (*UnsupportedApiService)(&c.common)
 	c.WaiApi = (*WaiApiService)(&c.common)
 
 	return c
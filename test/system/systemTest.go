@@ -164,7 +164,7 @@ func createScenario(name string, filepath string) error {
 		log.Error("Failed to unmarshal: ", err)
 		return err
 	}
-	_, err = platformCtrlAppClient.ScenarioConfigurationApi.CreateScenario(context.TODO(), name, scenario)
+	_, err = platformCtrlAppClient.ScenarioConfigurationApi.CreateScenario(context.TODO(), name, scenario, nil)
 	if err != nil {
 		log.Error("Failed to create scenario: ", err)
 		return err